@@ -2,7 +2,9 @@ package evmd
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -45,6 +47,7 @@ import (
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
 	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
@@ -138,8 +141,12 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	cosmosevmserver "github.com/cosmos/evm/server"
 
+	futchaingraphql "github.com/raifpy/futchain/x/futchain/graphql"
+	futchainhistory "github.com/raifpy/futchain/x/futchain/history"
+	futchainindexer "github.com/raifpy/futchain/x/futchain/indexer"
 	futchainkeeper "github.com/raifpy/futchain/x/futchain/keeper"
 	futchainmodule "github.com/raifpy/futchain/x/futchain/module"
+	futchainstream "github.com/raifpy/futchain/x/futchain/stream"
 	futchaintypes "github.com/raifpy/futchain/x/futchain/types"
 
 	_ "embed"
@@ -204,6 +211,11 @@ type EVMD struct {
 	CallbackKeeper ibccallbackskeeper.ContractKeeper
 
 	FutchainKeeper futchainkeeper.Keeper
+	FutchainStream *futchainstream.Hub
+	// FutchainIndexer is nil unless the futchain indexer is enabled in app.toml
+	FutchainIndexer *futchainindexer.Indexer
+	// FutchainGraphQL is nil unless the futchain GraphQL endpoint is enabled in app.toml
+	FutchainGraphQL *futchaingraphql.Server
 
 	// Cosmos EVM keepers
 	FeeMarketKeeper   feemarketkeeper.Keeper
@@ -306,6 +318,35 @@ func NewExampleApp(
 		os.Exit(1)
 	}
 
+	// stream the match updates of the committed blocks to the futchain watchers
+	futchainStream := futchainstream.NewHub()
+	streamingManager := bApp.StreamingManager()
+	streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, futchainStream)
+	bApp.SetStreamingManager(streamingManager)
+
+	// index the futchain store changes into an SQL database, if enabled
+	var futchainIndexer *futchainindexer.Indexer
+	if indexerCfg := futchainindexer.ConfigFromAppOptions(appOpts); indexerCfg.Enable {
+		var err error
+		if futchainIndexer, err = futchainindexer.Open(indexerCfg, cast.ToString(appOpts.Get(flags.FlagHome)), logger); err != nil {
+			fmt.Printf("failed to open the futchain indexer: %s", err)
+			os.Exit(1)
+		}
+		bApp.CommitMultiStore().AddListeners([]storetypes.StoreKey{keys[futchaintypes.StoreKey]})
+		streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, futchainIndexer)
+		bApp.SetStreamingManager(streamingManager)
+	}
+
+	// serve the futchain state over GraphQL, if enabled
+	var futchainGraphQL *futchaingraphql.Server
+	if graphqlCfg := futchaingraphql.ConfigFromAppOptions(appOpts); graphqlCfg.Enable {
+		var err error
+		if futchainGraphQL, err = futchaingraphql.NewServer(graphqlCfg, bApp); err != nil {
+			fmt.Printf("failed to create the futchain GraphQL server: %s", err)
+			os.Exit(1)
+		}
+	}
+
 	// wire up the versiondb's `StreamingService` and `MultiStore`.
 	if cast.ToBool(appOpts.Get("versiondb.enable")) {
 		panic("version db not supported in this example chain")
@@ -319,6 +360,9 @@ func NewExampleApp(
 		interfaceRegistry: interfaceRegistry,
 		keys:              keys,
 		tkeys:             tkeys,
+		FutchainStream:    futchainStream,
+		FutchainIndexer:   futchainIndexer,
+		FutchainGraphQL:   futchainGraphQL,
 	}
 
 	app.ParamsKeeper = initParamsKeeper(appCodec, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
@@ -542,6 +586,12 @@ func NewExampleApp(
 		appCodec,
 		app.AccountKeeper.AddressCodec(),
 		authtypes.NewModuleAddress(futchaintypes.GovModuleName),
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.SlashingKeeper,
+		&app.Erc20Keeper,
+		app.EVMKeeper,
+		app.IBCKeeper.ChannelKeeper,
 		futchainkeeper.DatasourceConfig{
 			ApiURL: "https://www.fotmob.com", //TODO: implement default values from config
 			Headers: map[string]string{
@@ -600,6 +650,7 @@ func NewExampleApp(
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(futchaintypes.PortID, futchainmodule.NewIBCModule(app.FutchainKeeper))
 	ibcRouterV2 := ibcapi.NewRouter()
 	ibcRouterV2.AddRoute(ibctransfertypes.ModuleName, transferStackV2)
 
@@ -878,6 +929,15 @@ func NewExampleApp(
 			logger.Error("error on loading last version", "err", err)
 			os.Exit(1)
 		}
+
+		// catch the index up with the committed state, e.g. after a state sync
+		if app.FutchainIndexer != nil {
+			store := app.CommitMultiStore().CacheMultiStore().GetKVStore(keys[futchaintypes.StoreKey])
+			if err := app.FutchainIndexer.Backfill(context.Background(), store, app.LastBlockHeight()); err != nil {
+				logger.Error("error on backfilling the futchain index", "err", err)
+				os.Exit(1)
+			}
+		}
 	}
 
 	return app
@@ -931,6 +991,15 @@ func (app *EVMD) setPostHandler() {
 // Name returns the name of the App
 func (app *EVMD) Name() string { return app.BaseApp.Name() }
 
+// Close closes the app, and the futchain indexer database.
+func (app *EVMD) Close() error {
+	err := app.BaseApp.Close()
+	if app.FutchainIndexer != nil {
+		err = errors.Join(err, app.FutchainIndexer.Close())
+	}
+	return err
+}
+
 // BeginBlocker application updates every begin block
 func (app *EVMD) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
 	return app.ModuleManager.BeginBlock(ctx)
@@ -1066,12 +1135,37 @@ func (app *EVMD) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfi
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the futchain match updates WebSocket.
+	futchainstream.RegisterWebSocketRoute(apiSvr.Router, app.FutchainStream, apiConfig.EnableUnsafeCORS)
+
+	// Register the futchain historical match routes, on the node of the API server.
+	if node, err := clientCtx.GetNode(); err == nil {
+		futchainhistory.RegisterRoutes(apiSvr.Router, futchainhistory.NewQuerier(node, futchaintypes.NewQueryClient(clientCtx)))
+	}
+
+	// Register the futchain indexer query routes, if enabled.
+	if app.FutchainIndexer != nil {
+		futchainindexer.RegisterRoutes(apiSvr.Router, app.FutchainIndexer)
+	}
+
+	// Register the futchain GraphQL endpoint, if enabled.
+	if app.FutchainGraphQL != nil {
+		futchaingraphql.RegisterRoute(apiSvr.Router, app.FutchainGraphQL)
+	}
+
 	// register swagger API from root so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
 	}
 }
 
+// RegisterGRPCServerWithSkipCheckHeader registers the gRPC query services, and the futchain stream service
+// next to them.
+func (app *EVMD) RegisterGRPCServerWithSkipCheckHeader(server gogogrpc.Server, skipCheckHeader bool) {
+	app.BaseApp.RegisterGRPCServerWithSkipCheckHeader(server, skipCheckHeader)
+	futchaintypes.RegisterStreamServer(server, futchainstream.NewStreamServer(app.FutchainStream))
+}
+
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *EVMD) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.interfaceRegistry)
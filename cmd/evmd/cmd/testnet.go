@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/evm/evmd"
 	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 	evmdconfig "github.com/raifpy/futchain/cmd/evmd/config"
+	futchainevmrpc "github.com/raifpy/futchain/x/futchain/evmrpc"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
@@ -315,7 +316,7 @@ func initTestnetFiles(
 			evmCfg.JSONRPC.WsAddress = fmt.Sprintf("127.0.0.1:%d", evmJSONRPCWS+evmPortOffset)
 			evmCfg.JSONRPC.Enable = true
 			evmCfg.JSONRPC.EnableIndexer = true
-			evmCfg.JSONRPC.API = []string{"eth", "txpool", "personal", "net", "debug", "web3"}
+			evmCfg.JSONRPC.API = []string{"eth", "txpool", "personal", "net", "debug", "web3", futchainevmrpc.Namespace}
 			evmCfg.API.Enable = true
 		}
 		nodeDirName := fmt.Sprintf("%s%d", args.nodeDirPrefix, i)
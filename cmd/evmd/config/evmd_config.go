@@ -22,6 +22,11 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	futchainevmrpc "github.com/raifpy/futchain/x/futchain/evmrpc"
+	futchaingraphql "github.com/raifpy/futchain/x/futchain/graphql"
+	futchainindexer "github.com/raifpy/futchain/x/futchain/indexer"
+	futchaintypes "github.com/raifpy/futchain/x/futchain/types"
 )
 
 func MustGetDefaultNodeHome() string {
@@ -47,6 +52,12 @@ var maccPerms = map[string][]string{
 	feemarkettypes.ModuleName:   nil,
 	erc20types.ModuleName:       {authtypes.Minter, authtypes.Burner},
 	precisebanktypes.ModuleName: {authtypes.Minter, authtypes.Burner},
+
+	// Futchain modules
+	futchaintypes.ModuleName:              {authtypes.Minter, authtypes.Burner},
+	futchaintypes.OracleRewardsPoolName:   nil,
+	futchaintypes.ReporterBondsPoolName:   nil,
+	futchaintypes.ReporterRewardsPoolName: nil,
 }
 
 // BlockedAddresses returns all the app's blocked account addresses.
@@ -93,6 +104,9 @@ type EVMAppConfig struct {
 	EVM     cosmosevmserverconfig.EVMConfig
 	JSONRPC cosmosevmserverconfig.JSONRPCConfig
 	TLS     cosmosevmserverconfig.TLSConfig
+
+	FutchainIndexer futchainindexer.Config `mapstructure:"futchain-indexer"`
+	FutchainGraphQL futchaingraphql.Config `mapstructure:"futchain-graphql"`
 }
 
 // InitAppConfig helps to override default appConfig template and configs.
@@ -118,14 +132,22 @@ func InitAppConfig(denom string, evmChainID uint64) (string, interface{}) {
 	evmCfg := cosmosevmserverconfig.DefaultEVMConfig()
 	evmCfg.EVMChainID = evmChainID
 
+	// the futchain log APIs override the eth log filter methods, they are registered after eth
+	jsonRPCCfg := cosmosevmserverconfig.DefaultJSONRPCConfig()
+	jsonRPCCfg.API = append(jsonRPCCfg.API, futchainevmrpc.Namespace)
+
 	customAppConfig := EVMAppConfig{
 		Config:  *srvCfg,
 		EVM:     *evmCfg,
-		JSONRPC: *cosmosevmserverconfig.DefaultJSONRPCConfig(),
+		JSONRPC: *jsonRPCCfg,
 		TLS:     *cosmosevmserverconfig.DefaultTLSConfig(),
+
+		FutchainIndexer: futchainindexer.DefaultConfig(),
+		FutchainGraphQL: futchaingraphql.DefaultConfig(),
 	}
 
 	return EVMAppTemplate, customAppConfig
 }
 
-const EVMAppTemplate = serverconfig.DefaultConfigTemplate + cosmosevmserverconfig.DefaultEVMConfigTemplate
+const EVMAppTemplate = serverconfig.DefaultConfigTemplate + cosmosevmserverconfig.DefaultEVMConfigTemplate + futchainindexer.DefaultConfigTemplate +
+	futchaingraphql.DefaultConfigTemplate
@@ -0,0 +1,303 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+const (
+	flagLeague    = "league"
+	flagTeam      = "team"
+	flagDate      = "date"
+	flagLive      = "live"
+	flagFinalized = "finalized"
+
+	// pageSize is the page size of the queries listing all the selected matches.
+	pageSize = 100
+)
+
+// matchColumns are the columns of the matches command.
+var matchColumns = []string{"id", "kickoff", "league", "home", "home_score", "away_score", "away", "status", "finalized"}
+
+// CmdMatches lists the matches selected by a league, a team, a kick-off date and a live status.
+func CmdMatches() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "matches",
+		Short: "List the matches, filtered by league, team, kick-off date and live status",
+		Long: `List the matches selected by the filters, in id order, as a table, JSON or CSV. The date is
+the UTC kick-off date, YYYY-MM-DD or today.`,
+		Example: fmt.Sprintf(`%[1]s query %[2]s matches --league 47 --date 2026-03-01
+%[1]s query %[2]s matches --live -o csv
+%[1]s query %[2]s matches --league 47 --at-time 2026-03-01T21:30:00Z`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			format, err := outputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMatchesRequest{}
+			req.LeagueId, _ = cmd.Flags().GetInt64(flagLeague)
+			req.TeamId, _ = cmd.Flags().GetInt64(flagTeam)
+			if date, _ := cmd.Flags().GetString(flagDate); date != "" {
+				day, err := parseDate(date, time.Now())
+				if err != nil {
+					return err
+				}
+				req.FromTime, req.ToTime = day.Unix(), day.Add(24*time.Hour).Unix()-1
+			}
+			if live, _ := cmd.Flags().GetBool(flagLive); live {
+				req.Status = types.MATCH_STATUS_FILTER_LIVE
+			}
+
+			ctx, err := atTimeContext(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			matches, err := queryMatches(ctx, queryClient, req)
+			if err != nil {
+				return err
+			}
+			leagues, err := leagueNames(ctx, queryClient, matches, nil)
+			if err != nil {
+				return err
+			}
+			return matchesTable(matches, leagues).print(cmd.OutOrStdout(), format)
+		},
+	}
+
+	cmd.Flags().Int64(flagLeague, 0, "Select the matches of a league")
+	cmd.Flags().Int64(flagTeam, 0, "Select the matches played by a team, home or away")
+	cmd.Flags().String(flagDate, "", "Select the matches kicking off on a UTC date, YYYY-MM-DD or today")
+	cmd.Flags().Bool(flagLive, false, "Select the started matches not over yet")
+	addAtTimeFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	setOutputFlag(cmd)
+	return cmd
+}
+
+// parseDate parses a YYYY-MM-DD date, or today, to the start of the UTC day.
+func parseDate(date string, now time.Time) (time.Time, error) {
+	if date == "today" {
+		return now.UTC().Truncate(24 * time.Hour), nil
+	}
+	day, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD or today", date)
+	}
+	return day, nil
+}
+
+// queryMatches returns all the matches selected by a request, page after page.
+func queryMatches(ctx context.Context, queryClient types.QueryClient, req *types.QueryMatchesRequest, opts ...grpc.CallOption) ([]types.Match, error) {
+	var matches []types.Match
+	req.Pagination = &query.PageRequest{Limit: pageSize}
+	for {
+		res, err := queryClient.Matches(ctx, req, opts...)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, res.Matches...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return matches, nil
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+// leagueNames adds the names of the leagues of the matches to the names by league id, which may be nil. A
+// league missing from the chain has an empty name.
+func leagueNames(ctx context.Context, queryClient types.QueryClient, matches []types.Match, names map[int64]string) (map[int64]string, error) {
+	if names == nil {
+		names = map[int64]string{}
+	}
+	for _, match := range matches {
+		if err := leagueName(ctx, queryClient, match.LeagueId, names); err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+// leagueName adds the name of a league to the names by league id, if it is not known yet.
+func leagueName(ctx context.Context, queryClient types.QueryClient, leagueID int64, names map[int64]string) error {
+	if _, ok := names[leagueID]; ok {
+		return nil
+	}
+	res, err := queryClient.League(ctx, &types.QueryLeagueRequest{Id: leagueID})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		names[leagueID] = ""
+	case err != nil:
+		return err
+	default:
+		names[leagueID] = res.League.Name
+	}
+	return nil
+}
+
+// matchesTable returns the rows of the matches command.
+func matchesTable(matches []types.Match, leagues map[int64]string) table {
+	t := table{columns: matchColumns}
+	for _, m := range matches {
+		s := m.Status
+		t.rows = append(t.rows, []any{
+			m.Id, s.UtcTime, leagues[m.LeagueId], m.Home.Name, m.HomeScore, m.AwayScore, m.Away.Name,
+			matchStatus(s.Started, s.Finished, s.Cancelled, m.Finalized, s.LiveTime.Long), m.Finalized,
+		})
+	}
+	return t
+}
+
+// matchStatus returns the status of a match: upcoming, the live time of a started match, finished, final
+// once the result is finalized, or cancelled.
+func matchStatus(started, finished, cancelled, finalized bool, liveTime string) string {
+	switch {
+	case cancelled:
+		return "cancelled"
+	case finished && finalized:
+		return "final"
+	case finished:
+		return "finished"
+	case started && liveTime != "":
+		return "live " + liveTime
+	case started:
+		return "live"
+	default:
+		return "upcoming"
+	}
+}
+
+// standingColumns are the columns of the standings command.
+var standingColumns = []string{"position", "team", "played", "won", "drawn", "lost", "goals_for", "goals_against", "goal_difference", "points"}
+
+// CmdStandings prints the standings of a league, computed from its finished matches.
+func CmdStandings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "standings [league-id]",
+		Short: "Print the standings of a league, from its finished matches",
+		Long: `Print the standings of a league, computed from the results of its finished matches: three
+points for a win and one for a draw, ranked by points, goal difference, then goals scored. With
+--finalized, only the results which survived the dispute window are counted. With --at-time, the
+standings are computed from the state of the chain at that time.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			format, err := outputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			leagueID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid league id %s: %w", args[0], err)
+			}
+
+			req := &types.QueryMatchesRequest{LeagueId: leagueID, Status: types.MATCH_STATUS_FILTER_FINISHED}
+			if finalized, _ := cmd.Flags().GetBool(flagFinalized); finalized {
+				req.Status = types.MATCH_STATUS_FILTER_FINALIZED
+			}
+			ctx, err := atTimeContext(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			matches, err := queryMatches(ctx, types.NewQueryClient(clientCtx), req)
+			if err != nil {
+				return err
+			}
+			return standingsTable(matches).print(cmd.OutOrStdout(), format)
+		},
+	}
+
+	cmd.Flags().Bool(flagFinalized, false, "Count the finalized results only")
+	addAtTimeFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	setOutputFlag(cmd)
+	return cmd
+}
+
+// standing is the record of a team in a league.
+type standing struct {
+	team                           string
+	played, won, drawn, lost       int64
+	goalsFor, goalsAgainst, points int64
+}
+
+// standingsTable returns the standings computed from the results of finished matches.
+func standingsTable(matches []types.Match) table {
+	standings := map[int64]*standing{}
+	record := func(team types.Team, scored, conceded int64) {
+		s, ok := standings[team.Id]
+		if !ok {
+			s = &standing{team: team.Name}
+			standings[team.Id] = s
+		}
+		s.played++
+		s.goalsFor += scored
+		s.goalsAgainst += conceded
+		switch {
+		case scored > conceded:
+			s.won++
+			s.points += 3
+		case scored == conceded:
+			s.drawn++
+			s.points++
+		default:
+			s.lost++
+		}
+	}
+	for _, m := range matches {
+		if !m.Status.Finished || m.Status.Cancelled {
+			continue
+		}
+		record(m.Home, m.HomeScore, m.AwayScore)
+		record(m.Away, m.AwayScore, m.HomeScore)
+	}
+
+	ranked := make([]*standing, 0, len(standings))
+	for _, s := range standings {
+		ranked = append(ranked, s)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if a.points != b.points {
+			return a.points > b.points
+		}
+		if a.goalsFor-a.goalsAgainst != b.goalsFor-b.goalsAgainst {
+			return a.goalsFor-a.goalsAgainst > b.goalsFor-b.goalsAgainst
+		}
+		if a.goalsFor != b.goalsFor {
+			return a.goalsFor > b.goalsFor
+		}
+		return a.team < b.team
+	})
+
+	t := table{columns: standingColumns}
+	for i, s := range ranked {
+		t.rows = append(t.rows, []any{
+			i + 1, s.team, s.played, s.won, s.drawn, s.lost, s.goalsFor, s.goalsAgainst, s.goalsFor - s.goalsAgainst, s.points,
+		})
+	}
+	return t
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestStandingsTable(t *testing.T) {
+	a, b, c := types.Team{Id: 1, Name: "A"}, types.Team{Id: 2, Name: "B"}, types.Team{Id: 3, Name: "C"}
+	finished := types.Status{Started: true, Finished: true}
+	matches := []types.Match{
+		{Id: 1, Home: a, Away: b, HomeScore: 2, AwayScore: 0, Status: finished},
+		{Id: 2, Home: b, Away: c, HomeScore: 1, AwayScore: 1, Status: finished},
+		{Id: 3, Home: c, Away: a, HomeScore: 3, AwayScore: 1, Status: finished},
+		// the unfinished and cancelled matches are not counted
+		{Id: 4, Home: a, Away: c, HomeScore: 5, Status: types.Status{Started: true}},
+		{Id: 5, Home: b, Away: a, HomeScore: 5, Status: types.Status{Finished: true, Cancelled: true}},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, standingsTable(matches).print(&out, outputCSV))
+	require.Equal(t, `position,team,played,won,drawn,lost,goals_for,goals_against,goal_difference,points
+1,C,2,1,1,0,4,2,2,4
+2,A,2,1,0,1,3,3,0,3
+3,B,2,0,1,1,1,3,-2,1
+`, out.String())
+}
+
+func TestMatchesTable(t *testing.T) {
+	kickoff := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	matches := []types.Match{{
+		Id: 10, LeagueId: 47, Home: types.Team{Name: "Home"}, Away: types.Team{Name: "Away"}, HomeScore: 2, AwayScore: 1,
+		Status: types.Status{UtcTime: kickoff, Started: true, LiveTime: types.LiveTime{Long: "67:12"}},
+	}}
+	leagues := map[int64]string{47: "Premier League"}
+
+	var out bytes.Buffer
+	require.NoError(t, matchesTable(matches, leagues).print(&out, outputTable))
+	require.Equal(t, `ID  KICKOFF           LEAGUE          HOME  HOME SCORE  AWAY SCORE  AWAY  STATUS      FINALIZED
+10  2026-03-01 20:00  Premier League  Home  2           1           Away  live 67:12  no
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, matchesTable(matches, leagues).print(&out, outputJSON))
+	require.JSONEq(t, `[{"id": 10, "kickoff": "2026-03-01T20:00:00Z", "league": "Premier League", "home": "Home", "home_score": 2,
+		"away_score": 1, "away": "Away", "status": "live 67:12", "finalized": false}]`, out.String())
+}
+
+func TestMatchStatus(t *testing.T) {
+	require.Equal(t, "upcoming", matchStatus(false, false, false, false, ""))
+	require.Equal(t, "live", matchStatus(true, false, false, false, ""))
+	require.Equal(t, "live 45+2'", matchStatus(true, false, false, false, "45+2'"))
+	require.Equal(t, "finished", matchStatus(true, true, false, false, ""))
+	require.Equal(t, "final", matchStatus(true, true, false, true, ""))
+	require.Equal(t, "cancelled", matchStatus(false, false, true, false, ""))
+}
+
+func TestParseDate(t *testing.T) {
+	day, err := parseDate("2026-03-01", time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), day)
+
+	day, err = parseDate("today", time.Date(2026, 3, 1, 23, 30, 0, 0, time.FixedZone("", -2*3600)))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), day)
+
+	_, err = parseDate("01/03/2026", time.Time{})
+	require.ErrorContains(t, err, "invalid date")
+}
+
+func TestParsePriority(t *testing.T) {
+	for input, expected := range map[string]types.MatchUpdatePriority{
+		"":                               types.MATCH_UPDATE_PRIORITY_NO_CHANGES,
+		"8":                              types.MATCH_UPDATE_PRIORITY_SCORE,
+		"live-time":                      types.MATCH_UPDATE_PRIORITY_LIVE_TIME,
+		"MATCH_UPDATE_PRIORITY_FINISHED": types.MATCH_UPDATE_PRIORITY_FINISHED,
+	} {
+		priority, err := parsePriority(input)
+		require.NoError(t, err)
+		require.Equal(t, expected, priority)
+	}
+	_, err := parsePriority("goal")
+	require.ErrorContains(t, err, "invalid min priority")
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/raifpy/futchain/x/futchain/history"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+const flagAtTime = "at-time"
+
+// diffColumns are the columns of the diff command.
+var diffColumns = []string{"field", "from", "to", "priority"}
+
+// historyQuerier returns the querier of the matches as of a height, through the node of a client context.
+func historyQuerier(clientCtx client.Context) (history.Querier, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return history.Querier{}, err
+	}
+	return history.NewQuerier(node, types.NewQueryClient(clientCtx)), nil
+}
+
+// addAtTimeFlag adds the flag querying the state of the chain at a block time.
+func addAtTimeFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagAtTime, "", "Query the state of the chain at an RFC 3339 time, resolved to the height of the last block not after it")
+}
+
+// atTimeContext returns the context of the queries of a command, querying the state at the time of the
+// at-time flag, if set.
+func atTimeContext(cmd *cobra.Command, clientCtx client.Context) (context.Context, error) {
+	at, _ := cmd.Flags().GetString(flagAtTime)
+	if at == "" {
+		return cmd.Context(), nil
+	}
+	t, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q, expected an RFC 3339 time", at)
+	}
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	height, _, err := history.HeightAt(cmd.Context(), node, t)
+	if err != nil {
+		return nil, err
+	}
+	return history.WithHeight(cmd.Context(), height), nil
+}
+
+// CmdMatchAt queries a match as of a height or a block time.
+func CmdMatchAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "match-at [match-id] [height-or-time]",
+		Short: "Query a match as of a height or a block time",
+		Long: `Query the state of a match as of a height, or as of an RFC 3339 time resolved to the height of the
+last block not after it, with that height and its block time. The height must be in the pruning window of
+the node.`,
+		Example: fmt.Sprintf(`%[1]s query %[2]s match-at 4506520 1200
+%[1]s query %[2]s match-at 4506520 2026-03-01T21:30:00Z`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			matchID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid match id %s: %w", args[0], err)
+			}
+			querier, err := historyQuerier(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			match, err := querier.Match(cmd.Context(), matchID, args[1])
+			if err != nil {
+				return err
+			}
+			bz, err := json.Marshal(match)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdDiff prints the fields of a match changed between two heights or block times.
+func CmdDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [match-id] [from] [to]",
+		Short: "Print the fields of a match changed between two heights or block times",
+		Long: `Print the fields of a match changed between two heights or RFC 3339 times, to the latest height if
+to is omitted. Each change is ranked as by the data source comparison of the ingested matches, e.g. score;
+the table output starts with the rank of the whole change.`,
+		Example: fmt.Sprintf(`%[1]s query %[2]s diff 4506520 1200 1350
+%[1]s query %[2]s diff 4506520 2026-03-01T21:00:00Z 2026-03-01T22:00:00Z -o json`, version.AppName, types.ModuleName),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			format, err := outputFormat(cmd)
+			if err != nil {
+				return err
+			}
+			matchID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid match id %s: %w", args[0], err)
+			}
+			querier, err := historyQuerier(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			to := ""
+			if len(args) == 3 {
+				to = args[2]
+			}
+			diff, err := querier.Diff(cmd.Context(), matchID, args[1], to)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			switch format {
+			case outputJSON:
+				return json.NewEncoder(out).Encode(diff)
+			case outputTable:
+				priority := diff.Priority
+				if priority == "" {
+					priority = "no changes"
+				}
+				fmt.Fprintf(out, "match %d, height %d to %d: %s\n\n", diff.MatchID, diff.FromHeight, diff.ToHeight, priority)
+			}
+			return diffTable(diff).print(out, format)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	setOutputFlag(cmd)
+	return cmd
+}
+
+// diffTable returns the rows of the diff command.
+func diffTable(diff history.MatchDiff) table {
+	t := table{columns: diffColumns}
+	for _, change := range diff.Changes {
+		t.rows = append(t.rows, []any{change.Field, change.From, change.To, change.Priority})
+	}
+	return t
+}
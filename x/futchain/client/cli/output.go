@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// The output formats of the browsing commands. The text format of the query flags is the table format.
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputCSV   = "csv"
+)
+
+// setOutputFlag makes the output flag added by the query flags select the table, JSON or CSV format.
+func setOutputFlag(cmd *cobra.Command) {
+	flag := cmd.Flags().Lookup(flags.FlagOutput)
+	flag.Usage = "Output format (table|json|csv)"
+	flag.DefValue = outputTable
+	_ = flag.Value.Set(outputTable)
+}
+
+// outputFormat returns the output format of a browsing command.
+func outputFormat(cmd *cobra.Command) (string, error) {
+	format, _ := cmd.Flags().GetString(flags.FlagOutput)
+	switch format = strings.ToLower(format); format {
+	case "text", "":
+		return outputTable, nil
+	case outputTable, outputJSON, outputCSV:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid output format %q, expected %s, %s or %s", format, outputTable, outputJSON, outputCSV)
+	}
+}
+
+// table is the output of a browsing command. The columns are the keys of the JSON objects and the header of
+// the CSV output, and the upper-cased headers of the table output.
+type table struct {
+	columns []string
+	rows    [][]any
+}
+
+// print writes the table in an output format: an aligned table, a JSON array of objects, or CSV.
+func (t table) print(w io.Writer, format string) error {
+	switch format {
+	case outputJSON:
+		objects := make([]map[string]any, len(t.rows))
+		for i := range t.rows {
+			objects[i] = t.object(i)
+		}
+		return json.NewEncoder(w).Encode(objects)
+	case outputCSV:
+		return t.writeCSV(w, true)
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		headers := make([]string, len(t.columns))
+		for i, column := range t.columns {
+			headers[i] = strings.ToUpper(strings.ReplaceAll(column, "_", " "))
+		}
+		fmt.Fprintln(tw, strings.Join(headers, "\t"))
+		for _, row := range t.rows {
+			cells := make([]string, len(row))
+			for i, value := range row {
+				cells[i] = tableCell(value)
+			}
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+		return tw.Flush()
+	}
+}
+
+// object returns a row as a JSON object.
+func (t table) object(i int) map[string]any {
+	object := make(map[string]any, len(t.columns))
+	for j, column := range t.columns {
+		object[column] = t.rows[i][j]
+	}
+	return object
+}
+
+// printJSONLine writes an object as a line of JSON, for the outputs streaming a row at a time.
+func printJSONLine(w io.Writer, object map[string]any) error {
+	return json.NewEncoder(w).Encode(object)
+}
+
+// writeCSV writes the rows as CSV, after the header if set.
+func (t table) writeCSV(w io.Writer, header bool) error {
+	cw := csv.NewWriter(w)
+	if header {
+		if err := cw.Write(t.columns); err != nil {
+			return err
+		}
+	}
+	for _, row := range t.rows {
+		cells := make([]string, len(row))
+		for i, value := range row {
+			cells[i] = csvCell(value)
+		}
+		if err := cw.Write(cells); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// tableCell formats a value for the table output: times in minutes, in UTC.
+func tableCell(value any) string {
+	switch v := value.(type) {
+	case time.Time:
+		if v.IsZero() {
+			return "-"
+		}
+		return v.UTC().Format("2006-01-02 15:04")
+	case bool:
+		if v {
+			return "yes"
+		}
+		return "no"
+	}
+	return csvCell(value)
+}
+
+// csvCell formats a value for the CSV output, as in the JSON output.
+func csvCell(value any) string {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int:
+		return strconv.Itoa(v)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/raifpy/futchain/x/futchain/proof"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+const flagEVM = "evm"
+
+// GetQueryCmd returns the custom query commands of the module. The commands of the query service are added
+// to it by autocli.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdMatchWithProof(), CmdMatches(), CmdStandings(), CmdWatch(), CmdMatchAt(), CmdDiff())
+	return cmd
+}
+
+// matchWithProofOutput is the output of the match-with-proof command.
+type matchWithProofOutput struct {
+	proof.MatchWithProof
+	AppHash  cmtbytes.HexBytes `json:"app_hash"`
+	EVMProof *proof.EVMProof   `json:"evm_proof,omitempty"`
+}
+
+// CmdMatchWithProof queries the result record of a match with its proof against the app hash.
+func CmdMatchWithProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "match-with-proof [match-id]",
+		Short: "Query the result of a match with its merkle proof against the app hash",
+		Long: `Query the result record of a match with its ICS-23 proof against the app hash committed for the
+queried height, which is in the header of the next block. The proof is verified before it is printed.
+Without --height, the latest height with a committed app hash is queried. With --evm, the compact proofs
+for the FutchainProofVerifier solidity library are printed too.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			matchID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid match id %s: %w", args[0], err)
+			}
+
+			height := clientCtx.Height
+			if height == 0 {
+				if height, err = proof.QueryLatestProvableHeight(cmd.Context(), clientCtx); err != nil {
+					return err
+				}
+			}
+
+			m, err := proof.QueryMatchWithProof(clientCtx, matchID, height)
+			if err != nil {
+				return err
+			}
+			appHash, err := proof.QueryAppHash(cmd.Context(), clientCtx, m.Height)
+			if err != nil {
+				return err
+			}
+			if err := m.Verify(appHash); err != nil {
+				return fmt.Errorf("invalid proof: %w", err)
+			}
+
+			out := matchWithProofOutput{MatchWithProof: m, AppHash: appHash}
+			if evm, _ := cmd.Flags().GetBool(flagEVM); evm {
+				evmProof, err := m.EVMProof()
+				if err != nil {
+					return err
+				}
+				out.EVMProof = &evmProof
+			}
+
+			bz, err := json.Marshal(out)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	cmd.Flags().Bool(flagEVM, false, "Print the compact proofs for the FutchainProofVerifier solidity library")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,245 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+const flagMinPriority = "min-priority"
+
+// scoreboardColumns are the columns of the watch command.
+var scoreboardColumns = []string{"height", "id", "league", "home", "home_score", "away_score", "away", "status"}
+
+// clearScreen moves the cursor home and clears the terminal, to redraw the scoreboard.
+const clearScreen = "\x1b[H\x1b[2J"
+
+// CmdWatch tails the match updates of the committed blocks and prints a live scoreboard.
+func CmdWatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Tail the match updates and print a live scoreboard",
+		Long: `Tail the match updates of the committed blocks, streamed by the gRPC server of the node set by
+--grpc-addr, starting from the live matches. The table output redraws the scoreboard on each update; the
+JSON and CSV outputs print a line per update. The min priority drops the minor updates, e.g. score only
+prints the score changes and the new matches.`,
+		Example: fmt.Sprintf(`%[1]s query %[2]s watch --grpc-addr localhost:9090 --league 47
+%[1]s query %[2]s watch --grpc-addr localhost:9090 --min-priority score -o json`, version.AppName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GRPCClient == nil {
+				return fmt.Errorf("the match updates are streamed by the gRPC server of the node, set it with --%s", flags.FlagGRPC)
+			}
+			format, err := outputFormat(cmd)
+			if err != nil {
+				return err
+			}
+
+			leagueID, _ := cmd.Flags().GetInt64(flagLeague)
+			teamID, _ := cmd.Flags().GetInt64(flagTeam)
+			req := &types.WatchMatchesRequest{}
+			if leagueID != 0 {
+				req.LeagueIds = []int64{leagueID}
+			}
+			if teamID != 0 {
+				req.TeamIds = []int64{teamID}
+			}
+			priority, _ := cmd.Flags().GetString(flagMinPriority)
+			if req.MinPriority, err = parsePriority(priority); err != nil {
+				return err
+			}
+
+			// the stream is opened before the live matches are queried, not to miss the updates in between
+			ctx, cancel := context.WithCancel(cmd.Context())
+			defer cancel()
+			stream, err := types.NewStreamClient(clientCtx.GRPCClient).WatchMatches(ctx, req)
+			if err != nil {
+				return err
+			}
+			var header metadata.MD
+			queryClient := types.NewQueryClient(clientCtx)
+			live, err := queryMatches(ctx, queryClient, &types.QueryMatchesRequest{
+				LeagueId: leagueID,
+				TeamId:   teamID,
+				Status:   types.MATCH_STATUS_FILTER_LIVE,
+			}, grpc.Header(&header))
+			if err != nil {
+				return err
+			}
+			var height int64
+			if values := header.Get(grpctypes.GRPCBlockHeightHeader); len(values) == 1 {
+				height, _ = strconv.ParseInt(values[0], 10, 64)
+			}
+
+			board := newScoreboard(ctx, queryClient, cmd.OutOrStdout(), format)
+			for _, m := range live {
+				board.matches[m.Id] = scoreboardMatch{height: height, MatchState: types.MatchState{
+					Id: m.Id, LeagueId: m.LeagueId, HomeName: m.Home.Name, AwayName: m.Away.Name,
+					HomeScore: m.HomeScore, AwayScore: m.AwayScore, Started: m.Status.Started,
+					Finished: m.Status.Finished, Cancelled: m.Status.Cancelled, LiveTime: m.Status.LiveTime.Long,
+				}}
+			}
+			if err := board.redraw(); err != nil {
+				return err
+			}
+
+			for {
+				update, err := stream.Recv()
+				switch {
+				case errors.Is(err, io.EOF), status.Code(err) == codes.Canceled:
+					return nil
+				case err != nil:
+					return err
+				}
+				if err := board.update(update); err != nil {
+					return err
+				}
+			}
+		},
+	}
+
+	cmd.Flags().Int64(flagLeague, 0, "Watch the matches of a league")
+	cmd.Flags().Int64(flagTeam, 0, "Watch the matches played by a team, home or away")
+	cmd.Flags().String(flagMinPriority, "", "Drop the updates of a lower priority: live-time, period-length, status, ongoing, finished, started, cancelled or score")
+	flags.AddQueryFlagsToCmd(cmd)
+	setOutputFlag(cmd)
+	return cmd
+}
+
+// parsePriority parses a match update priority: a number, an enum name, or an enum name without its prefix,
+// e.g. live-time.
+func parsePriority(priority string) (types.MatchUpdatePriority, error) {
+	if priority == "" {
+		return types.MATCH_UPDATE_PRIORITY_NO_CHANGES, nil
+	}
+	if n, err := strconv.ParseInt(priority, 10, 32); err == nil {
+		if _, ok := types.MatchUpdatePriority_name[int32(n)]; ok {
+			return types.MatchUpdatePriority(n), nil
+		}
+	}
+	name := strings.ToUpper(strings.ReplaceAll(priority, "-", "_"))
+	if !strings.HasPrefix(name, "MATCH_UPDATE_PRIORITY_") {
+		name = "MATCH_UPDATE_PRIORITY_" + name
+	}
+	n, ok := types.MatchUpdatePriority_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid min priority %q", priority)
+	}
+	return types.MatchUpdatePriority(n), nil
+}
+
+// scoreboardMatch is the last state of a match on the scoreboard, and the height it changed at.
+type scoreboardMatch struct {
+	types.MatchState
+	height int64
+}
+
+// scoreboard prints the updated matches: the table output redraws all the matches, while the JSON and CSV
+// outputs print the updated match only.
+type scoreboard struct {
+	ctx         context.Context
+	queryClient types.QueryClient
+	w           io.Writer
+	format      string
+
+	matches map[int64]scoreboardMatch
+	leagues map[int64]string
+	header  bool
+}
+
+func newScoreboard(ctx context.Context, queryClient types.QueryClient, w io.Writer, format string) *scoreboard {
+	return &scoreboard{
+		ctx:         ctx,
+		queryClient: queryClient,
+		w:           w,
+		format:      format,
+		matches:     map[int64]scoreboardMatch{},
+		leagues:     map[int64]string{},
+	}
+}
+
+// update records a match update and prints it.
+func (b *scoreboard) update(update *types.WatchMatchesResponse) error {
+	m := scoreboardMatch{MatchState: update.Match, height: update.Height}
+	b.matches[m.Id] = m
+	if b.format == outputTable {
+		return b.redraw()
+	}
+	t, err := b.table([]scoreboardMatch{m})
+	if err != nil {
+		return err
+	}
+	if b.format == outputJSON {
+		return printJSONLine(b.w, t.object(0))
+	}
+	err = t.writeCSV(b.w, !b.header)
+	b.header = true
+	return err
+}
+
+// redraw prints the whole scoreboard in the table output. The JSON and CSV outputs print the matches as the
+// first updates.
+func (b *scoreboard) redraw() error {
+	matches := make([]scoreboardMatch, 0, len(b.matches))
+	for _, m := range b.matches {
+		matches = append(matches, m)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Id < matches[j].Id })
+	t, err := b.table(matches)
+	if err != nil {
+		return err
+	}
+
+	switch b.format {
+	case outputTable:
+		if _, err := io.WriteString(b.w, clearScreen); err != nil {
+			return err
+		}
+		return t.print(b.w, outputTable)
+	case outputJSON:
+		for i := range t.rows {
+			if err := printJSONLine(b.w, t.object(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		b.header = true
+		return t.writeCSV(b.w, true)
+	}
+}
+
+// table returns the rows of the matches, looking up the names of their leagues.
+func (b *scoreboard) table(matches []scoreboardMatch) (table, error) {
+	t := table{columns: scoreboardColumns}
+	for _, m := range matches {
+		if err := leagueName(b.ctx, b.queryClient, m.LeagueId, b.leagues); err != nil {
+			return table{}, err
+		}
+		t.rows = append(t.rows, []any{
+			m.height, m.Id, b.leagues[m.LeagueId], m.HomeName, m.HomeScore, m.AwayScore, m.AwayName,
+			matchStatus(m.Started, m.Finished, m.Cancelled, false, m.LiveTime),
+		})
+	}
+	return t, nil
+}
@@ -0,0 +1,131 @@
+pragma solidity >=0.8.17;
+
+// FutchainProofVerifier verifies match results of FutChain on other chains, with the proofs of their
+// result records against a committed FutChain app hash.
+//
+// A relayer queries the result with `evmd query futchain match-with-proof [match-id] --evm`, which
+// returns the compact IAVL and store proofs, and posts them with the app hash of the header at height+1.
+// Trusting that app hash, e.g. from a light client of FutChain, is up to the caller.
+library FutchainProofVerifier {
+    // A step of an existence proof: the hash of the child is sha256(prefix || child || suffix).
+    struct InnerOp {
+        bytes prefix;
+        bytes suffix;
+    }
+
+    // The compact form of an ICS-23 existence proof with sha256 hashing and varint lengths, as used by
+    // both the IAVL store and the multistore of FutChain.
+    struct ExistenceProof {
+        bytes key;
+        bytes value;
+        bytes leafPrefix;
+        InnerOp[] path;
+    }
+
+    // The result record of a match, see types.MatchResult.
+    struct MatchResult {
+        uint64 matchId;
+        uint32 homeScore;
+        uint32 awayScore;
+        bool started;
+        bool finished;
+        bool cancelled;
+        bool finalized;
+    }
+
+    bytes constant STORE_KEY = "futchain";
+    bytes constant RESULT_KEY_PREFIX = "results";
+    uint256 constant RESULT_SIZE = 17;
+
+    uint8 constant RESULT_STARTED = 1;
+    uint8 constant RESULT_FINISHED = 2;
+    uint8 constant RESULT_CANCELLED = 4;
+    uint8 constant RESULT_FINALIZED = 8;
+
+    // Verifies the result record of a match against an app hash and decodes it.
+    function verifyMatchResult(
+        bytes32 appHash,
+        ExistenceProof memory iavlProof,
+        ExistenceProof memory storeProof
+    ) internal pure returns (MatchResult memory result) {
+        require(verify(appHash, iavlProof, storeProof), "FutchainProofVerifier: invalid proof");
+
+        bytes memory key = iavlProof.key;
+        require(key.length == RESULT_KEY_PREFIX.length + 8, "FutchainProofVerifier: not a match result key");
+        for (uint256 i = 0; i < RESULT_KEY_PREFIX.length; i++) {
+            require(key[i] == RESULT_KEY_PREFIX[i], "FutchainProofVerifier: not a match result key");
+        }
+
+        result = decodeMatchResult(iavlProof.value);
+        require(result.matchId == readUint(key, RESULT_KEY_PREFIX.length, 8), "FutchainProofVerifier: match id mismatch");
+    }
+
+    // Verifies that the value of a key of the futchain store is committed to by the app hash.
+    function verify(
+        bytes32 appHash,
+        ExistenceProof memory iavlProof,
+        ExistenceProof memory storeProof
+    ) internal pure returns (bool) {
+        if (keccak256(storeProof.key) != keccak256(STORE_KEY)) {
+            return false;
+        }
+        if (storeProof.value.length != 32 || bytes32(storeProof.value) != computeRoot(iavlProof)) {
+            return false;
+        }
+        return computeRoot(storeProof) == appHash;
+    }
+
+    // Computes the root hash of an existence proof. Leaves are prefixed with 0x00 and inner nodes with a
+    // non-zero byte, which rules out second preimages.
+    function computeRoot(ExistenceProof memory proof) internal pure returns (bytes32 hash) {
+        require(proof.key.length > 0 && proof.value.length > 0, "FutchainProofVerifier: empty leaf");
+        require(proof.leafPrefix.length > 0 && proof.leafPrefix[0] == 0x00, "FutchainProofVerifier: invalid leaf prefix");
+
+        hash = sha256(abi.encodePacked(
+            proof.leafPrefix,
+            encodeVarint(proof.key.length),
+            proof.key,
+            encodeVarint(32),
+            sha256(proof.value)
+        ));
+
+        for (uint256 i = 0; i < proof.path.length; i++) {
+            InnerOp memory op = proof.path[i];
+            require(op.prefix.length > 0 && op.prefix[0] != 0x00, "FutchainProofVerifier: invalid inner prefix");
+            hash = sha256(abi.encodePacked(op.prefix, hash, op.suffix));
+        }
+    }
+
+    // Decodes a match result record: the big endian match id (8 bytes), home score (4 bytes), away score
+    // (4 bytes) and a byte of flags.
+    function decodeMatchResult(bytes memory value) internal pure returns (MatchResult memory result) {
+        require(value.length == RESULT_SIZE, "FutchainProofVerifier: invalid match result");
+
+        uint8 flags = uint8(value[16]);
+        result = MatchResult({
+            matchId: uint64(readUint(value, 0, 8)),
+            homeScore: uint32(readUint(value, 8, 4)),
+            awayScore: uint32(readUint(value, 12, 4)),
+            started: flags & RESULT_STARTED != 0,
+            finished: flags & RESULT_FINISHED != 0,
+            cancelled: flags & RESULT_CANCELLED != 0,
+            finalized: flags & RESULT_FINALIZED != 0
+        });
+    }
+
+    // Encodes an unsigned protobuf varint.
+    function encodeVarint(uint256 value) internal pure returns (bytes memory out) {
+        while (value >= 0x80) {
+            out = abi.encodePacked(out, uint8(value & 0x7f) | 0x80);
+            value >>= 7;
+        }
+        out = abi.encodePacked(out, uint8(value));
+    }
+
+    // Reads a big endian unsigned integer of size bytes at offset.
+    function readUint(bytes memory data, uint256 offset, uint256 size) private pure returns (uint256 value) {
+        for (uint256 i = 0; i < size; i++) {
+            value = (value << 8) | uint8(data[offset + i]);
+        }
+    }
+}
@@ -30,8 +30,53 @@ struct TeamData {
     string name;
 }
 
+// Match finality states, see getMatchFinality
+uint8 constant FINALITY_OPEN = 0;      // not finished nor cancelled
+uint8 constant FINALITY_PENDING = 1;   // finished or cancelled, the result may still be revised
+uint8 constant FINALITY_FINALIZED = 2; // the result is final, settle on it
+
+// Data provider key types, see getMatchAttestation
+uint8 constant PROVIDER_KEY_TYPE_NONE = 0;      // the provider is not registered anymore
+uint8 constant PROVIDER_KEY_TYPE_ED25519 = 1;   // signs the payload
+uint8 constant PROVIDER_KEY_TYPE_SECP256K1 = 2; // signs sha256(payload), 64 byte r || s signature
+
+// Prediction market types, see MarketType in the futchain proto
+uint8 constant MARKET_TYPE_MATCH_RESULT = 1; // outcomes: 0 home, 1 draw, 2 away
+uint8 constant MARKET_TYPE_OVER_UNDER = 2;   // outcomes: 0 under, 1 over the line
+uint8 constant MARKET_TYPE_CORRECT_SCORE = 3; // outcome: homeScore * 100 + awayScore
+
+// Prediction market statuses, see MarketStatus in the futchain proto
+uint8 constant MARKET_STATUS_OPEN = 1;
+uint8 constant MARKET_STATUS_SETTLED = 2;
+uint8 constant MARKET_STATUS_REFUNDED = 3;
+
+struct MarketData {
+    uint256 id;
+    uint256 matchId;
+    uint8 marketType;
+    uint64 line;
+    uint8 status;
+    uint64 winningOutcome;
+    uint256 totalStaked;
+}
+
 // Futchain Interface Contract
 interface FutI {
+    /// @notice Emitted when a league is seen for the first time
+    event NewLeague(uint256 indexed leagueId, string name);
+
+    /// @notice Emitted when a match is seen for the first time
+    event NewMatch(uint256 indexed matchId, uint256 indexed leagueId, uint256 homeId, uint256 awayId);
+
+    /// @notice Emitted when the score of a match changes
+    event MatchScoreChanged(uint256 indexed matchId, uint256 homeScore, uint256 awayScore);
+
+    /// @notice Emitted when a match is finished or cancelled
+    event MatchFinished(uint256 indexed matchId, uint256 homeScore, uint256 awayScore, bool cancelled);
+
+    /// @notice Emitted when the result of a match is final, after the dispute window
+    event MatchFinalized(uint256 indexed matchId, uint256 homeScore, uint256 awayScore, bool cancelled);
+
     /// @notice Get match details by ID
     /// @param matchId The match ID to query
     /// @return match The match data structure
@@ -46,10 +91,105 @@ interface FutI {
     /// @param teamId The team ID to query
     /// @return team The team data structure
     function getTeam(uint256 teamId) external view returns (TeamData memory);
-    
+
+    /// @notice Search the teams by name, ignoring case and diacritics, e.g. "fenerbahce" finds Fenerbahçe
+    /// @dev Matches the teams with a word of their name or long name starting with the query, exact matches first.
+    /// Reverts if the query has no letters nor digits.
+    /// @param query The name of the team, or the start of a word of it
+    /// @param limit The max number of teams returned, 20 if zero and at most 100
+    /// @return teams The matching teams
+    function searchTeams(string calldata query, uint256 limit) external view returns (TeamData[] memory);
+
+    /// @notice Search the leagues by name, ignoring case and diacritics
+    /// @dev Matches the leagues with a word of their name starting with the query, exact matches first.
+    /// Reverts if the query has no letters nor digits.
+    /// @param query The name of the league, or the start of a word of it
+    /// @param limit The max number of leagues returned, 20 if zero and at most 100
+    /// @return leagues The matching leagues
+    function searchLeagues(string calldata query, uint256 limit) external view returns (LeagueData[] memory);
+
     /// @notice Get list of unfinished match IDs
     /// @return matchIds Array of unfinished match IDs
     function getUnfinishedMatches() external view returns (uint256[] memory);
+
+    /// @notice Get the finality of a match result. Settlement should only rely on FINALITY_FINALIZED results.
+    /// @param matchId The match ID to query
+    /// @return state One of the FINALITY constants
+    /// @return finalityHeight The block height the result was, or is expected to be, finalized at
+    function getMatchFinality(uint256 matchId) external view returns (uint8 state, uint256 finalityHeight);
+
+    /// @notice Get the data provider attestation a match was ingested with, to verify the origin of the data off-chain
+    /// @dev Reverts if the match data is not attested by a provider.
+    /// @param matchId The match ID to query
+    /// @return provider The name of the data provider
+    /// @return keyType One of the PROVIDER_KEY_TYPE constants
+    /// @return pubKey The raw public key of the provider
+    /// @return payload The signed payload, the JSON encoding of the match by the provider
+    /// @return signature The signature of the provider over the payload
+    function getMatchAttestation(uint256 matchId) external view returns (string memory provider, uint8 keyType, bytes memory pubKey, bytes memory payload, bytes memory signature);
+
+    /// @notice Subscribe the caller to the result of a match
+    /// @dev msg.value must cover callbackGasLimit * callbackGasPrice (module param); the excess is refunded
+    /// right away, and the part of the fee the callback does not use once it is delivered. Subscribing
+    /// again to the same match replaces the subscription and refunds its fee.
+    /// The caller must implement IFutchainSubscriber; it is called once at the end of the
+    /// block in which the match result is finalized.
+    /// @param matchId The match ID to subscribe to
+    /// @param callbackGasLimit Gas limit of the onMatchResult callback
+    function subscribe(uint256 matchId, uint64 callbackGasLimit) external payable;
+
+    /// @notice Cancel the subscription of the caller to a match and refund its callback fee
+    /// @dev Lets the subscribers of the matches that never finish get their fee back.
+    /// @param matchId The match ID of the subscription
+    function unsubscribe(uint256 matchId) external;
+
+    /// @notice Create a prediction market on a match that has not started yet
+    /// @param matchId The match ID
+    /// @param marketType One of the MARKET_TYPE constants
+    /// @param line Goal line of over/under markets in half goals (odd, e.g. 5 for 2.5), ignored otherwise
+    /// @return marketId The ID of the new market
+    function createMarket(uint256 matchId, uint8 marketType, uint64 line) external returns (uint256 marketId);
+
+    /// @notice Stake the bond denom on an outcome of an open market
+    /// @dev The amount is transferred from the caller; the market pays out or refunds
+    /// automatically once the match result is finalized.
+    /// @param marketId The market ID
+    /// @param outcome The outcome to stake on, see the MARKET_TYPE constants
+    /// @param amount The amount to stake
+    function placeStake(uint256 marketId, uint64 outcome, uint256 amount) external;
+
+    /// @notice Get prediction market details by ID
+    /// @param marketId The market ID to query
+    /// @return market The market data structure
+    function getMarket(uint256 marketId) external view returns (MarketData memory);
+
+    /// @notice Get the ERC-20 token of an outcome share of a match
+    /// @dev The token is deployed on the first mint and removed once the match is over and its collateral is paid out
+    /// @param matchId The match ID
+    /// @param outcome 0 home win, 1 draw, 2 away win
+    /// @return token The address of the outcome share token
+    function getOutcomeToken(uint256 matchId, uint8 outcome) external view returns (address);
+
+    /// @notice Lock collateral (bond denom) and mint the same amount of every outcome share of a match
+    /// @param matchId The match ID
+    /// @param amount The collateral amount, transferred from the caller
+    function mintOutcomeShares(uint256 matchId, uint256 amount) external;
+
+    /// @notice Burn complete sets of outcome shares of a match and unlock the collateral
+    /// @param matchId The match ID
+    /// @param amount The amount of complete sets to burn
+    function burnOutcomeShares(uint256 matchId, uint256 amount) external;
+
+    /// @notice Redeem every winning outcome share of a finished match held by the caller, 1:1 for collateral
+    /// @param matchId The match ID
+    /// @return payout The collateral paid out
+    function redeemOutcomeShares(uint256 matchId) external returns (uint256 payout);
+}
+
+// Callback interface for contracts subscribed to match results
+interface IFutchainSubscriber {
+    /// @notice Called by the futchain module when the result of a subscribed match is finalized
+    function onMatchResult(uint256 matchId, uint256 homeScore, uint256 awayScore, bool cancelled) external;
 }
 
 // Futchain Precompile Instance
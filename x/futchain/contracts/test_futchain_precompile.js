@@ -253,6 +253,23 @@ async function interactiveTest() {
   }
 }
 
+async function testMatchEventLogs(blocks = 100) {
+  console.log(`\n📣 Testing match event logs (last ${blocks} blocks)...`);
+  try {
+    const latest = await web3.eth.getBlockNumber();
+    const fromBlock = latest > BigInt(blocks) ? latest - BigInt(blocks) : 0n;
+    const events = await futchainContract.getPastEvents('allEvents', { fromBlock, toBlock: latest });
+    console.log(`✅ ${events.length} events received:`);
+    for (const event of events.slice(0, 10)) {
+      console.log(`  #${event.blockNumber} ${event.event}(matchId/leagueId: ${event.returnValues[0]})`);
+    }
+    return events;
+  } catch (error) {
+    console.error('❌ match event logs failed:', error.message);
+    return null;
+  }
+}
+
 async function debugInfo() {
   console.log('\n🔍 Debug Information:');
   console.log(`  Precompile Address: ${PRECOMPILE_ADDRESS}`);
@@ -301,6 +318,7 @@ async function runAllTests() {
   await testRawCalls();
   await performanceTest();
   await interactiveTest();
+  await testMatchEventLogs();
   
   console.log('\n🎉 All tests completed!');
 }
@@ -312,6 +330,7 @@ module.exports = {
   testGetLeague,
   testGetTeam,
   testGetUnfinishedMatches,
+  testMatchEventLogs,
   testGasEstimation,
   testRawCalls,
   performanceTest,
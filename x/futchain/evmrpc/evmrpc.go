@@ -0,0 +1,177 @@
+// Package evmrpc serves the EVM logs the futchain module emits outside of transactions, e.g. the match
+// updates mirrored during ingestion, through the Ethereum JSON-RPC. The cosmos/evm RPC only reads the
+// logs of the transaction results, so this package registers the Namespace API set, which overrides the
+// eth log filter methods with ones reading the block-level `tx_log` events too, and adds these logs to
+// the log stream of the eth_subscribe subscriptions. Namespace must be enabled after "eth" in the
+// json-rpc.api list of app.toml.
+package evmrpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	cosmosevmrpc "github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/stream"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Namespace is the json-rpc.api name of the futchain log APIs.
+const Namespace = "futchain"
+
+func init() {
+	if err := cosmosevmrpc.RegisterAPINamespace(Namespace, NewAPIs); err != nil {
+		panic(err)
+	}
+}
+
+// NewAPIs returns the eth log filter API reading the block logs too, and starts adding the block logs of
+// the new blocks to the log stream.
+func NewAPIs(ctx *server.Context, clientCtx client.Context, rpcStream *stream.RPCStream, allowUnprotectedTxs bool, indexer cosmosevmtypes.EVMTxIndexer) []rpc.API {
+	logger := ctx.Logger.With("api", Namespace)
+	if rpcStream != nil && clientCtx.Client != nil {
+		go streamBlockLogs(context.Background(), logger, clientCtx.Client, rpcStream)
+	}
+
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+	return []rpc.API{NewFilterAPI(logger, clientCtx, rpcStream, evmBackend)}
+}
+
+// NewFilterAPI returns the eth log filter API of a backend, reading the block logs too.
+func NewFilterAPI(logger log.Logger, clientCtx client.Context, rpcStream *stream.RPCStream, evmBackend filters.Backend) rpc.API {
+	return rpc.API{
+		Namespace: cosmosevmrpc.EthNamespace,
+		Version:   "1.0",
+		Service:   filters.NewPublicAPI(logger, clientCtx, rpcStream, logsBackend{evmBackend}),
+		Public:    true,
+	}
+}
+
+// BlockLogs returns the logs emitted outside of transactions in a block, from the `tx_log` events of its
+// FinalizeBlock events.
+func BlockLogs(blockRes *coretypes.ResultBlockResults) ([]*ethtypes.Log, error) {
+	var logs []*ethtypes.Log
+	for _, event := range blockLogEvents(blockRes) {
+		eventLogs, err := evmtypes.ParseTxLogsFromEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, eventLogs...)
+	}
+	return logs, nil
+}
+
+// blockLogEvents returns the `tx_log` events of the FinalizeBlock events of a block.
+func blockLogEvents(blockRes *coretypes.ResultBlockResults) []abci.Event {
+	var events []abci.Event
+	for _, event := range blockRes.FinalizeBlockEvents {
+		if event.Type == evmtypes.EventTypeTxLog {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// logsBackend is a filter backend whose block results hold the block logs as the events of an extra
+// transaction result, which the filters read the logs from.
+type logsBackend struct {
+	filters.Backend
+}
+
+// withBlockLogs returns the block results with an extra transaction result holding the block logs.
+func withBlockLogs(blockRes *coretypes.ResultBlockResults) *coretypes.ResultBlockResults {
+	events := blockLogEvents(blockRes)
+	if len(events) == 0 {
+		return blockRes
+	}
+	res := *blockRes
+	res.TxsResults = append(slices.Clone(blockRes.TxsResults), &abci.ExecTxResult{Events: events})
+	return &res
+}
+
+func (b logsBackend) CometBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error) {
+	blockRes, err := b.Backend.CometBlockResultByNumber(height)
+	if err != nil || blockRes == nil {
+		return blockRes, err
+	}
+	return withBlockLogs(blockRes), nil
+}
+
+// BlockBloom adds the block logs to the bloom of the transaction logs, which is missing from the blocks
+// without EVM transactions.
+func (b logsBackend) BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	bloom, err := b.Backend.BlockBloom(blockRes)
+	logs, logsErr := BlockLogs(blockRes)
+	if logsErr != nil {
+		return ethtypes.Bloom{}, logsErr
+	}
+	if len(logs) == 0 {
+		return bloom, err
+	}
+	for _, l := range logs {
+		bloom.Add(l.Address.Bytes())
+		for _, topic := range l.Topics {
+			bloom.Add(topic.Bytes())
+		}
+	}
+	return bloom, nil
+}
+
+func (b logsBackend) GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error) {
+	resBlock, err := b.CometBlockByHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, fmt.Errorf("block not found for hash %s", blockHash)
+	}
+	return b.GetLogsByHeight(&resBlock.Block.Height)
+}
+
+func (b logsBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	blockRes, err := b.CometBlockResultByNumber(height)
+	if err != nil {
+		return nil, err
+	}
+	return backend.GetLogsFromBlockResults(blockRes)
+}
+
+// streamBlockLogs adds the block logs of each new block to the log stream read by the eth_subscribe logs
+// subscriptions.
+func streamBlockLogs(ctx context.Context, logger log.Logger, cometClient client.CometRPC, rpcStream *stream.RPCStream) {
+	err := rpcStream.HeaderStream().Subscribe(ctx, func(headers []stream.RPCHeader, _ int) error {
+		for _, header := range headers {
+			height := header.EthHeader.Number.Int64()
+			blockRes, err := cometClient.BlockResults(ctx, &height)
+			if err != nil {
+				logger.Error("failed to fetch block results", "height", height, "error", err)
+				continue
+			}
+			logs, err := BlockLogs(blockRes)
+			if err != nil {
+				logger.Error("failed to decode block logs", "height", height, "error", err)
+				continue
+			}
+			if len(logs) > 0 {
+				rpcStream.LogStream().Add(logs...)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Error("block log stream stopped", "error", err)
+	}
+}
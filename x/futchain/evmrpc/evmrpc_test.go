@@ -0,0 +1,141 @@
+package evmrpc_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/raifpy/futchain/x/futchain/evmrpc"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// blocksBackend is a filter backend over the results of a single block.
+type blocksBackend struct {
+	block    *coretypes.ResultBlock
+	blockRes *coretypes.ResultBlockResults
+}
+
+func (b blocksBackend) GetBlockByNumber(rpctypes.BlockNumber, bool) (map[string]interface{}, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b blocksBackend) HeaderByNumber(rpctypes.BlockNumber) (*ethtypes.Header, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b blocksBackend) HeaderByHash(common.Hash) (*ethtypes.Header, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b blocksBackend) CometBlockByHash(common.Hash) (*coretypes.ResultBlock, error) {
+	return b.block, nil
+}
+
+func (b blocksBackend) CometBlockResultByNumber(*int64) (*coretypes.ResultBlockResults, error) {
+	return b.blockRes, nil
+}
+
+func (b blocksBackend) GetLogs(common.Hash) ([][]*ethtypes.Log, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b blocksBackend) GetLogsByHeight(*int64) ([][]*ethtypes.Log, error) {
+	return nil, errors.New("not implemented")
+}
+
+// BlockBloom fails like the evm backend does for the blocks without EVM transactions.
+func (b blocksBackend) BlockBloom(*coretypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
+}
+
+func (b blocksBackend) BloomStatus() (uint64, uint64) { return 4096, 0 }
+func (b blocksBackend) RPCFilterCap() int32           { return 100 }
+func (b blocksBackend) RPCLogsCap() int32             { return 10000 }
+func (b blocksBackend) RPCBlockRangeCap() int32       { return 10000 }
+
+// txLogEvent returns the `tx_log` event of a log, as the keeper emits it.
+func txLogEvent(t *testing.T, log *ethtypes.Log) abci.Event {
+	t.Helper()
+	bz, err := json.Marshal(evmtypes.NewLogFromEth(log))
+	require.NoError(t, err)
+	return abci.Event{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)}}}
+}
+
+func TestGetLogs(t *testing.T) {
+	precompile := common.HexToAddress(types.FutchainPrecompileAddress)
+	blockHash := common.HexToHash("0x01")
+	scoreChanged := &ethtypes.Log{
+		Address:     precompile,
+		Topics:      []common.Hash{common.HexToHash("0xaa"), common.BigToHash(common.Big1)},
+		Data:        []byte{1, 2},
+		BlockNumber: 7,
+		BlockHash:   blockHash,
+	}
+	finished := &ethtypes.Log{
+		Address:     precompile,
+		Topics:      []common.Hash{common.HexToHash("0xbb"), common.BigToHash(common.Big1)},
+		BlockNumber: 7,
+		BlockHash:   blockHash,
+		Index:       1,
+	}
+
+	backend := blocksBackend{
+		block: &coretypes.ResultBlock{Block: &cmttypes.Block{Header: cmttypes.Header{Height: 7}}},
+		blockRes: &coretypes.ResultBlockResults{
+			Height: 7,
+			FinalizeBlockEvents: []abci.Event{
+				{Type: "new_block_events"},
+				txLogEvent(t, scoreChanged),
+				txLogEvent(t, finished),
+			},
+		},
+	}
+
+	logs, err := evmrpc.BlockLogs(backend.blockRes)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+
+	api := evmrpc.NewFilterAPI(log.NewNopLogger(), client.Context{}, nil, backend)
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName(api.Namespace, api.Service))
+	rpcClient := rpc.DialInProc(server)
+	defer rpcClient.Close()
+
+	getLogs := func(topics [][]common.Hash) []ethtypes.Log {
+		t.Helper()
+		var logs []ethtypes.Log
+		require.NoError(t, rpcClient.Call(&logs, "eth_getLogs", map[string]interface{}{
+			"blockHash": blockHash,
+			"address":   precompile,
+			"topics":    topics,
+		}))
+		return logs
+	}
+
+	got := getLogs(nil)
+	require.Len(t, got, 2)
+	require.Equal(t, scoreChanged.Topics, got[0].Topics)
+	require.Equal(t, scoreChanged.Data, got[0].Data)
+	require.Equal(t, uint64(7), got[0].BlockNumber)
+	require.Equal(t, uint(1), got[1].Index)
+
+	got = getLogs([][]common.Hash{{common.HexToHash("0xbb")}})
+	require.Len(t, got, 1)
+	require.Equal(t, finished.Topics, got[0].Topics)
+
+	require.Empty(t, getLogs([][]common.Hash{{common.HexToHash("0xcc")}}))
+}
@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Config is the futchain-graphql section of app.toml.
+type Config struct {
+	// Enable turns the GraphQL endpoint on.
+	Enable bool `mapstructure:"enable"`
+	// MaxQueries bounds the chain queries run to resolve a request, nested fields included.
+	MaxQueries int `mapstructure:"max-queries"`
+}
+
+// DefaultConfig returns the default GraphQL config. The endpoint is disabled by default.
+func DefaultConfig() Config {
+	return Config{
+		MaxQueries: 200,
+	}
+}
+
+// DefaultConfigTemplate is the app.toml template of the GraphQL config.
+const DefaultConfigTemplate = `
+###############################################################################
+###                         Futchain GraphQL                                ###
+###############################################################################
+
+[futchain-graphql]
+
+# Enable serves the /raifpy/futchain/futchain/v1/graphql route of the API server, resolving the
+# leagues, teams and matches at the latest or a given height.
+enable = {{ .FutchainGraphQL.Enable }}
+
+# MaxQueries bounds the chain queries run to resolve a request, nested fields included.
+max-queries = {{ .FutchainGraphQL.MaxQueries }}
+`
+
+// ConfigFromAppOptions reads the GraphQL config from the app options, over the defaults.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	cfg.Enable = cast.ToBool(appOpts.Get("futchain-graphql.enable"))
+	if v := cast.ToInt(appOpts.Get("futchain-graphql.max-queries")); v > 0 {
+		cfg.MaxQueries = v
+	}
+	return cfg
+}
+
+// Validate checks the config.
+func (c Config) Validate() error {
+	if c.MaxQueries <= 0 {
+		return fmt.Errorf("max queries must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,496 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	timestampName protoreflect.FullName = "google.protobuf.Timestamp"
+	durationName  protoreflect.FullName = "google.protobuf.Duration"
+)
+
+// Int64 is the scalar of the 64-bit signed proto integers. As in the REST API, it is serialized as a string;
+// it is parsed from an int or a string.
+var Int64 = gql.NewScalar(gql.ScalarConfig{
+	Name:        "Int64",
+	Description: "A 64-bit signed integer, serialized as a string. Ints and strings are accepted as input.",
+	Serialize: func(value any) any {
+		if v, ok := value.(int64); ok {
+			return strconv.FormatInt(v, 10)
+		}
+		return nil
+	},
+	ParseValue: func(value any) any {
+		return parseInteger(value, func(s string) (any, error) { return strconv.ParseInt(s, 10, 64) })
+	},
+	ParseLiteral: func(value ast.Value) any {
+		return parseIntegerLiteral(value, func(s string) (any, error) { return strconv.ParseInt(s, 10, 64) })
+	},
+})
+
+// Uint64 is the scalar of the unsigned proto integers, serialized and parsed as Int64.
+var Uint64 = gql.NewScalar(gql.ScalarConfig{
+	Name:        "Uint64",
+	Description: "A 64-bit unsigned integer, serialized as a string. Ints and strings are accepted as input.",
+	Serialize: func(value any) any {
+		if v, ok := value.(uint64); ok {
+			return strconv.FormatUint(v, 10)
+		}
+		return nil
+	},
+	ParseValue: func(value any) any {
+		return parseInteger(value, func(s string) (any, error) { return strconv.ParseUint(s, 10, 64) })
+	},
+	ParseLiteral: func(value ast.Value) any {
+		return parseIntegerLiteral(value, func(s string) (any, error) { return strconv.ParseUint(s, 10, 64) })
+	},
+})
+
+// parseInteger parses a variable value of an integer scalar. JSON numbers are decoded as float64.
+func parseInteger(value any, parse func(string) (any, error)) any {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case int:
+		s = strconv.Itoa(v)
+	case float64:
+		if v != math.Trunc(v) {
+			return nil
+		}
+		s = strconv.FormatFloat(v, 'f', 0, 64)
+	default:
+		return nil
+	}
+	parsed, err := parse(s)
+	if err != nil {
+		return nil
+	}
+	return parsed
+}
+
+// parseIntegerLiteral parses a query literal of an integer scalar.
+func parseIntegerLiteral(value ast.Value, parse func(string) (any, error)) any {
+	var s string
+	switch v := value.(type) {
+	case *ast.IntValue:
+		s = v.Value
+	case *ast.StringValue:
+		s = v.Value
+	default:
+		return nil
+	}
+	parsed, err := parse(s)
+	if err != nil {
+		return nil
+	}
+	return parsed
+}
+
+// message is the source of the fields of a generated object: a proto message and the height it was queried
+// at, which the joined queries of the object are resolved at.
+type message struct {
+	msg    protoreflect.Message
+	height int64
+}
+
+// builder generates the GraphQL types of the proto messages and enums. Objects are named after their
+// message, and inputs after their message with an Input suffix; a name used by two proto packages is
+// qualified with the package of the latter.
+type builder struct {
+	objects map[protoreflect.FullName]*gql.Object
+	inputs  map[protoreflect.FullName]*gql.InputObject
+	enums   map[protoreflect.FullName]*gql.Enum
+	names   map[string]protoreflect.FullName
+
+	// joins are the fields added to the generated fields of an object
+	joins map[protoreflect.FullName]gql.Fields
+}
+
+func newBuilder() *builder {
+	return &builder{
+		objects: map[protoreflect.FullName]*gql.Object{},
+		inputs:  map[protoreflect.FullName]*gql.InputObject{},
+		enums:   map[protoreflect.FullName]*gql.Enum{},
+		names:   map[string]protoreflect.FullName{},
+		joins:   map[protoreflect.FullName]gql.Fields{},
+	}
+}
+
+// name returns the unique GraphQL name of a proto type.
+func (b *builder) name(fullName protoreflect.FullName, suffix string) string {
+	name := string(fullName.Name()) + suffix
+	if owner, ok := b.names[name]; ok && owner != fullName {
+		name = strings.ReplaceAll(string(fullName), ".", "_") + suffix
+	}
+	b.names[name] = fullName
+	return name
+}
+
+// object returns the object type of a message. Its fields are the fields of the message, by their JSON
+// name, and the joins of the message.
+func (b *builder) object(md protoreflect.MessageDescriptor) *gql.Object {
+	if object, ok := b.objects[md.FullName()]; ok {
+		return object
+	}
+
+	object := gql.NewObject(gql.ObjectConfig{
+		Name: b.name(md.FullName(), ""),
+		// the fields are built once all types are known, messages may refer to each other
+		Fields: gql.FieldsThunk(func() gql.Fields {
+			fields := gql.Fields{}
+			for i := 0; i < md.Fields().Len(); i++ {
+				fd := md.Fields().Get(i)
+				if fd.IsMap() {
+					continue
+				}
+				fields[fd.JSONName()] = &gql.Field{Type: b.outputType(fd), Resolve: resolveField(fd)}
+			}
+			for name, field := range b.joins[md.FullName()] {
+				fields[name] = field
+			}
+			return fields
+		}),
+	})
+	b.objects[md.FullName()] = object
+	return object
+}
+
+// outputType returns the type of a message field. Scalars and lists are never null; messages are null when
+// unset.
+func (b *builder) outputType(fd protoreflect.FieldDescriptor) gql.Output {
+	var t gql.Output
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch fd.Message().FullName() {
+		case timestampName:
+			t = gql.DateTime
+		case durationName:
+			t = gql.String
+		default:
+			t = b.object(fd.Message())
+		}
+		if !fd.IsList() {
+			return t
+		}
+	case protoreflect.EnumKind:
+		t = b.enum(fd.Enum())
+	default:
+		t = scalarType(fd.Kind())
+	}
+	if fd.IsList() {
+		return gql.NewNonNull(gql.NewList(gql.NewNonNull(t)))
+	}
+	return gql.NewNonNull(t)
+}
+
+// input returns the input object type of a message.
+func (b *builder) input(md protoreflect.MessageDescriptor) *gql.InputObject {
+	if input, ok := b.inputs[md.FullName()]; ok {
+		return input
+	}
+
+	input := gql.NewInputObject(gql.InputObjectConfig{
+		Name: b.name(md.FullName(), "Input"),
+		Fields: gql.InputObjectConfigFieldMapThunk(func() gql.InputObjectConfigFieldMap {
+			fields := gql.InputObjectConfigFieldMap{}
+			for i := 0; i < md.Fields().Len(); i++ {
+				fd := md.Fields().Get(i)
+				if !fd.IsMap() {
+					fields[fd.JSONName()] = &gql.InputObjectFieldConfig{Type: b.inputType(fd)}
+				}
+			}
+			return fields
+		}),
+	})
+	b.inputs[md.FullName()] = input
+	return input
+}
+
+// inputType returns the type of a message field given as an argument. All arguments are optional, as the
+// fields of a proto3 message.
+func (b *builder) inputType(fd protoreflect.FieldDescriptor) gql.Input {
+	var t gql.Input
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		switch fd.Message().FullName() {
+		case timestampName:
+			t = gql.DateTime
+		case durationName:
+			t = gql.String
+		default:
+			t = b.input(fd.Message())
+		}
+	case protoreflect.EnumKind:
+		t = b.enum(fd.Enum())
+	default:
+		t = scalarType(fd.Kind())
+	}
+	if fd.IsList() {
+		return gql.NewList(gql.NewNonNull(t))
+	}
+	return t
+}
+
+// args returns the arguments of the fields of a request message, but the skipped ones.
+func (b *builder) args(md protoreflect.MessageDescriptor, skip map[protoreflect.Name]protoreflect.Name) gql.FieldConfigArgument {
+	args := gql.FieldConfigArgument{}
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		if _, ok := skip[fd.Name()]; ok || fd.IsMap() {
+			continue
+		}
+		args[fd.JSONName()] = &gql.ArgumentConfig{Type: b.inputType(fd)}
+	}
+	return args
+}
+
+// enum returns the enum type of a proto enum, valued by the proto enum values.
+func (b *builder) enum(ed protoreflect.EnumDescriptor) *gql.Enum {
+	if enum, ok := b.enums[ed.FullName()]; ok {
+		return enum
+	}
+
+	values := gql.EnumValueConfigMap{}
+	for i := 0; i < ed.Values().Len(); i++ {
+		value := ed.Values().Get(i)
+		values[string(value.Name())] = &gql.EnumValueConfig{Value: value.Number()}
+	}
+	enum := gql.NewEnum(gql.EnumConfig{Name: b.name(ed.FullName(), ""), Values: values})
+	b.enums[ed.FullName()] = enum
+	return enum
+}
+
+// scalarType returns the scalar type of a proto scalar kind. Bytes are base64 strings.
+func scalarType(kind protoreflect.Kind) *gql.Scalar {
+	switch kind {
+	case protoreflect.BoolKind:
+		return gql.Boolean
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return gql.Int
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return Int64
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return Uint64
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return gql.Float
+	default:
+		return gql.String
+	}
+}
+
+// resolveField resolves a generated object field from the message of the object.
+func resolveField(fd protoreflect.FieldDescriptor) gql.FieldResolveFn {
+	return func(p gql.ResolveParams) (any, error) {
+		source, ok := p.Source.(message)
+		if !ok {
+			return nil, fmt.Errorf("unexpected source %T of field %s", p.Source, fd.FullName())
+		}
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !source.msg.Has(fd) {
+			return nil, nil
+		}
+
+		value := source.msg.Get(fd)
+		if !fd.IsList() {
+			return outputValue(fd, value, source.height), nil
+		}
+		list := value.List()
+		values := make([]any, list.Len())
+		for i := range values {
+			values[i] = outputValue(fd, list.Get(i), source.height)
+		}
+		return values, nil
+	}
+}
+
+// outputValue converts a single value of a message field to the value of its GraphQL type.
+func outputValue(fd protoreflect.FieldDescriptor, value protoreflect.Value, height int64) any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return value.Bool()
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return int(value.Int())
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return value.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return value.Uint()
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float()
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(value.Bytes())
+	case protoreflect.EnumKind:
+		return value.Enum()
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		msg := value.Message()
+		switch fd.Message().FullName() {
+		case timestampName:
+			seconds, nanos := wellKnownFields(msg)
+			return time.Unix(seconds, nanos).UTC()
+		case durationName:
+			seconds, nanos := wellKnownFields(msg)
+			return (time.Duration(seconds)*time.Second + time.Duration(nanos)).String()
+		}
+		return message{msg: msg, height: height}
+	default:
+		return value.String()
+	}
+}
+
+// wellKnownFields returns the seconds and nanos of a timestamp or a duration.
+func wellKnownFields(msg protoreflect.Message) (int64, int64) {
+	fields := msg.Descriptor().Fields()
+	return msg.Get(fields.ByName("seconds")).Int(), msg.Get(fields.ByName("nanos")).Int()
+}
+
+// setFields sets the fields of a message from the GraphQL arguments, by their JSON name.
+func setFields(msg protoreflect.Message, args map[string]any) error {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		arg, ok := args[fd.JSONName()]
+		if !ok || arg == nil {
+			continue
+		}
+
+		if !fd.IsList() {
+			value, err := inputValue(msg, fd, arg)
+			if err != nil {
+				return fmt.Errorf("%s: %w", fd.JSONName(), err)
+			}
+			msg.Set(fd, value)
+			continue
+		}
+		items, ok := arg.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected a list", fd.JSONName())
+		}
+		list := msg.Mutable(fd).List()
+		for _, item := range items {
+			value, err := inputValue(msg, fd, item)
+			if err != nil {
+				return fmt.Errorf("%s: %w", fd.JSONName(), err)
+			}
+			list.Append(value)
+		}
+	}
+	return nil
+}
+
+// inputValue converts a single argument value to the value of a message field.
+func inputValue(msg protoreflect.Message, fd protoreflect.FieldDescriptor, arg any) (protoreflect.Value, error) {
+	invalid := func() (protoreflect.Value, error) {
+		return protoreflect.Value{}, fmt.Errorf("unexpected value %v", arg)
+	}
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		v, ok := arg.(bool)
+		if !ok {
+			return invalid()
+		}
+		return protoreflect.ValueOfBool(v), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, ok := arg.(int)
+		if !ok || v < math.MinInt32 || v > math.MaxInt32 {
+			return invalid()
+		}
+		return protoreflect.ValueOfInt32(int32(v)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, ok := arg.(int64)
+		if !ok {
+			return invalid()
+		}
+		return protoreflect.ValueOfInt64(v), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, ok := arg.(uint64)
+		if !ok || v > math.MaxUint32 {
+			return invalid()
+		}
+		return protoreflect.ValueOfUint32(uint32(v)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, ok := arg.(uint64)
+		if !ok {
+			return invalid()
+		}
+		return protoreflect.ValueOfUint64(v), nil
+	case protoreflect.FloatKind:
+		v, ok := arg.(float64)
+		if !ok {
+			return invalid()
+		}
+		return protoreflect.ValueOfFloat32(float32(v)), nil
+	case protoreflect.DoubleKind:
+		v, ok := arg.(float64)
+		if !ok {
+			return invalid()
+		}
+		return protoreflect.ValueOfFloat64(v), nil
+	case protoreflect.StringKind:
+		v, ok := arg.(string)
+		if !ok {
+			return invalid()
+		}
+		return protoreflect.ValueOfString(v), nil
+	case protoreflect.BytesKind:
+		v, ok := arg.(string)
+		if !ok {
+			return invalid()
+		}
+		bz, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfBytes(bz), nil
+	case protoreflect.EnumKind:
+		v, ok := arg.(protoreflect.EnumNumber)
+		if !ok {
+			return invalid()
+		}
+		return protoreflect.ValueOfEnum(v), nil
+	}
+
+	field := msg.NewField(fd).Message()
+	switch fd.Message().FullName() {
+	case timestampName:
+		v, ok := arg.(time.Time)
+		if !ok {
+			return invalid()
+		}
+		setWellKnownFields(field, v.Unix(), int64(v.Nanosecond()))
+	case durationName:
+		s, ok := arg.(string)
+		if !ok {
+			return invalid()
+		}
+		v, err := time.ParseDuration(s)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		setWellKnownFields(field, int64(v/time.Second), int64(v%time.Second))
+	default:
+		v, ok := arg.(map[string]any)
+		if !ok {
+			return invalid()
+		}
+		if err := setFields(field, v); err != nil {
+			return protoreflect.Value{}, err
+		}
+	}
+	return protoreflect.ValueOfMessage(field), nil
+}
+
+// setWellKnownFields sets the seconds and nanos of a timestamp or a duration.
+func setWellKnownFields(msg protoreflect.Message, seconds, nanos int64) {
+	fields := msg.Descriptor().Fields()
+	msg.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(seconds))
+	msg.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(int32(nanos)))
+}
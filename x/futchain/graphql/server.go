@@ -0,0 +1,336 @@
+// Package graphql serves the leagues, teams and matches of futchain over GraphQL, for clients fetching
+// nested data in one request. The schema is generated from the proto types of the Query service: each
+// exposed method is a field whose arguments are the fields of its request, and whose type is its response.
+// Joins, such as the matches of a league, are fields of the generated objects resolved by another method.
+//
+// The fields are resolved by the ABCI queries of the node, all at the height of the request: the latest
+// height, or the height given to a root field, which its nested fields inherit.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/gorilla/mux"
+	gql "github.com/graphql-go/graphql"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// Route is the API server route of the GraphQL endpoint. The request is given by the query,
+	// operationName and variables parameters of a GET request, or the JSON body of a POST request.
+	Route = "/raifpy/futchain/futchain/v1/graphql"
+
+	// queryService is the proto service the fields are resolved by.
+	queryService protoreflect.FullName = "futchain.futchain.v1.Query"
+
+	// maxRequestSize bounds the size of a request body.
+	maxRequestSize = 1 << 20
+)
+
+// rootFields are the Query service methods exposed as root fields.
+var rootFields = []struct {
+	name        string
+	method      protoreflect.Name
+	description string
+}{
+	{"league", "League", "The league with the id, null if there is none."},
+	{"team", "Team", "The team with the id, null if there is none."},
+	{"match", "Match", "The match with the id, null if there is none."},
+	{"leagues", "Leagues", "The leagues, in id order."},
+	{"teams", "Teams", "The teams, in id order."},
+	{"matches", "Matches", "The matches selected by the filters, in id order."},
+	{"unfinishedMatches", "UnfinishedMatches", "The ids of the matches waiting for their result."},
+	{"searchTeams", "SearchTeams", "The teams with a word of their name starting with the query, ignoring case and diacritics."},
+	{"searchLeagues", "SearchLeagues", "The leagues with a word of their name starting with the query, ignoring case and diacritics."},
+	{"canonicalId", "CanonicalID", "The canonical id of a team, league or match by its id at a data provider."},
+	{"externalIds", "ExternalIDs", "The ids of a team, league or match at the data providers."},
+	{"idMappings", "IDMappings", "The id mappings of the teams, leagues or matches, in provider and external id order."},
+}
+
+// joins are the fields added to the generated objects, resolved by a Query service method. bind maps the
+// request fields set from the object to the object fields.
+var joins = []struct {
+	object      protoreflect.Name
+	name        string
+	method      protoreflect.Name
+	bind        map[protoreflect.Name]protoreflect.Name
+	description string
+}{
+	{"League", "matches", "Matches", map[protoreflect.Name]protoreflect.Name{"league_id": "id"}, "The matches of the league."},
+	{"Team", "matches", "Matches", map[protoreflect.Name]protoreflect.Name{"team_id": "id"}, "The matches played by the team, home or away."},
+	{"Match", "league", "League", map[protoreflect.Name]protoreflect.Name{"id": "league_id"}, "The league of the match."},
+	{"Match", "reports", "MatchReports", map[protoreflect.Name]protoreflect.Name{"match_id": "id"}, "The oracle reports of the match."},
+	{"Match", "attestation", "MatchAttestation", map[protoreflect.Name]protoreflect.Name{"match_id": "id"}, "The data provider attestation of the match, null if there is none."},
+	{"Match", "markets", "MatchMarkets", map[protoreflect.Name]protoreflect.Name{"match_id": "id"}, "The prediction markets of the match."},
+}
+
+// Querier runs the ABCI queries of the resolvers, the BaseApp of the node.
+type Querier interface {
+	Query(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error)
+	LastBlockHeight() int64
+}
+
+// Server resolves GraphQL requests against the futchain state.
+type Server struct {
+	cfg     Config
+	querier Querier
+	schema  gql.Schema
+}
+
+// NewServer generates the schema of the server from the registered proto types.
+func NewServer(cfg Config, querier Querier) (*Server, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	// the merged registry resolves the messages of the imports of the gogoproto files
+	files, err := gogoproto.MergedRegistry()
+	if err != nil {
+		return nil, err
+	}
+	desc, err := files.FindDescriptorByName(queryService)
+	if err != nil {
+		return nil, err
+	}
+	service, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", queryService)
+	}
+	method := func(name protoreflect.Name) (protoreflect.MethodDescriptor, error) {
+		md := service.Methods().ByName(name)
+		if md == nil {
+			return nil, fmt.Errorf("unknown method %s of %s", name, queryService)
+		}
+		return md, nil
+	}
+
+	s := &Server{cfg: cfg, querier: querier}
+	b := newBuilder()
+	for _, join := range joins {
+		md, err := method(join.method)
+		if err != nil {
+			return nil, err
+		}
+		objectName := service.ParentFile().Package().Append(join.object)
+		if b.joins[objectName] == nil {
+			b.joins[objectName] = gql.Fields{}
+		}
+		b.joins[objectName][join.name] = &gql.Field{
+			Type:        s.resultType(b, md),
+			Args:        b.args(md.Input(), join.bind),
+			Resolve:     s.resolve(md, join.bind),
+			Description: join.description,
+		}
+	}
+
+	fields := gql.Fields{}
+	for _, root := range rootFields {
+		md, err := method(root.method)
+		if err != nil {
+			return nil, err
+		}
+		args := b.args(md.Input(), nil)
+		if _, ok := args["height"]; ok {
+			return nil, fmt.Errorf("the request of %s has a height field", md.FullName())
+		}
+		args["height"] = &gql.ArgumentConfig{
+			Type:        Int64,
+			Description: "The height to resolve the field and its nested fields at, the latest height by default.",
+		}
+		fields[root.name] = &gql.Field{Type: s.resultType(b, md), Args: args, Resolve: s.resolve(md, nil), Description: root.description}
+	}
+
+	s.schema, err = gql.NewSchema(gql.SchemaConfig{Query: gql.NewObject(gql.ObjectConfig{Name: "Query", Fields: fields})})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// unwrapped returns the field of a response made of a single message, such as the match of
+// QueryMatchResponse, which is returned in place of the response.
+func unwrapped(response protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	if response.Fields().Len() != 1 {
+		return nil
+	}
+	fd := response.Fields().Get(0)
+	if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+		return nil
+	}
+	return fd
+}
+
+// resultType returns the type of a field resolved by a method.
+func (s *Server) resultType(b *builder, md protoreflect.MethodDescriptor) gql.Output {
+	if fd := unwrapped(md.Output()); fd != nil {
+		return b.object(fd.Message())
+	}
+	return b.object(md.Output())
+}
+
+// resolve returns the resolver of a field resolved by a method. The request is set from the arguments,
+// and from the bound fields of the object of a join.
+func (s *Server) resolve(md protoreflect.MethodDescriptor, bind map[protoreflect.Name]protoreflect.Name) gql.FieldResolveFn {
+	path := fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name())
+
+	return func(p gql.ResolveParams) (any, error) {
+		req := dynamicpb.NewMessage(md.Input())
+		if err := setFields(req, p.Args); err != nil {
+			return nil, err
+		}
+
+		var height int64
+		if parent, ok := p.Source.(message); ok {
+			height = parent.height
+			for reqField, parentField := range bind {
+				fields := parent.msg.Descriptor().Fields()
+				req.Set(md.Input().Fields().ByName(reqField), parent.msg.Get(fields.ByName(parentField)))
+			}
+		} else {
+			height = requestHeight(p.Context)
+			if arg, ok := p.Args["height"].(int64); ok {
+				if arg <= 0 {
+					return nil, fmt.Errorf("invalid height %d", arg)
+				}
+				height = arg
+			}
+		}
+
+		res, err := s.query(p.Context, path, req, md.Output(), height)
+		if err != nil || res == nil {
+			return nil, err
+		}
+		if fd := unwrapped(md.Output()); fd != nil {
+			return message{msg: res.Get(fd).Message(), height: height}, nil
+		}
+		return message{msg: res, height: height}, nil
+	}
+}
+
+// query runs an ABCI query at a height. It returns nil if the queried item is not found.
+func (s *Server) query(ctx context.Context, path string, req proto.Message, response protoreflect.MessageDescriptor, height int64) (protoreflect.Message, error) {
+	if state, ok := ctx.Value(stateKey{}).(*requestState); ok && state.queries.Add(1) > int64(s.cfg.MaxQueries) {
+		return nil, fmt.Errorf("the request needs more than %d queries", s.cfg.MaxQueries)
+	}
+
+	bz, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.querier.Query(ctx, &abci.RequestQuery{Path: path, Data: bz, Height: height})
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		if res.Codespace == sdkerrors.ErrKeyNotFound.Codespace() && res.Code == sdkerrors.ErrKeyNotFound.ABCICode() {
+			return nil, nil
+		}
+		return nil, errors.New(res.Log)
+	}
+
+	msg := dynamicpb.NewMessage(response)
+	if err := proto.Unmarshal(res.Value, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// requestState is the state of a request shared by its resolvers.
+type requestState struct {
+	// height is the latest height when the request started
+	height  int64
+	queries atomic.Int64
+}
+
+type stateKey struct{}
+
+// requestHeight returns the height a request resolves at by default.
+func requestHeight(ctx context.Context) int64 {
+	if state, ok := ctx.Value(stateKey{}).(*requestState); ok {
+		return state.height
+	}
+	return 0
+}
+
+// Execute resolves a GraphQL request. The root fields without a height all resolve at the latest height
+// when the request starts, even if a block is committed meanwhile.
+func (s *Server) Execute(ctx context.Context, query, operationName string, variables map[string]any) *gql.Result {
+	ctx = context.WithValue(ctx, stateKey{}, &requestState{height: s.querier.LastBlockHeight()})
+	return gql.Do(gql.Params{
+		Schema:         s.schema,
+		RequestString:  query,
+		OperationName:  operationName,
+		VariableValues: variables,
+		Context:        ctx,
+	})
+}
+
+// request is a GraphQL request over HTTP.
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// ServeHTTP serves GraphQL requests over HTTP.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if r.Method == http.MethodPost {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize+1))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if len(body) > maxRequestSize {
+			writeError(w, fmt.Errorf("request larger than %d bytes", maxRequestSize))
+			return
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			writeError(w, err)
+			return
+		}
+	} else {
+		params := r.URL.Query()
+		req.Query, req.OperationName = params.Get("query"), params.Get("operationName")
+		if variables := params.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+	}
+	if req.Query == "" {
+		writeError(w, fmt.Errorf("empty query"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.Execute(r.Context(), req.Query, req.OperationName, req.Variables))
+}
+
+// RegisterRoute registers the GraphQL endpoint on the API server router.
+func RegisterRoute(router *mux.Router, s *Server) {
+	router.Handle(Route, s).Methods(http.MethodGet, http.MethodPost)
+}
+
+// writeError writes a malformed request error, in the shape of the GraphQL errors.
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []map[string]string{{"message": err.Error()}}})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
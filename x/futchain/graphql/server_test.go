@@ -0,0 +1,225 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/raifpy/futchain/x/futchain/graphql"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+var kickoff = time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+
+// queryServer serves the matches of each height from memory.
+type queryServer struct {
+	types.UnimplementedQueryServer
+	leagues []types.League
+	teams   []types.Team
+	matches map[int64][]types.Match
+}
+
+func (s queryServer) heightMatches(ctx context.Context) []types.Match {
+	return s.matches[sdk.UnwrapSDKContext(ctx).BlockHeight()]
+}
+
+func (s queryServer) League(_ context.Context, req *types.QueryLeagueRequest) (*types.QueryLeagueResponse, error) {
+	for _, league := range s.leagues {
+		if league.Id == req.Id {
+			return &types.QueryLeagueResponse{League: league}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "league not found")
+}
+
+func (s queryServer) Leagues(context.Context, *types.QueryLeaguesRequest) (*types.QueryLeaguesResponse, error) {
+	return &types.QueryLeaguesResponse{Leagues: s.leagues, Pagination: &query.PageResponse{Total: uint64(len(s.leagues))}}, nil
+}
+
+func (s queryServer) Match(ctx context.Context, req *types.QueryMatchRequest) (*types.QueryMatchResponse, error) {
+	for _, match := range s.heightMatches(ctx) {
+		if match.Id == req.Id {
+			return &types.QueryMatchResponse{Match: match}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "match not found")
+}
+
+func (s queryServer) Matches(ctx context.Context, req *types.QueryMatchesRequest) (*types.QueryMatchesResponse, error) {
+	res := &types.QueryMatchesResponse{Matches: []types.Match{}}
+	for _, match := range s.heightMatches(ctx) {
+		if req.LeagueId != 0 && match.LeagueId != req.LeagueId ||
+			req.TeamId != 0 && match.Home.Id != req.TeamId && match.Away.Id != req.TeamId ||
+			req.Status == types.MATCH_STATUS_FILTER_FINISHED && !match.Status.Finished {
+			continue
+		}
+		res.Matches = append(res.Matches, match)
+	}
+	res.Pagination = &query.PageResponse{Total: uint64(len(res.Matches))}
+	return res, nil
+}
+
+func (s queryServer) MatchReports(_ context.Context, req *types.QueryMatchReportsRequest) (*types.QueryMatchReportsResponse, error) {
+	return &types.QueryMatchReportsResponse{Reports: []types.MatchReport{{Reporter: "reporter", MatchId: req.MatchId, HomeScore: 2}}}, nil
+}
+
+func (s queryServer) MatchAttestation(context.Context, *types.QueryMatchAttestationRequest) (*types.QueryMatchAttestationResponse, error) {
+	return nil, status.Error(codes.NotFound, "attestation not found")
+}
+
+// querier runs the queries as the BaseApp, at the height of the request.
+type querier struct {
+	router *baseapp.GRPCQueryRouter
+	latest int64
+}
+
+func (q querier) Query(_ context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	handler := q.router.Route(req.Path)
+	if handler == nil {
+		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, req.Path), false), nil
+	}
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: req.Height}, false, log.NewNopLogger())
+	res, err := handler(ctx, req)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrKeyNotFound, err.Error()), false), nil
+	case err != nil:
+		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrUnknownRequest, err.Error()), false), nil
+	}
+	return res, nil
+}
+
+func (q querier) LastBlockHeight() int64 {
+	return q.latest
+}
+
+func newServer(t *testing.T, maxQueries int) *graphql.Server {
+	t.Helper()
+	home, away := types.Team{Id: 1, Name: "Home", LongName: "Home FC"}, types.Team{Id: 2, Name: "Away"}
+	match := types.Match{Id: 10, LeagueId: 1, Home: home, Away: away, Status: types.Status{UtcTime: kickoff, Started: true, Ongoing: true}}
+	finished := match
+	finished.HomeScore, finished.AwayScore = 2, 1
+	finished.Status.Ongoing, finished.Status.Finished = false, true
+
+	router := baseapp.NewGRPCQueryRouter()
+	router.SetInterfaceRegistry(codectypes.NewInterfaceRegistry())
+	types.RegisterQueryServer(router, &queryServer{
+		leagues: []types.League{{Id: 1, Name: "Premier League", Ccode: "ENG"}},
+		teams:   []types.Team{home, away},
+		matches: map[int64][]types.Match{1: {match}, 2: {finished}},
+	})
+
+	cfg := graphql.DefaultConfig()
+	cfg.Enable = true
+	cfg.MaxQueries = maxQueries
+	server, err := graphql.NewServer(cfg, querier{router: router, latest: 2})
+	require.NoError(t, err)
+	return server
+}
+
+// execute runs a query and returns its data as JSON.
+func execute(t *testing.T, server *graphql.Server, query string) string {
+	t.Helper()
+	result := server.Execute(context.Background(), query, "", nil)
+	require.Empty(t, result.Errors)
+	bz, err := json.Marshal(result.Data)
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestNestedQuery(t *testing.T) {
+	server := newServer(t, 100)
+
+	require.JSONEq(t, `{"leagues": {"leagues": [{"name": "Premier League", "matches": {
+			"matches": [{"id": "10", "homeScore": "2", "home": {"longName": "Home FC"}, "away": {"name": "Away"},
+				"status": {"utcTime": "2026-03-01T20:00:00Z", "finished": true}, "league": {"ccode": "ENG"}, "attestation": null,
+				"reports": {"reports": [{"reporter": "reporter", "matchId": "10", "homeScore": "2"}]}}],
+			"pagination": {"total": "1"}}}]}}`,
+		execute(t, server, `{
+			leagues {
+				leagues {
+					name
+					matches(status: MATCH_STATUS_FILTER_FINISHED) {
+						matches { id homeScore home { longName } away { name } status { utcTime finished } league { ccode } attestation { provider { name } }
+							reports { reports { reporter matchId homeScore } } }
+						pagination { total }
+					}
+				}
+			}
+		}`))
+
+	// the enum argument reaches the query
+	require.JSONEq(t, `{"match": {"league": {"matches": {"matches": []}}}}`,
+		execute(t, server, `{ match(id: 10, height: 1) { league { matches(status: MATCH_STATUS_FILTER_FINISHED) { matches { id } } } } }`))
+}
+
+func TestHistoricalQuery(t *testing.T) {
+	server := newServer(t, 100)
+
+	// the nested fields are resolved at the height of their root field
+	require.JSONEq(t, `{
+			"before": {"homeScore": "0", "status": {"ongoing": true}, "league": {"matches": {"matches": [{"homeScore": "0"}]}}},
+			"after": {"homeScore": "2", "status": {"ongoing": false}, "league": {"matches": {"matches": [{"homeScore": "2"}]}}}
+		}`,
+		execute(t, server, `{
+			before: match(id: 10, height: 1) { ...score }
+			after: match(id: "10") { ...score }
+		}
+		fragment score on Match { homeScore status { ongoing } league { matches { matches { homeScore } } } }`))
+
+	require.JSONEq(t, `{"match": null, "league": null}`, execute(t, server, `{ match(id: 99) { id } league(id: 2) { id } }`))
+
+	result := server.Execute(context.Background(), `{ match(id: 10, height: -1) { id } }`, "", nil)
+	require.Len(t, result.Errors, 1)
+	require.Contains(t, result.Errors[0].Message, "invalid height")
+}
+
+func TestMaxQueries(t *testing.T) {
+	server := newServer(t, 2)
+
+	execute(t, server, `{ match(id: 10) { league { name } } }`)
+	result := server.Execute(context.Background(), `{ match(id: 10) { league { matches { pagination { total } } } } }`, "", nil)
+	require.Len(t, result.Errors, 1)
+	require.Contains(t, result.Errors[0].Message, "more than 2 queries")
+}
+
+func TestHTTP(t *testing.T) {
+	router := mux.NewRouter()
+	graphql.RegisterRoute(router, newServer(t, 100))
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+graphql.Route, "application/json", strings.NewReader(
+		`{"query": "query Match($id: Int64!) { match(id: $id) { id home { name } } }", "variables": {"id": 10}}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Equal(t, map[string]any{"match": map[string]any{"id": "10", "home": map[string]any{"name": "Home"}}}, body["data"])
+
+	resp, err = http.Get(server.URL + graphql.Route)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
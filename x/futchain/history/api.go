@@ -0,0 +1,150 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+const (
+	// MatchRoute is the API server route of a match as of a height or a time, given by the at parameter,
+	// the latest height by default.
+	MatchRoute = "/raifpy/futchain/futchain/v1/history/matches/{id}"
+	// DiffRoute is the API server route of the diff of a match between two heights or times, given by the
+	// from and to parameters. To is the latest height by default.
+	DiffRoute = "/raifpy/futchain/futchain/v1/history/matches/{id}/diff"
+)
+
+// MatchAt is a match as of a height.
+type MatchAt struct {
+	Height    int64       `json:"height"`
+	BlockTime time.Time   `json:"block_time"`
+	Match     types.Match `json:"match"`
+}
+
+// Querier queries the matches as of a height, resolving the block times with a node.
+type Querier struct {
+	node        Node
+	queryClient types.QueryClient
+}
+
+// NewQuerier returns a querier of the matches of a query client, resolving the block times with a node.
+func NewQuerier(node Node, queryClient types.QueryClient) Querier {
+	return Querier{node: node, queryClient: queryClient}
+}
+
+// Height resolves a height or an RFC 3339 time, the latest height if empty.
+func (q Querier) Height(ctx context.Context, at string) (int64, error) {
+	if at != "" {
+		return ResolveHeight(ctx, q.node, at)
+	}
+	status, err := q.node.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// Match queries a match as of a height or a time, the latest height if empty.
+func (q Querier) Match(ctx context.Context, matchID int64, at string) (MatchAt, error) {
+	height, err := q.Height(ctx, at)
+	if err != nil {
+		return MatchAt{}, err
+	}
+	match, err := QueryMatch(ctx, q.queryClient, matchID, height)
+	if err != nil {
+		return MatchAt{}, err
+	}
+	blockTime, err := BlockTime(ctx, q.node, height)
+	if err != nil {
+		return MatchAt{}, err
+	}
+	return MatchAt{Height: height, BlockTime: blockTime, Match: match}, nil
+}
+
+// Diff returns the diff of a match between two heights or times, to the latest height if empty.
+func (q Querier) Diff(ctx context.Context, matchID int64, from, to string) (MatchDiff, error) {
+	fromHeight, err := q.Height(ctx, from)
+	if err != nil {
+		return MatchDiff{}, err
+	}
+	toHeight, err := q.Height(ctx, to)
+	if err != nil {
+		return MatchDiff{}, err
+	}
+	if fromHeight > toHeight {
+		return MatchDiff{}, fmt.Errorf("from height %d is after to height %d", fromHeight, toHeight)
+	}
+	fromMatch, err := QueryMatch(ctx, q.queryClient, matchID, fromHeight)
+	if err != nil {
+		return MatchDiff{}, err
+	}
+	toMatch, err := QueryMatch(ctx, q.queryClient, matchID, toHeight)
+	if err != nil {
+		return MatchDiff{}, err
+	}
+	return Diff(fromMatch, fromHeight, toMatch, toHeight), nil
+}
+
+// RegisterRoutes registers the match and diff routes of a querier on the API server router.
+func RegisterRoutes(router *mux.Router, q Querier) {
+	router.HandleFunc(DiffRoute, q.handleDiff).Methods(http.MethodGet)
+	router.HandleFunc(MatchRoute, q.handleMatch).Methods(http.MethodGet)
+}
+
+func (q Querier) handleMatch(w http.ResponseWriter, r *http.Request) {
+	matchID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		writeError(w, fmt.Errorf("invalid match id: %w", err))
+		return
+	}
+	match, err := q.Match(r.Context(), matchID, r.URL.Query().Get("at"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, match)
+}
+
+func (q Querier) handleDiff(w http.ResponseWriter, r *http.Request) {
+	matchID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		writeError(w, fmt.Errorf("invalid match id: %w", err))
+		return
+	}
+	params := r.URL.Query()
+	if params.Get("from") == "" {
+		writeError(w, fmt.Errorf("missing from height or time"))
+		return
+	}
+	diff, err := q.Diff(r.Context(), matchID, params.Get("from"), params.Get("to"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, diff)
+}
+
+// writeError writes an error, not found for the matches missing at a height and bad request otherwise.
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusBadRequest
+	if status.Code(err) == codes.NotFound {
+		code = http.StatusNotFound
+	}
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,135 @@
+package history
+
+import (
+	"time"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// FieldChange is the change of a field of a match between two heights.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+	// Priority is the priority of the change alone, as ranked by Match.Compare of the data source. The fields
+	// the data source does not compare have no priority, e.g. the team names.
+	Priority string `json:"priority"`
+}
+
+// MatchDiff is the field-level diff of a match between two heights.
+type MatchDiff struct {
+	MatchID    int64 `json:"match_id"`
+	FromHeight int64 `json:"from_height"`
+	ToHeight   int64 `json:"to_height"`
+	// Priority is the priority of the whole change, the highest of the fields.
+	Priority string        `json:"priority"`
+	Changes  []FieldChange `json:"changes"`
+}
+
+// matchField is a field of a match the diff compares.
+type matchField struct {
+	name string
+	get  func(m *types.Match) any
+	set  func(dst, src *types.Match)
+}
+
+// matchFields are the fields of a match, in the order of the diff.
+var matchFields = []matchField{
+	{"league_id", func(m *types.Match) any { return m.LeagueId }, func(dst, src *types.Match) { dst.LeagueId = src.LeagueId }},
+	{"time", func(m *types.Match) any { return m.Time }, func(dst, src *types.Match) { dst.Time = src.Time }},
+	{"time_ts", func(m *types.Match) any { return m.TimeTs }, func(dst, src *types.Match) { dst.TimeTs = src.TimeTs }},
+	{"home.name", func(m *types.Match) any { return m.Home.Name }, func(dst, src *types.Match) { dst.Home.Name = src.Home.Name }},
+	{"home.long_name", func(m *types.Match) any { return m.Home.LongName }, func(dst, src *types.Match) { dst.Home.LongName = src.Home.LongName }},
+	{"away.name", func(m *types.Match) any { return m.Away.Name }, func(dst, src *types.Match) { dst.Away.Name = src.Away.Name }},
+	{"away.long_name", func(m *types.Match) any { return m.Away.LongName }, func(dst, src *types.Match) { dst.Away.LongName = src.Away.LongName }},
+	{"home_score", func(m *types.Match) any { return m.HomeScore }, func(dst, src *types.Match) { dst.HomeScore = src.HomeScore }},
+	{"away_score", func(m *types.Match) any { return m.AwayScore }, func(dst, src *types.Match) { dst.AwayScore = src.AwayScore }},
+	{"status_id", func(m *types.Match) any { return m.StatusId }, func(dst, src *types.Match) { dst.StatusId = src.StatusId }},
+	{"tournament_stage", func(m *types.Match) any { return m.TournamentStage }, func(dst, src *types.Match) { dst.TournamentStage = src.TournamentStage }},
+	{"status.utc_time", func(m *types.Match) any { return m.Status.UtcTime }, func(dst, src *types.Match) { dst.Status.UtcTime = src.Status.UtcTime }},
+	{"status.period_length", func(m *types.Match) any { return m.Status.PeriodLength }, func(dst, src *types.Match) { dst.Status.PeriodLength = src.Status.PeriodLength }},
+	{"status.started", func(m *types.Match) any { return m.Status.Started }, func(dst, src *types.Match) { dst.Status.Started = src.Status.Started }},
+	{"status.ongoing", func(m *types.Match) any { return m.Status.Ongoing }, func(dst, src *types.Match) { dst.Status.Ongoing = src.Status.Ongoing }},
+	{"status.finished", func(m *types.Match) any { return m.Status.Finished }, func(dst, src *types.Match) { dst.Status.Finished = src.Status.Finished }},
+	{"status.cancelled", func(m *types.Match) any { return m.Status.Cancelled }, func(dst, src *types.Match) { dst.Status.Cancelled = src.Status.Cancelled }},
+	{"status.live_time.long", func(m *types.Match) any { return m.Status.LiveTime.Long }, func(dst, src *types.Match) { dst.Status.LiveTime.Long = src.Status.LiveTime.Long }},
+	{"status.live_time.max_time", func(m *types.Match) any { return m.Status.LiveTime.MaxTime }, func(dst, src *types.Match) { dst.Status.LiveTime.MaxTime = src.Status.LiveTime.MaxTime }},
+	{"status.live_time.added_time", func(m *types.Match) any { return m.Status.LiveTime.AddedTime }, func(dst, src *types.Match) { dst.Status.LiveTime.AddedTime = src.Status.LiveTime.AddedTime }},
+	{"finalized", func(m *types.Match) any { return m.Finalized }, func(dst, src *types.Match) { dst.Finalized = src.Finalized }},
+}
+
+// Diff returns the changed fields of a match between its states at two heights.
+func Diff(from types.Match, fromHeight int64, to types.Match, toHeight int64) MatchDiff {
+	diff := MatchDiff{
+		MatchID:    to.Id,
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+		Priority:   priorityName(compare(to, from)),
+		Changes:    []FieldChange{},
+	}
+	for _, field := range matchFields {
+		fromValue, toValue := field.get(&from), field.get(&to)
+		if equal(fromValue, toValue) {
+			continue
+		}
+		changed := from
+		field.set(&changed, &to)
+		diff.Changes = append(diff.Changes, FieldChange{
+			Field:    field.name,
+			From:     fromValue,
+			To:       toValue,
+			Priority: priorityName(compare(changed, from)),
+		})
+	}
+	return diff
+}
+
+// equal compares the values of a field, the times by instant.
+func equal(a, b any) bool {
+	if t, ok := a.(time.Time); ok {
+		return t.Equal(b.(time.Time))
+	}
+	return a == b
+}
+
+// compare ranks the change of a match with Match.Compare of the data source.
+func compare(to, from types.Match) datasource.ComparePriority {
+	toMatch, fromMatch := datasourceMatch(to), datasourceMatch(from)
+	return toMatch.Compare(&fromMatch)
+}
+
+// priorityName returns the name of a priority without its prefix, e.g. score.
+func priorityName(priority datasource.ComparePriority) string {
+	if priority == datasource.PriorityNoChanges {
+		return ""
+	}
+	return priority.EventName()[len("match_"):]
+}
+
+// datasourceMatch converts a match to the data source match it was ingested from.
+func datasourceMatch(m types.Match) datasource.Match {
+	return datasource.Match{
+		ID:              int(m.Id),
+		LeagueID:        int(m.LeagueId),
+		Time:            m.Time,
+		Home:            datasource.Team{ID: int(m.Home.Id), Score: int(m.HomeScore), Name: m.Home.Name, LongName: m.Home.LongName},
+		Away:            datasource.Team{ID: int(m.Away.Id), Score: int(m.AwayScore), Name: m.Away.Name, LongName: m.Away.LongName},
+		StatusID:        int(m.StatusId),
+		TournamentStage: m.TournamentStage,
+		Status: datasource.Status{
+			UtcTime:      m.Status.UtcTime,
+			PeriodLength: int(m.Status.PeriodLength),
+			Started:      m.Status.Started,
+			Cancelled:    m.Status.Cancelled,
+			Finished:     m.Status.Finished,
+			Ongoing:      m.Status.Ongoing,
+			LiveTime: datasource.LiveTime{
+				Long:      m.Status.LiveTime.Long,
+				MaxTime:   int(m.Status.LiveTime.MaxTime),
+				AddedTime: int(m.Status.LiveTime.AddedTime),
+			},
+		},
+		TimeTS: m.TimeTs,
+	}
+}
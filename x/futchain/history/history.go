@@ -0,0 +1,100 @@
+// Package history answers what the chain said about a match at a block: it resolves a block time to the
+// height of the state at that time, queries a match as of a height, and diffs the states of a match between
+// two heights. The queries run on the pruning window of the queried node.
+package history
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// Node is the CometBFT RPC client resolving the block times, implemented by client.CometRPC.
+type Node interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error)
+}
+
+// HeightAt returns the height of the state of the chain at a time, the last block with a time not after it,
+// and the time of that block. The time must not be before the earliest block kept by the node.
+func HeightAt(ctx context.Context, node Node, t time.Time) (int64, time.Time, error) {
+	status, err := node.Status(ctx)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	sync := status.SyncInfo
+	if t.Before(sync.EarliestBlockTime) {
+		return 0, time.Time{}, fmt.Errorf("%s is before the earliest block %d of the node at %s",
+			t.UTC().Format(time.RFC3339), sync.EarliestBlockHeight, sync.EarliestBlockTime.UTC().Format(time.RFC3339))
+	}
+	if !t.Before(sync.LatestBlockTime) {
+		return sync.LatestBlockHeight, sync.LatestBlockTime, nil
+	}
+
+	// the block times are monotonic: bisect for the last block at or before the time, lo is at or before it
+	// and hi is after it
+	lo, hi, loTime := sync.EarliestBlockHeight, sync.LatestBlockHeight, sync.EarliestBlockTime
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		midTime, err := BlockTime(ctx, node, mid)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+		if midTime.After(t) {
+			hi = mid
+		} else {
+			lo, loTime = mid, midTime
+		}
+	}
+	return lo, loTime, nil
+}
+
+// BlockTime returns the time of the block at a height.
+func BlockTime(ctx context.Context, node Node, height int64) (time.Time, error) {
+	info, err := node.BlockchainInfo(ctx, height, height)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(info.BlockMetas) == 0 {
+		return time.Time{}, fmt.Errorf("block %d not found", height)
+	}
+	return info.BlockMetas[0].Header.Time, nil
+}
+
+// ResolveHeight resolves a height, or an RFC 3339 block time to the height of the state at that time.
+func ResolveHeight(ctx context.Context, node Node, at string) (int64, error) {
+	if height, err := strconv.ParseInt(at, 10, 64); err == nil {
+		if height <= 0 {
+			return 0, fmt.Errorf("invalid height %d", height)
+		}
+		return height, nil
+	}
+	t, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return 0, fmt.Errorf("invalid height or time %q, expected a height or an RFC 3339 time", at)
+	}
+	height, _, err := HeightAt(ctx, node, t)
+	return height, err
+}
+
+// WithHeight returns a context querying the state at a height, through the gRPC client of the node or its
+// ABCI queries.
+func WithHeight(ctx context.Context, height int64) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+}
+
+// QueryMatch queries a match as of a height.
+func QueryMatch(ctx context.Context, queryClient types.QueryClient, matchID, height int64) (types.Match, error) {
+	res, err := queryClient.Match(WithHeight(ctx, height), &types.QueryMatchRequest{Id: matchID})
+	if err != nil {
+		return types.Match{}, fmt.Errorf("match %d at height %d: %w", matchID, height, err)
+	}
+	return res.Match, nil
+}
@@ -0,0 +1,178 @@
+package history_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/raifpy/futchain/x/futchain/history"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+var genesis = time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+
+// node has a block every ten seconds, from height 5 to 100, the earlier heights being pruned.
+type node struct {
+	calls int
+}
+
+func (n *node) blockTime(height int64) time.Time {
+	return genesis.Add(time.Duration(height) * 10 * time.Second)
+}
+
+func (n *node) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+		EarliestBlockHeight: 5, EarliestBlockTime: n.blockTime(5),
+		LatestBlockHeight: 100, LatestBlockTime: n.blockTime(100),
+	}}, nil
+}
+
+func (n *node) BlockchainInfo(_ context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
+	n.calls++
+	if minHeight != maxHeight {
+		return nil, fmt.Errorf("range %d-%d queried", minHeight, maxHeight)
+	}
+	return &coretypes.ResultBlockchainInfo{BlockMetas: []*cmttypes.BlockMeta{
+		{Header: cmttypes.Header{Height: minHeight, Time: n.blockTime(minHeight)}},
+	}}, nil
+}
+
+func TestHeightAt(t *testing.T) {
+	n := &node{}
+	for _, tc := range []struct {
+		time   time.Time
+		height int64
+	}{
+		{genesis.Add(5 * 10 * time.Second), 5},
+		{genesis.Add(42 * 10 * time.Second), 42},
+		{genesis.Add(42*10*time.Second + 9*time.Second), 42},
+		{genesis.Add(99*10*time.Second + time.Second), 99},
+		{genesis.Add(100 * 10 * time.Second), 100},
+		{genesis.Add(time.Hour), 100},
+	} {
+		height, blockTime, err := history.HeightAt(context.Background(), n, tc.time)
+		require.NoError(t, err)
+		require.Equal(t, tc.height, height, tc.time)
+		require.Equal(t, n.blockTime(tc.height), blockTime)
+	}
+	// bisected, not scanned
+	require.Less(t, n.calls, 50)
+
+	_, _, err := history.HeightAt(context.Background(), n, genesis)
+	require.ErrorContains(t, err, "before the earliest block 5")
+
+	height, err := history.ResolveHeight(context.Background(), n, "2026-03-01T20:07:00Z")
+	require.NoError(t, err)
+	require.Equal(t, int64(42), height)
+	height, err = history.ResolveHeight(context.Background(), n, "17")
+	require.NoError(t, err)
+	require.Equal(t, int64(17), height)
+	_, err = history.ResolveHeight(context.Background(), n, "yesterday")
+	require.ErrorContains(t, err, "invalid height or time")
+}
+
+// queryClient serves a match ingested at height 10, kicking off at height 20 and scoring at height 30.
+type queryClient struct {
+	types.QueryClient
+}
+
+func (queryClient) Match(ctx context.Context, req *types.QueryMatchRequest, _ ...grpc.CallOption) (*types.QueryMatchResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	height, err := strconv.ParseInt(md.Get(grpctypes.GRPCBlockHeightHeader)[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if req.Id != 1 || height < 10 {
+		return nil, status.Error(codes.NotFound, "match not found")
+	}
+	match := types.Match{Id: 1, LeagueId: 47, Home: types.Team{Id: 1, Name: "Home"}, Away: types.Team{Id: 2, Name: "Away"}}
+	if height >= 20 {
+		match.Status.Started, match.Status.Ongoing = true, true
+		match.Status.LiveTime.Long = "0:" + strconv.FormatInt(height, 10)
+	}
+	if height >= 30 {
+		match.HomeScore = 1
+	}
+	return &types.QueryMatchResponse{Match: match}, nil
+}
+
+func TestDiff(t *testing.T) {
+	q := history.NewQuerier(&node{}, queryClient{})
+
+	diff, err := q.Diff(context.Background(), 1, "10", "35")
+	require.NoError(t, err)
+	require.Equal(t, history.MatchDiff{MatchID: 1, FromHeight: 10, ToHeight: 35, Priority: "score", Changes: []history.FieldChange{
+		{Field: "home_score", From: int64(0), To: int64(1), Priority: "score"},
+		{Field: "status.started", From: false, To: true, Priority: "started"},
+		{Field: "status.ongoing", From: false, To: true, Priority: "ongoing"},
+		{Field: "status.live_time.long", From: "", To: "0:35", Priority: "live_time"},
+	}}, diff)
+
+	// the time resolves to height 25, and to the latest height by default
+	diff, err = q.Diff(context.Background(), 1, "2026-03-01T20:04:15Z", "")
+	require.NoError(t, err)
+	require.Equal(t, int64(25), diff.FromHeight)
+	require.Equal(t, int64(100), diff.ToHeight)
+	require.Equal(t, []history.FieldChange{
+		{Field: "home_score", From: int64(0), To: int64(1), Priority: "score"},
+		{Field: "status.live_time.long", From: "0:25", To: "0:100", Priority: "live_time"},
+	}, diff.Changes)
+
+	diff, err = q.Diff(context.Background(), 1, "12", "15")
+	require.NoError(t, err)
+	require.Empty(t, diff.Priority)
+	require.Empty(t, diff.Changes)
+
+	_, err = q.Diff(context.Background(), 1, "35", "10")
+	require.ErrorContains(t, err, "is after to height")
+	_, err = q.Diff(context.Background(), 1, "5", "10")
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRoutes(t *testing.T) {
+	router := mux.NewRouter()
+	history.RegisterRoutes(router, history.NewQuerier(&node{}, queryClient{}))
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	get := func(path string, code int, v any) {
+		t.Helper()
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, code, resp.StatusCode)
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+	}
+
+	var match history.MatchAt
+	get("/raifpy/futchain/futchain/v1/history/matches/1?at=2026-03-01T20:05:00Z", http.StatusOK, &match)
+	require.Equal(t, int64(30), match.Height)
+	require.Equal(t, genesis.Add(5*time.Minute), match.BlockTime)
+	require.Equal(t, int64(1), match.Match.HomeScore)
+
+	var diff history.MatchDiff
+	get("/raifpy/futchain/futchain/v1/history/matches/1/diff?from=20&to=30", http.StatusOK, &diff)
+	require.Equal(t, "score", diff.Priority)
+	require.Len(t, diff.Changes, 2)
+
+	var body map[string]string
+	get("/raifpy/futchain/futchain/v1/history/matches/2", http.StatusNotFound, &body)
+	require.Contains(t, body["error"], "match not found")
+	get("/raifpy/futchain/futchain/v1/history/matches/1/diff", http.StatusBadRequest, &body)
+	require.Contains(t, body["error"], "missing from")
+}
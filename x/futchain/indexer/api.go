@@ -0,0 +1,140 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// QueryRoute is the API server route of the read-only SQL queries. The query is given by the sql
+	// parameter of a GET request, or the body of a POST request.
+	QueryRoute = "/raifpy/futchain/futchain/v1/indexer/query"
+	// StatusRoute is the API server route of the indexed height.
+	StatusRoute = "/raifpy/futchain/futchain/v1/indexer/status"
+
+	// maxQuerySize bounds the size of a query.
+	maxQuerySize = 64 << 10
+)
+
+// QueryResult is the result of a query.
+type QueryResult struct {
+	Columns []string `json:"columns"`
+	Rows    [][]any  `json:"rows"`
+	// Truncated is set if the rows were cut at the max rows of the config.
+	Truncated bool `json:"truncated"`
+}
+
+// Query runs a read-only SQL query on the index. The query runs in a read-only transaction and as a single
+// prepared statement, within the timeout and max rows of the config.
+func (ix *Indexer) Query(ctx context.Context, query string) (QueryResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, ix.cfg.QueryTimeout)
+	defer cancel()
+
+	tx, err := ix.readDB.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return QueryResult{}, err
+	}
+	defer tx.Rollback() //nolint:errcheck // nothing to commit
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return QueryResult{}, err
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx)
+	if err != nil {
+		return QueryResult{}, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return QueryResult{}, err
+	}
+	result := QueryResult{Columns: columns, Rows: [][]any{}}
+	for rows.Next() {
+		if len(result.Rows) == ix.cfg.MaxRows {
+			result.Truncated = true
+			break
+		}
+
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return QueryResult{}, err
+		}
+		for i, value := range values {
+			switch v := value.(type) {
+			case []byte:
+				values[i] = string(v)
+			case time.Time:
+				values[i] = v.UTC().Format(time.RFC3339)
+			}
+		}
+		result.Rows = append(result.Rows, values)
+	}
+	return result, rows.Err()
+}
+
+// RegisterRoutes registers the query and status routes of the indexer on the API server router.
+func RegisterRoutes(router *mux.Router, ix *Indexer) {
+	router.HandleFunc(QueryRoute, ix.handleQuery).Methods(http.MethodGet, http.MethodPost)
+	router.HandleFunc(StatusRoute, ix.handleStatus).Methods(http.MethodGet)
+}
+
+func (ix *Indexer) handleQuery(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("sql")
+	if r.Method == http.MethodPost {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxQuerySize+1))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		query = string(body)
+	}
+	if query == "" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("empty query"))
+		return
+	}
+	if len(query) > maxQuerySize {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("query larger than %d bytes", maxQuerySize))
+		return
+	}
+
+	result, err := ix.Query(r.Context(), query)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (ix *Indexer) handleStatus(w http.ResponseWriter, r *http.Request) {
+	height, err := ix.Height(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"height": height, "driver": ix.cfg.Driver})
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
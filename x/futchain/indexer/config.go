@@ -0,0 +1,113 @@
+package indexer
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// DriverSQLite indexes into an embedded SQLite database.
+	DriverSQLite = "sqlite"
+	// DriverPostgres indexes into a Postgres database.
+	DriverPostgres = "postgres"
+)
+
+// Config is the futchain-indexer section of app.toml.
+type Config struct {
+	// Enable turns the indexer on.
+	Enable bool `mapstructure:"enable"`
+	// Driver is the database driver, sqlite or postgres.
+	Driver string `mapstructure:"driver"`
+	// DSN is the data source name of the database. It defaults to the futchain-index.db SQLite database of
+	// the data directory.
+	DSN string `mapstructure:"dsn"`
+	// MaxRows bounds the rows returned by a query of the query API.
+	MaxRows int `mapstructure:"max-rows"`
+	// QueryTimeout bounds the duration of a query of the query API.
+	QueryTimeout time.Duration `mapstructure:"query-timeout"`
+}
+
+// DefaultConfig returns the default indexer config. The indexer is disabled by default.
+func DefaultConfig() Config {
+	return Config{
+		Driver:       DriverSQLite,
+		MaxRows:      1000,
+		QueryTimeout: 5 * time.Second,
+	}
+}
+
+// DefaultConfigTemplate is the app.toml template of the indexer config.
+const DefaultConfigTemplate = `
+###############################################################################
+###                         Futchain Indexer                                ###
+###############################################################################
+
+[futchain-indexer]
+
+# Enable indexes the leagues, teams and matches into an SQL database, and serves the read-only
+# /raifpy/futchain/futchain/v1/indexer/query route of the API server.
+enable = {{ .FutchainIndexer.Enable }}
+
+# Driver is the database driver: sqlite or postgres.
+driver = "{{ .FutchainIndexer.Driver }}"
+
+# DSN is the data source name of the database. It defaults to the futchain-index.db SQLite database
+# of the data directory.
+dsn = "{{ .FutchainIndexer.DSN }}"
+
+# MaxRows bounds the rows returned by a query.
+max-rows = {{ .FutchainIndexer.MaxRows }}
+
+# QueryTimeout bounds the duration of a query.
+query-timeout = "{{ .FutchainIndexer.QueryTimeout }}"
+`
+
+// ConfigFromAppOptions reads the indexer config from the app options, over the defaults.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	cfg.Enable = cast.ToBool(appOpts.Get("futchain-indexer.enable"))
+	if v := cast.ToString(appOpts.Get("futchain-indexer.driver")); v != "" {
+		cfg.Driver = v
+	}
+	cfg.DSN = cast.ToString(appOpts.Get("futchain-indexer.dsn"))
+	if v := cast.ToInt(appOpts.Get("futchain-indexer.max-rows")); v > 0 {
+		cfg.MaxRows = v
+	}
+	if v := cast.ToDuration(appOpts.Get("futchain-indexer.query-timeout")); v > 0 {
+		cfg.QueryTimeout = v
+	}
+	return cfg
+}
+
+// Validate checks the config.
+func (c Config) Validate() error {
+	switch c.Driver {
+	case DriverSQLite:
+	case DriverPostgres:
+		if c.DSN == "" {
+			return fmt.Errorf("the %s driver requires a dsn", c.Driver)
+		}
+	default:
+		return fmt.Errorf("unknown driver %q, expected %s or %s", c.Driver, DriverSQLite, DriverPostgres)
+	}
+	if c.MaxRows <= 0 {
+		return fmt.Errorf("max rows must be positive")
+	}
+	if c.QueryTimeout <= 0 {
+		return fmt.Errorf("query timeout must be positive")
+	}
+	return nil
+}
+
+// dsn returns the data source name of the database, defaulting to a SQLite database of the home directory.
+func (c Config) dsn(homePath string) string {
+	if c.DSN != "" || c.Driver != DriverSQLite {
+		return c.DSN
+	}
+	return "file:" + filepath.Join(homePath, "data", "futchain-index.db") + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+}
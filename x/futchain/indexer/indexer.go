@@ -0,0 +1,312 @@
+// Package indexer indexes the leagues, teams and matches of futchain into an SQL database, SQLite or
+// Postgres, for the questions the chain queries can not answer without replaying the blocks. It is an
+// ADR-038 listener of the futchain store: the changes of each committed block are written in one database
+// transaction.
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/lib/pq"  // postgres driver
+	_ "modernc.org/sqlite" // sqlite driver
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource/flatbuffers"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+var _ storetypes.ABCIListener = (*Indexer)(nil)
+
+// idSize is the size of the big endian ids of the ingested items keys.
+const idSize = 8
+
+// Indexer writes the futchain state changes into an SQL database.
+type Indexer struct {
+	cfg    Config
+	logger log.Logger
+
+	// db is used for the writes, readDB for the query API
+	db     *sql.DB
+	readDB *sql.DB
+
+	mu sync.Mutex
+	// stopped is set once a block fails to be indexed. The index is caught up by the backfill of the next
+	// start.
+	stopped bool
+}
+
+// Open opens the database of the indexer and creates its tables.
+func Open(cfg Config, homePath string, logger log.Logger) (*Indexer, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	dsn := cfg.dsn(homePath)
+	if cfg.DSN == "" {
+		if err := os.MkdirAll(filepath.Join(homePath, "data"), 0o755); err != nil {
+			return nil, err
+		}
+	}
+	db, err := sql.Open(cfg.Driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	readDSN := dsn
+	if cfg.Driver == DriverSQLite {
+		// the query connections refuse the writes
+		readDSN += dsnSeparator(dsn) + "_pragma=query_only(1)"
+	}
+	readDB, err := sql.Open(cfg.Driver, readDSN)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	ix := &Indexer{cfg: cfg, logger: logger.With("module", "futchain-indexer"), db: db, readDB: readDB}
+	for _, stmt := range schema {
+		if _, err := db.Exec(stmt); err != nil {
+			ix.Close()
+			return nil, fmt.Errorf("failed to create the index schema: %w", err)
+		}
+	}
+	return ix, nil
+}
+
+// dsnSeparator returns the separator of a parameter appended to a data source name.
+func dsnSeparator(dsn string) string {
+	if strings.Contains(dsn, "?") {
+		return "&"
+	}
+	return "?"
+}
+
+// Close closes the database of the indexer.
+func (ix *Indexer) Close() error {
+	return errors.Join(ix.db.Close(), ix.readDB.Close())
+}
+
+// Height returns the height the index is at, zero if it is empty.
+func (ix *Indexer) Height(ctx context.Context) (int64, error) {
+	var height int64
+	err := ix.db.QueryRowContext(ctx, selectHeight).Scan(&height)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface.
+func (ix *Indexer) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface. It indexes the futchain store changes of the committed
+// block.
+func (ix *Indexer) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	if ix.stopped {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	indexed, err := ix.Height(ctx)
+	if err != nil {
+		return ix.stop(height, err)
+	}
+	// blocks replayed after a restart are already indexed
+	if height <= indexed {
+		return nil
+	}
+
+	var pairs []*storetypes.StoreKVPair
+	for _, pair := range changeSet {
+		if pair.StoreKey == types.StoreKey {
+			pairs = append(pairs, pair)
+		}
+	}
+	if err := ix.apply(ctx, height, pairs); err != nil {
+		return ix.stop(height, err)
+	}
+	return nil
+}
+
+// stop stops the indexing after a block failed to be indexed.
+func (ix *Indexer) stop(height int64, err error) error {
+	ix.stopped = true
+	ix.logger.Error("failed to index block, the indexer is stopped until the node restarts", "height", height, "error", err)
+	return err
+}
+
+// Backfill indexes the committed futchain store at a height, when the index is behind it: on the first start
+// of the indexer, after a state sync from a snapshot, or after a block failed to be indexed. Only the
+// current state of the matches is known, so their timeline starts at the height.
+func (ix *Indexer) Backfill(ctx context.Context, store storetypes.KVStore, height int64) error {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	indexed, err := ix.Height(ctx)
+	if err != nil {
+		return err
+	}
+	if height <= indexed {
+		return nil
+	}
+	ix.logger.Info("backfilling the index", "from", indexed, "to", height)
+
+	var pairs []*storetypes.StoreKVPair
+	for _, prefix := range [][]byte{keeper.LeagueKey, keeper.TeamKey, keeper.MatchKey, types.FinalizedMatchesKey} {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs = append(pairs, &storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: iterator.Key(), Value: iterator.Value()})
+		}
+		if err := iterator.Close(); err != nil {
+			return err
+		}
+	}
+	if err := ix.apply(ctx, height, pairs); err != nil {
+		return err
+	}
+	ix.stopped = false
+	return nil
+}
+
+// apply writes the futchain store changes of a height in one transaction. The matches are written before
+// their finality.
+func (ix *Indexer) apply(ctx context.Context, height int64, pairs []*storetypes.StoreKVPair) error {
+	tx, err := ix.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // a no-op after the commit
+
+	exec := func(query string, args ...any) error {
+		_, err := tx.ExecContext(ctx, ix.rebind(query), args...)
+		return err
+	}
+
+	var finality []*storetypes.StoreKVPair
+	for _, pair := range pairs {
+		if bytes.HasPrefix(pair.Key, types.FinalizedMatchesKey) {
+			finality = append(finality, pair)
+			continue
+		}
+		if err := applyItem(exec, height, pair); err != nil {
+			return fmt.Errorf("key %x: %w", pair.Key, err)
+		}
+	}
+	for _, pair := range finality {
+		_, matchID, err := collections.Int64Key.Decode(pair.Key[len(types.FinalizedMatchesKey):])
+		if err != nil {
+			return fmt.Errorf("key %x: %w", pair.Key, err)
+		}
+		if pair.Delete {
+			err = exec(updateFinalized, false, 0, matchID)
+		} else {
+			finalizedHeight, decodeErr := collections.Int64Value.Decode(pair.Value)
+			if decodeErr != nil {
+				return fmt.Errorf("key %x: %w", pair.Key, decodeErr)
+			}
+			err = exec(updateFinalized, true, finalizedHeight, matchID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := exec(upsertHeight, height); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// applyItem writes the change of an ingested league, team or match. Other keys, such as the unfinished
+// match index, are skipped.
+func applyItem(exec func(query string, args ...any) error, height int64, pair *storetypes.StoreKVPair) error {
+	id, prefix, ok := itemID(pair.Key)
+	if !ok {
+		return nil
+	}
+
+	switch {
+	case bytes.Equal(prefix, keeper.LeagueKey):
+		if pair.Delete {
+			return exec(deleteLeague, id)
+		}
+		l, err := flatbuffers.NewLeagueEncoder().DecodeFromBinary(pair.Value)
+		if err != nil {
+			return err
+		}
+		return exec(upsertLeague, l.ID, l.Name, l.GroupName, l.IsGroup, l.Ccode, l.PrimaryID, height)
+
+	case bytes.Equal(prefix, keeper.TeamKey):
+		if pair.Delete {
+			return exec(deleteTeam, id)
+		}
+		t, err := flatbuffers.NewTeamEncoder().DecodeFromBinary(pair.Value)
+		if err != nil {
+			return err
+		}
+		return exec(upsertTeam, t.ID, t.Name, t.LongName, height)
+
+	default:
+		if pair.Delete {
+			return exec(deleteMatch, id)
+		}
+		m, err := flatbuffers.NewMatchEncoder().DecodeFromBinary(pair.Value)
+		if err != nil {
+			return err
+		}
+		s := m.Status
+		if err := exec(upsertMatch, m.ID, m.LeagueID, m.Home.ID, m.Away.ID, m.Home.Score, m.Away.Score, s.UtcTime.Unix(), m.StatusID,
+			m.TournamentStage, s.Started, s.Ongoing, s.Finished, s.Cancelled, s.LiveTime.Long, height); err != nil {
+			return err
+		}
+		return exec(upsertTimeline, m.ID, height, m.Home.Score, m.Away.Score, s.Started, s.Ongoing, s.Finished, s.Cancelled, s.LiveTime.Long)
+	}
+}
+
+// itemID returns the id and key prefix of a league, team or match key.
+func itemID(key []byte) (int64, []byte, bool) {
+	for _, prefix := range [][]byte{keeper.LeagueKey, keeper.TeamKey, keeper.MatchKey} {
+		if len(key) == len(prefix)+idSize && bytes.HasPrefix(key, prefix) {
+			return int64(sdk.BigEndianToUint64(key[len(prefix):])), prefix, true
+		}
+	}
+	return 0, nil, false
+}
+
+// rebind rewrites the ? placeholders of a query to the placeholders of the driver.
+func (ix *Indexer) rebind(query string) string {
+	if ix.cfg.Driver != DriverPostgres {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
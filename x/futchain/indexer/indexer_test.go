@@ -0,0 +1,196 @@
+package indexer_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/indexer"
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource/flatbuffers"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func openIndexer(t *testing.T) *indexer.Indexer {
+	t.Helper()
+	cfg := indexer.DefaultConfig()
+	cfg.Enable = true
+	cfg.MaxRows = 2
+	ix, err := indexer.Open(cfg, t.TempDir(), log.NewNopLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { ix.Close() })
+	return ix
+}
+
+func itemPair(t *testing.T, prefix []byte, id int, value []byte, err error) *storetypes.StoreKVPair {
+	t.Helper()
+	require.NoError(t, err)
+	return &storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(id))...), Value: value}
+}
+
+func leaguePair(t *testing.T, league datasource.League) *storetypes.StoreKVPair {
+	bz, err := flatbuffers.EncodeLeague(&league)
+	return itemPair(t, keeper.LeagueKey, league.ID, bz, err)
+}
+
+func teamPair(t *testing.T, team datasource.Team) *storetypes.StoreKVPair {
+	bz, err := flatbuffers.EncodeTeam(&team)
+	return itemPair(t, keeper.TeamKey, team.ID, bz, err)
+}
+
+func matchPair(t *testing.T, match datasource.Match) *storetypes.StoreKVPair {
+	bz, err := flatbuffers.EncodeMatch(&match)
+	return itemPair(t, keeper.MatchKey, match.ID, bz, err)
+}
+
+func finalizedPair(t *testing.T, matchID, height int64) *storetypes.StoreKVPair {
+	t.Helper()
+	key, err := collections.EncodeKeyWithPrefix(types.FinalizedMatchesKey, collections.Int64Key, matchID)
+	require.NoError(t, err)
+	value, err := collections.Int64Value.Encode(height)
+	require.NoError(t, err)
+	return &storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: key, Value: value}
+}
+
+func commit(t *testing.T, ix *indexer.Indexer, height int64, pairs ...*storetypes.StoreKVPair) {
+	t.Helper()
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: height}, false, log.NewNopLogger())
+	require.NoError(t, ix.ListenCommit(ctx, abci.ResponseCommit{}, pairs))
+}
+
+func query(t *testing.T, ix *indexer.Indexer, sql string) [][]any {
+	t.Helper()
+	result, err := ix.Query(context.Background(), sql)
+	require.NoError(t, err)
+	return result.Rows
+}
+
+func TestIndexer(t *testing.T) {
+	ix := openIndexer(t)
+	kickoff := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	match := datasource.Match{ID: 10, LeagueID: 87, Home: datasource.Team{ID: 8634}, Away: datasource.Team{ID: 8633},
+		Status: datasource.Status{UtcTime: kickoff, Started: true, Ongoing: true, LiveTime: datasource.LiveTime{Long: "12:00"}}}
+
+	commit(t, ix, 5,
+		leaguePair(t, datasource.League{ID: 87, Name: "LaLiga", Ccode: "ESP"}),
+		teamPair(t, datasource.Team{ID: 8634, Name: "Barcelona", LongName: "FC Barcelona"}),
+		teamPair(t, datasource.Team{ID: 8633, Name: "Real Madrid"}),
+		matchPair(t, match),
+		// other store keys are skipped
+		&storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: append([]byte("match_unfinished"), sdk.Uint64ToBigEndian(10)...), Value: []byte{1}},
+		&storetypes.StoreKVPair{StoreKey: "bank", Key: []byte("team"), Value: []byte{1}},
+	)
+
+	match.Away.Score = 1
+	match.Status.LiveTime.Long = "30:00"
+	commit(t, ix, 6, matchPair(t, match))
+
+	match.Status.Ongoing, match.Status.Finished = false, true
+	commit(t, ix, 7, matchPair(t, match))
+	commit(t, ix, 9, finalizedPair(t, 10, 9))
+
+	// a block replayed after a restart is not indexed twice
+	match.Away.Score = 5
+	commit(t, ix, 7, matchPair(t, match))
+
+	height, err := ix.Height(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 9, height)
+
+	// all Barcelona matches in 2026 where they conceded
+	rows := query(t, ix, `SELECT m.id, h.name, a.name, m.home_score, m.away_score, m.finalized, m.finalized_height
+		FROM matches m JOIN teams h ON h.id = m.home_id JOIN teams a ON a.id = m.away_id
+		WHERE (m.home_id = 8634 AND m.away_score > 0 OR m.away_id = 8634 AND m.home_score > 0)
+			AND m.kickoff >= 1767225600 AND m.kickoff < 1798761600`)
+	require.Equal(t, [][]any{{int64(10), "Barcelona", "Real Madrid", int64(0), int64(1), int64(1), int64(9)}}, rows)
+
+	rows = query(t, ix, `SELECT height, away_score, live_time, finished FROM match_timeline WHERE match_id = 10 ORDER BY height`)
+	require.Equal(t, [][]any{{int64(5), int64(0), "12:00", int64(0)}, {int64(6), int64(1), "30:00", int64(0)}}, rows)
+
+	result, err := ix.Query(context.Background(), `SELECT height FROM match_timeline ORDER BY height`)
+	require.NoError(t, err)
+	require.True(t, result.Truncated)
+
+	// the query API is read-only
+	_, err = ix.Query(context.Background(), `DELETE FROM matches`)
+	require.Error(t, err)
+	_, err = ix.Query(context.Background(), `SELECT 1; DELETE FROM matches`)
+	require.Error(t, err)
+	require.Len(t, query(t, ix, `SELECT id FROM matches`), 1)
+}
+
+func TestIndexerBackfill(t *testing.T) {
+	ix := openIndexer(t)
+
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for _, pair := range []*storetypes.StoreKVPair{
+		teamPair(t, datasource.Team{ID: 1, Name: "Home"}),
+		teamPair(t, datasource.Team{ID: 2, Name: "Away"}),
+		matchPair(t, datasource.Match{ID: 10, Home: datasource.Team{ID: 1, Score: 2}, Away: datasource.Team{ID: 2}}),
+		matchPair(t, datasource.Match{ID: 11, Home: datasource.Team{ID: 2}, Away: datasource.Team{ID: 1}}),
+		finalizedPair(t, 10, 90),
+	} {
+		store.Set(pair.Key, pair.Value)
+	}
+	store.Set(append([]byte("match_unfinished"), sdk.Uint64ToBigEndian(11)...), []byte{1})
+
+	require.NoError(t, ix.Backfill(context.Background(), store, 100))
+	require.Equal(t, [][]any{{int64(10), int64(2), int64(1)}, {int64(11), int64(0), int64(0)}},
+		query(t, ix, `SELECT id, home_score, finalized FROM matches ORDER BY id`))
+	require.Equal(t, [][]any{{int64(10), int64(100)}, {int64(11), int64(100)}},
+		query(t, ix, `SELECT match_id, height FROM match_timeline ORDER BY match_id`))
+
+	// an index already at the height is not backfilled again
+	require.NoError(t, ix.Backfill(context.Background(), dbadapter.Store{DB: dbm.NewMemDB()}, 100))
+	require.Len(t, query(t, ix, `SELECT id FROM matches`), 2)
+}
+
+func TestIndexerRoutes(t *testing.T) {
+	ix := openIndexer(t)
+	commit(t, ix, 3, teamPair(t, datasource.Team{ID: 1, Name: "Home"}))
+
+	router := mux.NewRouter()
+	indexer.RegisterRoutes(router, ix)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	decode := func(resp *http.Response, err error) (int, map[string]any) {
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		return resp.StatusCode, body
+	}
+
+	code, body := decode(http.Get(server.URL + indexer.QueryRoute + "?sql=" + url.QueryEscape("SELECT id, name FROM teams")))
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []any{"id", "name"}, body["columns"])
+	require.Equal(t, []any{[]any{float64(1), "Home"}}, body["rows"])
+
+	code, body = decode(http.Post(server.URL+indexer.QueryRoute, "text/plain", strings.NewReader("UPDATE teams SET name = 'x'")))
+	require.Equal(t, http.StatusBadRequest, code)
+	require.NotEmpty(t, body["error"])
+
+	code, body = decode(http.Get(server.URL + indexer.StatusRoute))
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, float64(3), body["height"])
+}
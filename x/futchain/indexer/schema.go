@@ -0,0 +1,98 @@
+package indexer
+
+// schema creates the tables of the index. The statements are portable between SQLite and Postgres.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS indexer_state (
+		id INTEGER PRIMARY KEY,
+		height BIGINT NOT NULL
+	)`,
+
+	`CREATE TABLE IF NOT EXISTS leagues (
+		id BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		group_name TEXT NOT NULL,
+		is_group BOOLEAN NOT NULL,
+		ccode TEXT NOT NULL,
+		primary_id BIGINT NOT NULL,
+		height BIGINT NOT NULL
+	)`,
+
+	`CREATE TABLE IF NOT EXISTS teams (
+		id BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		long_name TEXT NOT NULL,
+		height BIGINT NOT NULL
+	)`,
+
+	// kickoff is in unix seconds; height is the height of the last change
+	`CREATE TABLE IF NOT EXISTS matches (
+		id BIGINT PRIMARY KEY,
+		league_id BIGINT NOT NULL,
+		home_id BIGINT NOT NULL,
+		away_id BIGINT NOT NULL,
+		home_score BIGINT NOT NULL,
+		away_score BIGINT NOT NULL,
+		kickoff BIGINT NOT NULL,
+		status_id BIGINT NOT NULL,
+		tournament_stage TEXT NOT NULL,
+		started BOOLEAN NOT NULL,
+		ongoing BOOLEAN NOT NULL,
+		finished BOOLEAN NOT NULL,
+		cancelled BOOLEAN NOT NULL,
+		live_time TEXT NOT NULL,
+		finalized BOOLEAN NOT NULL DEFAULT FALSE,
+		finalized_height BIGINT NOT NULL DEFAULT 0,
+		height BIGINT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS matches_league ON matches (league_id, kickoff)`,
+	`CREATE INDEX IF NOT EXISTS matches_home ON matches (home_id, kickoff)`,
+	`CREATE INDEX IF NOT EXISTS matches_away ON matches (away_id, kickoff)`,
+
+	// match_timeline keeps the state of a match at each height it changed at
+	`CREATE TABLE IF NOT EXISTS match_timeline (
+		match_id BIGINT NOT NULL,
+		height BIGINT NOT NULL,
+		home_score BIGINT NOT NULL,
+		away_score BIGINT NOT NULL,
+		started BOOLEAN NOT NULL,
+		ongoing BOOLEAN NOT NULL,
+		finished BOOLEAN NOT NULL,
+		cancelled BOOLEAN NOT NULL,
+		live_time TEXT NOT NULL,
+		PRIMARY KEY (match_id, height)
+	)`,
+}
+
+const (
+	upsertHeight = `INSERT INTO indexer_state (id, height) VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET height = excluded.height`
+
+	upsertLeague = `INSERT INTO leagues (id, name, group_name, is_group, ccode, primary_id, height) VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET name = excluded.name, group_name = excluded.group_name, is_group = excluded.is_group,
+			ccode = excluded.ccode, primary_id = excluded.primary_id, height = excluded.height`
+
+	upsertTeam = `INSERT INTO teams (id, name, long_name, height) VALUES (?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET name = excluded.name, long_name = excluded.long_name, height = excluded.height`
+
+	upsertMatch = `INSERT INTO matches (id, league_id, home_id, away_id, home_score, away_score, kickoff, status_id, tournament_stage,
+			started, ongoing, finished, cancelled, live_time, height) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET league_id = excluded.league_id, home_id = excluded.home_id, away_id = excluded.away_id,
+			home_score = excluded.home_score, away_score = excluded.away_score, kickoff = excluded.kickoff,
+			status_id = excluded.status_id, tournament_stage = excluded.tournament_stage, started = excluded.started,
+			ongoing = excluded.ongoing, finished = excluded.finished, cancelled = excluded.cancelled,
+			live_time = excluded.live_time, height = excluded.height`
+
+	upsertTimeline = `INSERT INTO match_timeline (match_id, height, home_score, away_score, started, ongoing, finished, cancelled, live_time)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (match_id, height) DO UPDATE SET home_score = excluded.home_score, away_score = excluded.away_score,
+			started = excluded.started, ongoing = excluded.ongoing, finished = excluded.finished,
+			cancelled = excluded.cancelled, live_time = excluded.live_time`
+
+	updateFinalized = `UPDATE matches SET finalized = ?, finalized_height = ? WHERE id = ?`
+
+	deleteLeague = `DELETE FROM leagues WHERE id = ?`
+	deleteTeam   = `DELETE FROM teams WHERE id = ?`
+	deleteMatch  = `DELETE FROM matches WHERE id = ?`
+
+	selectHeight = `SELECT height FROM indexer_state WHERE id = 1`
+)
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// FilterAttestedMatches verifies the provider attestations of the fetched matches. Matches whose attestation
+// does not verify are dropped. Attestations of unregistered providers are discarded, and the matches left
+// unattested are dropped if the params require provider signatures.
+func (k *Keeper) FilterAttestedMatches(ctx sdk.Context, leagues []datasource.League) ([]datasource.League, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pubKeys, err := types.ProviderPubKeys(params.DataProviders)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]datasource.League, 0, len(leagues))
+	for _, league := range leagues {
+		matches := make([]datasource.Match, 0, len(league.Matches))
+		for _, m := range league.Matches {
+			if m.Attestation != nil {
+				err := m.VerifyAttestation(pubKeys)
+				switch {
+				case errors.Is(err, datasource.ErrUnknownProvider):
+					ctx.Logger().Debug("discarding attestation of an unknown provider", "match", m.ID, "provider", m.Attestation.Provider)
+					m.Attestation = nil
+				case err != nil:
+					ctx.Logger().Error("dropping match with an invalid attestation", "error", err, "match", m.ID)
+					continue
+				}
+			}
+			if m.Attestation == nil && params.RequireProviderSignatures {
+				ctx.Logger().Debug("dropping unattested match", "match", m.ID)
+				continue
+			}
+			matches = append(matches, m)
+		}
+		league.Matches = matches
+		filtered = append(filtered, league)
+	}
+
+	return filtered, nil
+}
+
+// SetMatchAttestation stores the provider attestation of a match, or removes the stored one if the match is
+// not attested, as it does not attest the current data anymore.
+func (k *Keeper) SetMatchAttestation(ctx context.Context, match datasource.Match) error {
+	matchID := int64(match.ID)
+	if match.Attestation == nil {
+		return k.MatchAttestations.Remove(ctx, matchID)
+	}
+
+	return k.MatchAttestations.Set(ctx, matchID, types.MatchAttestation{
+		MatchId:   matchID,
+		Provider:  match.Attestation.Provider,
+		Payload:   match.Attestation.Payload,
+		Signature: match.Attestation.Signature,
+		Height:    sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	})
+}
+
+// GetMatchAttestation returns the provider attestation of a match and the registered provider that signed
+// it. The provider is empty if it is not registered anymore.
+func (k *Keeper) GetMatchAttestation(ctx context.Context, matchID int64) (types.MatchAttestation, types.DataProvider, error) {
+	attestation, err := k.MatchAttestations.Get(ctx, matchID)
+	if err != nil {
+		return types.MatchAttestation{}, types.DataProvider{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.MatchAttestation{}, types.DataProvider{}, err
+	}
+	for _, provider := range params.DataProviders {
+		if provider.Name == attestation.Provider {
+			return attestation, provider, nil
+		}
+	}
+	return attestation, types.DataProvider{}, nil
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// attest signs the JSON encoding of the match with the key of a provider.
+func attest(t *testing.T, provider string, key cryptotypes.PrivKey, m datasource.Match) *datasource.Attestation {
+	t.Helper()
+
+	payload, err := json.Marshal(m)
+	require.NoError(t, err)
+	signature, err := key.Sign(payload)
+	require.NoError(t, err)
+	return &datasource.Attestation{Provider: provider, Payload: payload, Signature: signature}
+}
+
+func TestMatchAttestations(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(7)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	edKey, secpKey := ed25519.GenPrivKey(), secp256k1.GenPrivKey()
+	params := types.DefaultParams()
+	params.DataProviders = []types.DataProvider{
+		{Name: "ed", KeyType: types.PROVIDER_KEY_TYPE_ED25519, PubKey: edKey.PubKey().Bytes()},
+		{Name: "secp", KeyType: types.PROVIDER_KEY_TYPE_SECP256K1, PubKey: secpKey.PubKey().Bytes()},
+	}
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	newMatch := func(id int) datasource.Match {
+		return datasource.Match{ID: id, LeagueID: 1, Home: datasource.Team{ID: 1, Score: 2}, Away: datasource.Team{ID: 2}}
+	}
+
+	edAttested := newMatch(1)
+	edAttested.Attestation = attest(t, "ed", edKey, edAttested)
+	secpAttested := newMatch(2)
+	secpAttested.Attestation = attest(t, "secp", secpKey, secpAttested)
+	forged := newMatch(3)
+	forged.Attestation = attest(t, "ed", secpKey, forged)
+	mismatched := newMatch(4)
+	mismatched.Attestation = attest(t, "ed", edKey, mismatched)
+	mismatched.Home.Score = 3
+	unknown := newMatch(5)
+	unknown.Attestation = attest(t, "other", edKey, unknown)
+	unattested := newMatch(6)
+
+	leagues := []datasource.League{{ID: 1, Matches: []datasource.Match{edAttested, secpAttested, forged, mismatched, unknown, unattested}}}
+	ids := func(leagues []datasource.League) []int {
+		var ids []int
+		for _, m := range leagues[0].Matches {
+			ids = append(ids, m.ID)
+		}
+		return ids
+	}
+
+	filtered, err := f.keeper.FilterAttestedMatches(ctx, leagues)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 5, 6}, ids(filtered))
+	require.Nil(t, filtered[0].Matches[2].Attestation)
+
+	params.RequireProviderSignatures = true
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	filtered, err = f.keeper.FilterAttestedMatches(ctx, leagues)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, ids(filtered))
+
+	// the attestation is stored with the match and served with the key of its provider
+	stored := setupMarketMatch(t, f, 1)
+	stored.Attestation = edAttested.Attestation
+	require.NoError(t, f.keeper.SetMatchAttestation(ctx, stored))
+
+	res, err := qs.MatchAttestation(ctx, &types.QueryMatchAttestationRequest{MatchId: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.MatchAttestation{MatchId: 1, Provider: "ed", Payload: edAttested.Attestation.Payload, Signature: edAttested.Attestation.Signature, Height: 7}, res.Attestation)
+	require.Equal(t, params.DataProviders[0], res.Provider)
+
+	// corrected data is not attested anymore
+	require.NoError(t, f.keeper.OverrideMatch(ctx, 1, 0, 0, true, true, false))
+	_, err = qs.MatchAttestation(ctx, &types.QueryMatchAttestationRequest{MatchId: 1})
+	require.Error(t, err)
+
+	params.DataProviders = append(params.DataProviders, types.DataProvider{Name: "ed", KeyType: types.PROVIDER_KEY_TYPE_ED25519, PubKey: edKey.PubKey().Bytes()})
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,298 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// subscriberABI mirrors the IFutchainSubscriber interface of the base contract.
+var subscriberABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(`[{"inputs":[{"internalType":"uint256","name":"matchId","type":"uint256"},{"internalType":"uint256","name":"homeScore","type":"uint256"},{"internalType":"uint256","name":"awayScore","type":"uint256"},{"internalType":"bool","name":"cancelled","type":"bool"}],"name":"onMatchResult","outputs":[],"stateMutability":"nonpayable","type":"function"}]`))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// Subscribe registers the subscriber contract to be called back once the match result is finalized.
+// The payment is moved from the payer into the module account: the callback fee is held until the
+// delivery, and the rest is refunded to the subscriber together with the fee of a replaced subscription.
+func (k *Keeper) Subscribe(ctx context.Context, matchID int, subscriber common.Address, gasLimit uint64, payer sdk.AccAddress, payment math.Int) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if gasLimit == 0 || gasLimit > params.MaxCallbackGasLimit {
+		return errorsmod.Wrapf(types.ErrInvalidSubscription, "callback gas limit must be between 1 and %d", params.MaxCallbackGasLimit)
+	}
+
+	match, err := k.GetMatch(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if match.Status.Finished || match.Status.Cancelled {
+		return errorsmod.Wrapf(types.ErrInvalidSubscription, "match %d is already over", matchID)
+	}
+
+	fee, err := k.CallbackFee(ctx, gasLimit)
+	if err != nil {
+		return err
+	}
+	feeAmount := math.NewIntFromBigInt(fee)
+	if payment.LT(feeAmount) {
+		return errorsmod.Wrapf(types.ErrInvalidSubscription, "insufficient callback fee: expected %s, got %s", feeAmount, payment)
+	}
+
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if payment.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, payment))); err != nil {
+			return err
+		}
+	}
+
+	key := collections.Join(int64(matchID), subscriber.Bytes())
+	refund := payment.Sub(feeAmount)
+	replaced, err := k.SubscriptionFees.Get(ctx, key)
+	if err == nil {
+		refund = refund.Add(replaced)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if refund.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, subscriber.Bytes(), sdk.NewCoins(sdk.NewCoin(denom, refund))); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SubscriptionFees.Set(ctx, key, feeAmount); err != nil {
+		return err
+	}
+	return k.Subscriptions.Set(ctx, key, gasLimit)
+}
+
+// Unsubscribe removes the subscription of the subscriber to the match and refunds its callback fee, so the
+// fees of the subscriptions to the matches that never finish are not locked in the module account.
+func (k *Keeper) Unsubscribe(ctx context.Context, matchID int, subscriber common.Address) error {
+	key := collections.Join(int64(matchID), subscriber.Bytes())
+	if ok, err := k.Subscriptions.Has(ctx, key); err != nil {
+		return err
+	} else if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidSubscription, "%s is not subscribed to match %d", subscriber.Hex(), matchID)
+	}
+	if err := k.Subscriptions.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	fee, err := k.SubscriptionFees.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := k.SubscriptionFees.Remove(ctx, key); err != nil {
+		return err
+	}
+	if !fee.IsPositive() {
+		return nil
+	}
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, subscriber.Bytes(), sdk.NewCoins(sdk.NewCoin(denom, fee)))
+}
+
+// CallbackFee returns the fee a subscriber prepays for the given callback gas limit.
+func (k *Keeper) CallbackFee(ctx context.Context, gasLimit uint64) (*big.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), new(big.Int).SetUint64(params.CallbackGasPrice)), nil
+}
+
+// EnqueueMatchCallbacks marks the match as pending delivery if it has any subscribers.
+func (k *Keeper) EnqueueMatchCallbacks(ctx context.Context, matchID int) error {
+	iterator, err := k.Subscriptions.Iterate(ctx, collections.NewPrefixedPairRange[int64, []byte](int64(matchID)))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil
+	}
+	return k.PendingCallbacks.Set(ctx, int64(matchID))
+}
+
+// maxCallbackGasPerBlock bounds the sum of the gas limits of the callbacks delivered in a block.
+const maxCallbackGasPerBlock uint64 = 4 * types.MaxCallbackGasLimitBound
+
+// DeliverMatchCallbacks calls onMatchResult on the subscribers of the pending matches, as long as the sum of
+// their gas limits fits in maxCallbackGasPerBlock; the rest are delivered in the next blocks.
+// Each callback runs in its own cached context bounded by its gas limit; failed callbacks are
+// reported with an event and never abort the block. Subscriptions are removed once delivered, and
+// a match stops being pending once all of its subscribers are called back.
+func (k *Keeper) DeliverMatchCallbacks(ctx sdk.Context) error {
+	iterator, err := k.PendingCallbacks.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	matchIDs, err := iterator.Keys()
+	if err != nil {
+		return err
+	}
+
+	gasBudget := maxCallbackGasPerBlock
+	for _, matchID := range matchIDs {
+		iterator, err := k.Subscriptions.Iterate(ctx, collections.NewPrefixedPairRange[int64, []byte](matchID))
+		if err != nil {
+			return err
+		}
+		subscriptions, err := iterator.KeyValues()
+		if err != nil {
+			return err
+		}
+
+		match, err := k.GetMatch(ctx, int(matchID))
+		if err != nil {
+			ctx.Logger().Error("failed to get match for callbacks", "error", err, "match", matchID)
+			if err := k.PendingCallbacks.Remove(ctx, matchID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		delivered := 0
+		for _, subscription := range subscriptions {
+			if subscription.Value > gasBudget {
+				break
+			}
+			gasBudget -= subscription.Value
+			delivered++
+
+			if err := k.Subscriptions.Remove(ctx, subscription.Key); err != nil {
+				return err
+			}
+			subscriber := common.BytesToAddress(subscription.Key.K2())
+			gasUsed := k.deliverCallback(ctx, match, subscriber, subscription.Value)
+			if err := k.settleCallbackFee(ctx, subscription.Key, subscriber, subscription.Value, gasUsed); err != nil {
+				return err
+			}
+		}
+		if delivered < len(subscriptions) {
+			// the rest are carried over to the next block
+			return nil
+		}
+		if err := k.PendingCallbacks.Remove(ctx, matchID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// settleCallbackFee charges the prepaid fee of a delivered callback for the gas it used, paying it to the
+// fee collector, and refunds the rest to the subscriber.
+func (k *Keeper) settleCallbackFee(ctx sdk.Context, key collections.Pair[int64, []byte], subscriber common.Address, gasLimit, gasUsed uint64) error {
+	prepaid, err := k.SubscriptionFees.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := k.SubscriptionFees.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	charged := prepaid.Mul(math.NewIntFromUint64(min(gasUsed, gasLimit))).Quo(math.NewIntFromUint64(gasLimit))
+	if charged.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(denom, charged))); err != nil {
+			return err
+		}
+	}
+	if refund := prepaid.Sub(charged); refund.IsPositive() {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, subscriber.Bytes(), sdk.NewCoins(sdk.NewCoin(denom, refund)))
+	}
+	return nil
+}
+
+// deliverCallback calls onMatchResult on a subscriber and returns the gas the callback used.
+func (k *Keeper) deliverCallback(ctx sdk.Context, match *datasource.Match, subscriber common.Address, gasLimit uint64) (gasUsed uint64) {
+	var err error
+
+	defer func() {
+		event := &types.EventMatchCallback{
+			MatchId:    int64(match.ID),
+			Subscriber: subscriber.Hex(),
+			GasUsed:    gasUsed,
+			Success:    err == nil,
+		}
+		if err != nil {
+			ctx.Logger().Info("match callback failed", "match", match.ID, "subscriber", subscriber.Hex(), "error", err)
+			event.Error = err.Error()
+		}
+		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+			ctx.Logger().Error("failed to emit event", "error", err, "event", "match_callback", "match", match.ID)
+		}
+	}()
+
+	if k.evmKeeper == nil {
+		err = errors.New("evm keeper is not set")
+		return
+	}
+
+	data, err := subscriberABI.Pack("onMatchResult", big.NewInt(int64(match.ID)), big.NewInt(int64(match.Home.Score)), big.NewInt(int64(match.Away.Score)), match.Status.Cancelled)
+	if err != nil {
+		return
+	}
+
+	msg := core.Message{
+		From:       common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName)),
+		To:         &subscriber,
+		Value:      big.NewInt(0),
+		GasLimit:   gasLimit,
+		GasPrice:   big.NewInt(0),
+		GasTipCap:  big.NewInt(0),
+		GasFeeCap:  big.NewInt(0),
+		Data:       data,
+		AccessList: ethtypes.AccessList{},
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	res, err := k.evmKeeper.ApplyMessage(cacheCtx, msg, nil, true, true)
+	if err != nil {
+		return
+	}
+	gasUsed = res.GasUsed
+	if res.Failed() {
+		err = errors.New(res.VmError)
+		return
+	}
+	write()
+	return
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestMatchCallbacks(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+
+	home := datasource.Team{ID: 1, Name: "Home"}
+	away := datasource.Team{ID: 2, Name: "Away"}
+	for _, team := range []datasource.Team{home, away} {
+		_, err := f.keeper.SaveTeamIfNotExists(ctx, team)
+		require.NoError(t, err)
+	}
+	match := datasource.Match{ID: 10, LeagueID: 1, Home: home, Away: away}
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+
+	subscriber := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	payer := sdk.AccAddress("payer")
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	f.bankKeeper.balances[string(payer)] = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewIntFromUint64(1_000_000*types.DefaultCallbackGasPrice)))
+	balance := func(addr []byte) uint64 {
+		return f.bankKeeper.balances[string(addr)].AmountOf(sdk.DefaultBondDenom).Uint64()
+	}
+
+	fee, err := f.keeper.CallbackFee(ctx, 100_000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100_000*types.DefaultCallbackGasPrice), fee.Uint64())
+
+	require.ErrorIs(t, f.keeper.Subscribe(ctx, match.ID, subscriber, 0, payer, math.ZeroInt()), types.ErrInvalidSubscription)
+	require.ErrorIs(t, f.keeper.Subscribe(ctx, match.ID, subscriber, types.DefaultMaxCallbackGasLimit+1, payer, math.ZeroInt()), types.ErrInvalidSubscription)
+	require.ErrorIs(t, f.keeper.Subscribe(ctx, match.ID, subscriber, 100_000, payer, math.NewIntFromBigInt(fee).SubRaw(1)), types.ErrInvalidSubscription)
+
+	// the fee is held by the module, the excess is refunded to the subscriber
+	require.NoError(t, f.keeper.Subscribe(ctx, match.ID, subscriber, 200_000, payer, math.NewIntFromUint64(300_000*types.DefaultCallbackGasPrice)))
+	require.Equal(t, 200_000*types.DefaultCallbackGasPrice, balance(moduleAddr))
+	require.Equal(t, 100_000*types.DefaultCallbackGasPrice, balance(subscriber.Bytes()))
+
+	// subscribing again refunds the replaced fee
+	require.NoError(t, f.keeper.Subscribe(ctx, match.ID, subscriber, 100_000, payer, math.NewIntFromBigInt(fee)))
+	require.Equal(t, 100_000*types.DefaultCallbackGasPrice, balance(moduleAddr))
+	require.Equal(t, 300_000*types.DefaultCallbackGasPrice, balance(subscriber.Bytes()))
+	require.Equal(t, 600_000*types.DefaultCallbackGasPrice, balance(payer))
+
+	// no subscribers, nothing is enqueued
+	require.NoError(t, f.keeper.EnqueueMatchCallbacks(ctx, 11))
+	has, err := f.keeper.PendingCallbacks.Has(ctx, 11)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, f.keeper.EnqueueMatchCallbacks(ctx, match.ID))
+	has, err = f.keeper.PendingCallbacks.Has(ctx, int64(match.ID))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the fixture has no evm keeper: the callback fails without using gas, so its fee is refunded
+	require.NoError(t, f.keeper.DeliverMatchCallbacks(ctx))
+	require.Zero(t, balance(moduleAddr))
+	require.Equal(t, 400_000*types.DefaultCallbackGasPrice, balance(subscriber.Bytes()))
+
+	has, err = f.keeper.PendingCallbacks.Has(ctx, int64(match.ID))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.Subscriptions.Has(ctx, collections.Join(int64(match.ID), subscriber.Bytes()))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.SubscriptionFees.Has(ctx, collections.Join(int64(match.ID), subscriber.Bytes()))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMatchCallback{
+		MatchId:    int64(match.ID),
+		Subscriber: subscriber.Hex(),
+		Error:      "evm keeper is not set",
+	}, msg)
+
+	// finished matches can not be subscribed
+	match.Status.Finished = true
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+	require.ErrorIs(t, f.keeper.Subscribe(ctx, match.ID, subscriber, 100_000, payer, math.NewIntFromBigInt(fee)), types.ErrInvalidSubscription)
+
+	// the fee of a match that never finishes is refunded on unsubscribe
+	require.NoError(t, f.keeper.SetMatch(ctx, datasource.Match{ID: 12, LeagueID: 1, Home: home, Away: away}))
+	require.NoError(t, f.keeper.Subscribe(ctx, 12, subscriber, 100_000, payer, math.NewIntFromBigInt(fee)))
+	require.Equal(t, 100_000*types.DefaultCallbackGasPrice, balance(moduleAddr))
+	require.NoError(t, f.keeper.Unsubscribe(ctx, 12, subscriber))
+	require.Zero(t, balance(moduleAddr))
+	require.Equal(t, 500_000*types.DefaultCallbackGasPrice, balance(subscriber.Bytes()))
+	has, err = f.keeper.Subscriptions.Has(ctx, collections.Join(int64(12), subscriber.Bytes()))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.ErrorIs(t, f.keeper.Unsubscribe(ctx, 12, subscriber), types.ErrInvalidSubscription)
+}
+
+func TestMatchCallbacksCarryOver(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MaxCallbackGasLimit, params.CallbackGasPrice = types.MaxCallbackGasLimitBound, 1
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	match := setupMarketMatch(t, f, 10)
+	payer := sdk.AccAddress("payer")
+	f.bankKeeper.balances[string(payer)] = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewIntFromUint64(10*types.MaxCallbackGasLimitBound)))
+	for i := byte(1); i <= 5; i++ {
+		subscriber := common.BytesToAddress([]byte{i})
+		require.NoError(t, f.keeper.Subscribe(ctx, match.ID, subscriber, types.MaxCallbackGasLimitBound, payer, math.NewIntFromUint64(types.MaxCallbackGasLimitBound)))
+	}
+	require.NoError(t, f.keeper.EnqueueMatchCallbacks(ctx, match.ID))
+
+	subscriptions := func() int {
+		iterator, err := f.keeper.Subscriptions.Iterate(ctx, nil)
+		require.NoError(t, err)
+		keys, err := iterator.Keys()
+		require.NoError(t, err)
+		return len(keys)
+	}
+
+	// the callbacks over the gas of the block are carried over to the next one
+	require.NoError(t, f.keeper.DeliverMatchCallbacks(ctx))
+	require.Equal(t, 1, subscriptions())
+	has, err := f.keeper.PendingCallbacks.Has(ctx, int64(match.ID))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	require.NoError(t, f.keeper.DeliverMatchCallbacks(ctx))
+	require.Zero(t, subscriptions())
+	has, err = f.keeper.PendingCallbacks.Has(ctx, int64(match.ID))
+	require.NoError(t, err)
+	require.False(t, has)
+}
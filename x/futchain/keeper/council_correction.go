@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// IsDataCouncilMember reports whether the address is one of the data-council members of the params.
+func (k *Keeper) IsDataCouncilMember(ctx context.Context, addr []byte) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range params.DataCouncilMembers {
+		memberAddr, err := k.addressCodec.StringToBytes(member)
+		if err != nil {
+			return false, err
+		}
+		if bytes.Equal(memberAddr, addr) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ProposeCorrection stores a data correction proposed by a data-council member and counts it as the
+// proposer's approval. The message must be a data correction signed by the module authority; it is
+// executed once the correction reaches the threshold.
+func (k *Keeper) ProposeCorrection(ctx sdk.Context, proposer sdk.AccAddress, msg *codectypes.Any, reason string) (uint64, error) {
+	if ok, err := k.IsDataCouncilMember(ctx, proposer); err != nil {
+		return 0, err
+	} else if !ok {
+		return 0, errorsmod.Wrap(types.ErrInvalidSigner, "proposer is not a data council member")
+	}
+
+	if msg == nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidCorrection, "correction message is required")
+	}
+	var sdkMsg sdk.Msg
+	if err := k.cdc.UnpackAny(msg, &sdkMsg); err != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidCorrection, "invalid correction message: %s", err)
+	}
+	authority, err := types.CorrectionAuthority(sdkMsg)
+	if err != nil {
+		return 0, err
+	}
+	expectedAuthority, err := k.addressCodec.BytesToString(k.GetAuthority())
+	if err != nil {
+		return 0, err
+	}
+	if authority != expectedAuthority {
+		return 0, errorsmod.Wrapf(types.ErrInvalidCorrection, "correction authority must be %s, got %s", expectedAuthority, authority)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	proposerStr, err := k.addressCodec.BytesToString(proposer)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := k.CorrectionSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	correction := types.Correction{
+		Id:           id,
+		Proposer:     proposerStr,
+		Msg:          msg,
+		Approvals:    []string{proposerStr},
+		ExpiryHeight: ctx.BlockHeight() + int64(params.CorrectionVotingBlocks),
+		Status:       types.CORRECTION_STATUS_PENDING,
+		Reason:       reason,
+	}
+	if err := k.PendingCorrections.Set(ctx, collections.Join(correction.ExpiryHeight, id)); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("correction_proposed",
+		sdk.NewAttribute("id", strconv.FormatUint(id, 10)),
+		sdk.NewAttribute("proposer", proposerStr),
+		sdk.NewAttribute("msg_type", msg.TypeUrl),
+		sdk.NewAttribute("reason", reason),
+	))
+
+	return id, k.tallyCorrection(ctx, correction)
+}
+
+// VoteCorrection adds the approval of a data-council member to a pending correction, and applies the
+// correction if it reaches the threshold.
+func (k *Keeper) VoteCorrection(ctx sdk.Context, voter sdk.AccAddress, id uint64) (types.CorrectionStatus, error) {
+	if ok, err := k.IsDataCouncilMember(ctx, voter); err != nil {
+		return types.CORRECTION_STATUS_UNSPECIFIED, err
+	} else if !ok {
+		return types.CORRECTION_STATUS_UNSPECIFIED, errorsmod.Wrap(types.ErrInvalidSigner, "voter is not a data council member")
+	}
+
+	correction, err := k.Corrections.Get(ctx, id)
+	if err != nil {
+		return types.CORRECTION_STATUS_UNSPECIFIED, errorsmod.Wrapf(types.ErrInvalidCorrection, "correction %d: %s", id, err)
+	}
+	if correction.Status != types.CORRECTION_STATUS_PENDING || correction.ExpiryHeight <= ctx.BlockHeight() {
+		return types.CORRECTION_STATUS_UNSPECIFIED, errorsmod.Wrapf(types.ErrInvalidCorrection, "correction %d is not pending", id)
+	}
+
+	voterStr, err := k.addressCodec.BytesToString(voter)
+	if err != nil {
+		return types.CORRECTION_STATUS_UNSPECIFIED, err
+	}
+	for _, approval := range correction.Approvals {
+		if approval == voterStr {
+			return types.CORRECTION_STATUS_UNSPECIFIED, errorsmod.Wrapf(types.ErrInvalidCorrection, "%s already approved correction %d", voterStr, id)
+		}
+	}
+	correction.Approvals = append(correction.Approvals, voterStr)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("correction_voted",
+		sdk.NewAttribute("id", strconv.FormatUint(id, 10)),
+		sdk.NewAttribute("voter", voterStr),
+	))
+
+	if err := k.tallyCorrection(ctx, correction); err != nil {
+		return types.CORRECTION_STATUS_UNSPECIFIED, err
+	}
+	correction, err = k.Corrections.Get(ctx, id)
+	if err != nil {
+		return types.CORRECTION_STATUS_UNSPECIFIED, err
+	}
+	return correction.Status, nil
+}
+
+// ExpireCorrections expires the pending corrections that did not reach the threshold before their expiry height.
+func (k *Keeper) ExpireCorrections(ctx sdk.Context) error {
+	iterator, err := k.PendingCorrections.Iterate(ctx, new(collections.Range[collections.Pair[int64, uint64]]).
+		EndInclusive(collections.Join(ctx.BlockHeight(), uint64(math.MaxUint64))))
+	if err != nil {
+		return err
+	}
+	keys, err := iterator.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.PendingCorrections.Remove(ctx, key); err != nil {
+			return err
+		}
+		correction, err := k.Corrections.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		correction.Status = types.CORRECTION_STATUS_EXPIRED
+		if err := k.Corrections.Set(ctx, correction.Id, correction); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent("correction_expired",
+			sdk.NewAttribute("id", strconv.FormatUint(correction.Id, 10)),
+		))
+	}
+
+	return nil
+}
+
+// tallyCorrection saves the correction, and executes its message once the approvals of the current
+// data-council members reach the threshold. A failing message marks the correction as failed.
+func (k *Keeper) tallyCorrection(ctx sdk.Context, correction types.Correction) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var approvals uint32
+	for _, approval := range correction.Approvals {
+		addr, err := k.addressCodec.StringToBytes(approval)
+		if err != nil {
+			return err
+		}
+		if ok, err := k.IsDataCouncilMember(ctx, addr); err != nil {
+			return err
+		} else if ok {
+			approvals++
+		}
+	}
+
+	if params.CorrectionThreshold == 0 || approvals < params.CorrectionThreshold {
+		return k.Corrections.Set(ctx, correction.Id, correction)
+	}
+
+	if err := k.PendingCorrections.Remove(ctx, collections.Join(correction.ExpiryHeight, correction.Id)); err != nil {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.executeCorrection(cacheCtx, correction); err != nil {
+		correction.Status = types.CORRECTION_STATUS_FAILED
+		correction.Error = err.Error()
+		ctx.Logger().Error("failed to apply correction", "error", err, "correction", correction.Id)
+		ctx.EventManager().EmitEvent(sdk.NewEvent("correction_failed",
+			sdk.NewAttribute("id", strconv.FormatUint(correction.Id, 10)),
+			sdk.NewAttribute("error", err.Error()),
+		))
+	} else {
+		write()
+		correction.Status = types.CORRECTION_STATUS_APPLIED
+		ctx.EventManager().EmitEvent(sdk.NewEvent("correction_applied",
+			sdk.NewAttribute("id", strconv.FormatUint(correction.Id, 10)),
+			sdk.NewAttribute("msg_type", correction.Msg.TypeUrl),
+		))
+	}
+
+	return k.Corrections.Set(ctx, correction.Id, correction)
+}
+
+// executeCorrection runs the message of the correction through the msg server, on behalf of the module authority.
+func (k *Keeper) executeCorrection(ctx sdk.Context, correction types.Correction) error {
+	var msg sdk.Msg
+	if err := k.cdc.UnpackAny(correction.Msg, &msg); err != nil {
+		return err
+	}
+
+	ms := NewMsgServerImpl(*k)
+	var err error
+	switch msg := msg.(type) {
+	case *types.MsgOverrideMatch:
+		_, err = ms.OverrideMatch(ctx, msg)
+	case *types.MsgVoidMatch:
+		_, err = ms.VoidMatch(ctx, msg)
+	case *types.MsgUpsertTeam:
+		_, err = ms.UpsertTeam(ctx, msg)
+	case *types.MsgUpsertLeague:
+		_, err = ms.UpsertLeague(ctx, msg)
+	default:
+		_, err = types.CorrectionAuthority(msg)
+	}
+	return err
+}
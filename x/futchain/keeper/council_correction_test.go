@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestCorrectionVoting(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	members := make([]string, 3)
+	for i, name := range []string{"alice", "bob", "carol"} {
+		members[i], err = f.addressCodec.BytesToString(sdk.AccAddress(name))
+		require.NoError(t, err)
+	}
+	outsider, err := f.addressCodec.BytesToString(sdk.AccAddress("mallory"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.DataCouncilMembers = members
+	params.CorrectionThreshold = 4
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: params})
+	require.Error(t, err)
+	params.CorrectionThreshold = 2
+	params.CorrectionVotingBlocks = 10
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: params})
+	require.NoError(t, err)
+
+	match := setupMarketMatch(t, f, 50)
+	override, err := codectypes.NewAnyWithValue(&types.MsgOverrideMatch{Authority: authorityStr, MatchId: int64(match.ID), HomeScore: 3, Started: true, Finished: true})
+	require.NoError(t, err)
+
+	_, err = ms.ProposeCorrection(ctx, &types.MsgProposeCorrection{Proposer: outsider, Msg: override})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// the message must be a data correction on behalf of the module authority
+	notCorrection, err := codectypes.NewAnyWithValue(&types.MsgVoteCorrection{Voter: members[0], CorrectionId: 1})
+	require.NoError(t, err)
+	_, err = ms.ProposeCorrection(ctx, &types.MsgProposeCorrection{Proposer: members[0], Msg: notCorrection})
+	require.ErrorIs(t, err, types.ErrInvalidCorrection)
+	wrongAuthority, err := codectypes.NewAnyWithValue(&types.MsgVoidMatch{Authority: members[0], MatchId: int64(match.ID)})
+	require.NoError(t, err)
+	_, err = ms.ProposeCorrection(ctx, &types.MsgProposeCorrection{Proposer: members[0], Msg: wrongAuthority})
+	require.ErrorIs(t, err, types.ErrInvalidCorrection)
+	// the id mappings are left to the authority
+	merge, err := codectypes.NewAnyWithValue(&types.MsgMergeEntities{Authority: authorityStr, EntityType: types.ENTITY_TYPE_MATCH, DuplicateId: int64(match.ID), CanonicalId: 1})
+	require.NoError(t, err)
+	_, err = ms.ProposeCorrection(ctx, &types.MsgProposeCorrection{Proposer: members[0], Msg: merge})
+	require.ErrorIs(t, err, types.ErrInvalidCorrection)
+
+	res, err := ms.ProposeCorrection(ctx, &types.MsgProposeCorrection{Proposer: members[0], Msg: override, Reason: "wrong score"})
+	require.NoError(t, err)
+	correctionID := res.CorrectionId
+
+	correction, err := f.keeper.Corrections.Get(ctx, correctionID)
+	require.NoError(t, err)
+	require.Equal(t, types.CORRECTION_STATUS_PENDING, correction.Status)
+	require.Equal(t, int64(110), correction.ExpiryHeight)
+
+	_, err = ms.VoteCorrection(ctx, &types.MsgVoteCorrection{Voter: members[0], CorrectionId: correctionID})
+	require.ErrorIs(t, err, types.ErrInvalidCorrection)
+	_, err = ms.VoteCorrection(ctx, &types.MsgVoteCorrection{Voter: outsider, CorrectionId: correctionID})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// the second approval reaches the threshold and applies the correction
+	voteRes, err := ms.VoteCorrection(ctx, &types.MsgVoteCorrection{Voter: members[1], CorrectionId: correctionID})
+	require.NoError(t, err)
+	require.Equal(t, types.CORRECTION_STATUS_APPLIED, voteRes.Status)
+
+	got, err := f.keeper.GetMatch(ctx, match.ID)
+	require.NoError(t, err)
+	require.Equal(t, 3, got.Home.Score)
+	finalized, err := f.keeper.IsMatchFinalized(ctx, int64(match.ID))
+	require.NoError(t, err)
+	require.True(t, finalized)
+
+	_, err = ms.VoteCorrection(ctx, &types.MsgVoteCorrection{Voter: members[2], CorrectionId: correctionID})
+	require.ErrorIs(t, err, types.ErrInvalidCorrection)
+
+	// a correction whose message fails is recorded as failed
+	invalid, err := codectypes.NewAnyWithValue(&types.MsgUpsertTeam{Authority: authorityStr, Id: 1})
+	require.NoError(t, err)
+	res, err = ms.ProposeCorrection(ctx, &types.MsgProposeCorrection{Proposer: members[0], Msg: invalid})
+	require.NoError(t, err)
+	voteRes, err = ms.VoteCorrection(ctx, &types.MsgVoteCorrection{Voter: members[2], CorrectionId: res.CorrectionId})
+	require.NoError(t, err)
+	require.Equal(t, types.CORRECTION_STATUS_FAILED, voteRes.Status)
+
+	// corrections expire without enough approvals
+	void, err := codectypes.NewAnyWithValue(&types.MsgVoidMatch{Authority: authorityStr, MatchId: int64(match.ID)})
+	require.NoError(t, err)
+	res, err = ms.ProposeCorrection(ctx, &types.MsgProposeCorrection{Proposer: members[2], Msg: void})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(109)
+	require.NoError(t, f.keeper.ExpireCorrections(ctx))
+	correction, err = f.keeper.Corrections.Get(ctx, res.CorrectionId)
+	require.NoError(t, err)
+	require.Equal(t, types.CORRECTION_STATUS_PENDING, correction.Status)
+
+	ctx = ctx.WithBlockHeight(110)
+	require.NoError(t, f.keeper.ExpireCorrections(ctx))
+	correction, err = f.keeper.Corrections.Get(ctx, res.CorrectionId)
+	require.NoError(t, err)
+	require.Equal(t, types.CORRECTION_STATUS_EXPIRED, correction.Status)
+
+	_, err = ms.VoteCorrection(ctx, &types.MsgVoteCorrection{Voter: members[0], CorrectionId: res.CorrectionId})
+	require.ErrorIs(t, err, types.ErrInvalidCorrection)
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	qres, err := qs.Corrections(ctx, &types.QueryCorrectionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, qres.Corrections, 3)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// OverrideMatch replaces the score and status of a match. A finished or cancelled result skips the
+// dispute window and is finalized right away; markets that were already settled are not reverted.
+// A finalized match can only be corrected to another finished or cancelled result, which revises it
+// without finalizing it again.
+func (k *Keeper) OverrideMatch(ctx sdk.Context, matchID int64, homeScore, awayScore int, started, finished, cancelled bool) error {
+	if homeScore < 0 || awayScore < 0 {
+		return errorsmod.Wrapf(types.ErrInvalidCorrection, "invalid score %d - %d", homeScore, awayScore)
+	}
+
+	match, err := k.GetMatch(ctx, int(matchID))
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidCorrection, "match %d: %s", matchID, err)
+	}
+
+	finalized, err := k.IsMatchFinalized(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	isOver := finished || cancelled
+	if finalized && !isOver {
+		return errorsmod.Wrapf(types.ErrInvalidCorrection, "match %d is finalized and can not be reopened", matchID)
+	}
+
+	match.Home.Score, match.Away.Score = homeScore, awayScore
+	match.Status.Started, match.Status.Finished, match.Status.Cancelled = started, finished, cancelled
+	match.Status.Ongoing = started && !isOver
+	match.Ongoing = match.Status.Ongoing
+	if err := k.SetMatch(ctx, *match); err != nil {
+		return err
+	}
+	// the provider attestation does not attest the corrected data
+	if err := k.SetMatchAttestation(ctx, *match); err != nil {
+		return err
+	}
+
+	if !isOver {
+		if err := k.ReopenMatch(ctx, matchID); err != nil {
+			return err
+		}
+		return k.SaveUnfinishedMatch(ctx, *match)
+	}
+
+	if err := k.DeleteUnfinishedMatch(ctx, match.ID); err != nil {
+		return err
+	}
+	if finalized {
+		return k.reviseFinalizedMatch(ctx, *match)
+	}
+	return k.finalizeMatch(ctx, matchID)
+}
+
+// VoidMatch cancels a match and finalizes it, which refunds its open markets.
+func (k *Keeper) VoidMatch(ctx sdk.Context, matchID int64) error {
+	match, err := k.GetMatch(ctx, int(matchID))
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidCorrection, "match %d: %s", matchID, err)
+	}
+	return k.OverrideMatch(ctx, matchID, match.Home.Score, match.Away.Score, match.Status.Started, false, true)
+}
+
+// UpsertTeam creates or replaces a team.
+func (k *Keeper) UpsertTeam(ctx context.Context, team datasource.Team) error {
+	if team.ID <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidCorrection, "invalid team id %d", team.ID)
+	}
+	if team.Name == "" {
+		return errorsmod.Wrap(types.ErrInvalidCorrection, "team name is required")
+	}
+	return k.SetTeam(ctx, team)
+}
+
+// UpsertLeague creates or replaces a league.
+func (k *Keeper) UpsertLeague(ctx context.Context, league datasource.League) error {
+	if league.ID <= 0 {
+		return errorsmod.Wrapf(types.ErrInvalidCorrection, "invalid league id %d", league.ID)
+	}
+	if league.Name == "" {
+		return errorsmod.Wrap(types.ErrInvalidCorrection, "league name is required")
+	}
+	return k.SetLeague(ctx, league)
+}
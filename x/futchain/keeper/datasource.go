@@ -3,8 +3,12 @@ package keeper
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
 	"github.com/raifpy/futchain/x/futchain/keeper/datasource/flatbuffers"
 )
@@ -27,7 +31,10 @@ func (k *Keeper) SaveTeamIfNotExists(ctx context.Context, team datasource.Team)
 		return false, err
 	}
 	//TODO: use proto marshaler
-	return true, k.storeService.OpenKVStore(ctx).Set(key, buf)
+	if err := k.storeService.OpenKVStore(ctx).Set(key, buf); err != nil {
+		return false, err
+	}
+	return true, indexNames(ctx, k.TeamNames, int64(team.ID), nil, teamNames(&team))
 }
 
 func (k *Keeper) SaveMatchIfNotExists(ctx context.Context, match datasource.Match) (bool, error) {
@@ -43,7 +50,10 @@ func (k *Keeper) SaveMatchIfNotExists(ctx context.Context, match datasource.Matc
 		return false, err
 	}
 	//TODO: use proto marshaler
-	return true, k.storeService.OpenKVStore(ctx).Set(key, buf)
+	if err := k.storeService.OpenKVStore(ctx).Set(key, buf); err != nil {
+		return false, err
+	}
+	return true, k.setMatchResult(ctx, match)
 }
 
 func (k *Keeper) SaveLeagueIfNotExists(ctx context.Context, league datasource.League) (bool, error) {
@@ -59,7 +69,10 @@ func (k *Keeper) SaveLeagueIfNotExists(ctx context.Context, league datasource.Le
 		return false, err
 	}
 	//TODO: use proto marshaler
-	return true, k.storeService.OpenKVStore(ctx).Set(key, buf)
+	if err := k.storeService.OpenKVStore(ctx).Set(key, buf); err != nil {
+		return false, err
+	}
+	return true, indexNames(ctx, k.LeagueNames, int64(league.ID), nil, leagueNames(&league))
 }
 
 func (k *Keeper) GetLeague(ctx context.Context, id int) (*datasource.League, error) {
@@ -68,15 +81,22 @@ func (k *Keeper) GetLeague(ctx context.Context, id int) (*datasource.League, err
 	if err != nil {
 		return nil, err
 	}
+	if buf == nil {
+		return nil, fmt.Errorf("league %d: %w", id, collections.ErrNotFound)
+	}
 	return flatbuffers.NewLeagueEncoder().DecodeFromBinary(buf)
 }
 
+// GetMatch returns a stored match with its teams. It returns collections.ErrNotFound if the match does not exist.
 func (k *Keeper) GetMatch(ctx context.Context, id int) (*datasource.Match, error) {
 	key := k.MatchKey(id)
 	buf, err := k.storeService.OpenKVStore(ctx).Get(key)
 	if err != nil {
 		return nil, err
 	}
+	if buf == nil {
+		return nil, fmt.Errorf("match %d: %w", id, collections.ErrNotFound)
+	}
 	match, err := flatbuffers.NewMatchEncoder().DecodeFromBinary(buf)
 	if err != nil {
 		return nil, err
@@ -84,7 +104,8 @@ func (k *Keeper) GetMatch(ctx context.Context, id int) (*datasource.Match, error
 
 	home, err := k.GetTeam(ctx, match.Home.ID)
 	if err != nil {
-		return nil, err
+		// a stored match always has its teams, a missing one is not a missing match
+		return nil, fmt.Errorf("home team of match %d: %v", id, err)
 	}
 	home.Score = match.Home.Score
 	home.ID = match.Home.ID
@@ -92,7 +113,7 @@ func (k *Keeper) GetMatch(ctx context.Context, id int) (*datasource.Match, error
 
 	away, err := k.GetTeam(ctx, match.Away.ID)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("away team of match %d: %v", id, err)
 	}
 	away.Score = match.Away.Score
 	away.ID = match.Away.ID
@@ -107,7 +128,40 @@ func (k *Keeper) SetMatch(ctx context.Context, match datasource.Match) error {
 	if err != nil {
 		return err
 	}
-	return k.storeService.OpenKVStore(ctx).Set(key, buf)
+	if err := k.storeService.OpenKVStore(ctx).Set(key, buf); err != nil {
+		return err
+	}
+	return k.setMatchResult(ctx, match)
+}
+
+func (k *Keeper) SetTeam(ctx context.Context, team datasource.Team) error {
+	old, err := k.GetTeam(ctx, team.ID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	buf, err := flatbuffers.NewTeamEncoder().EncodeToBinary(&team)
+	if err != nil {
+		return err
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set(k.TeamKey(team.ID), buf); err != nil {
+		return err
+	}
+	return indexNames(ctx, k.TeamNames, int64(team.ID), teamNames(old), teamNames(&team))
+}
+
+func (k *Keeper) SetLeague(ctx context.Context, league datasource.League) error {
+	old, err := k.GetLeague(ctx, league.ID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	buf, err := flatbuffers.NewLeagueEncoder().EncodeToBinary(&league)
+	if err != nil {
+		return err
+	}
+	if err := k.storeService.OpenKVStore(ctx).Set(k.LeagueKey(league.ID), buf); err != nil {
+		return err
+	}
+	return indexNames(ctx, k.LeagueNames, int64(league.ID), leagueNames(old), leagueNames(&league))
 }
 
 func (k *Keeper) GetTeam(ctx context.Context, id int) (*datasource.Team, error) {
@@ -116,6 +170,9 @@ func (k *Keeper) GetTeam(ctx context.Context, id int) (*datasource.Team, error)
 	if err != nil {
 		return nil, err
 	}
+	if buf == nil {
+		return nil, fmt.Errorf("team %d: %w", id, collections.ErrNotFound)
+	}
 	return flatbuffers.NewTeamEncoder().DecodeFromBinary(buf)
 }
 
@@ -136,7 +193,7 @@ func (k *Keeper) DeleteUnfinishedMatch(ctx context.Context, matchID int) error {
 }
 
 func (k *Keeper) ListUnfinishedMatches(ctx context.Context) ([]int, error) {
-	iterator, err := k.storeService.OpenKVStore(ctx).Iterator(MatchKeyUnfinishedPrefix, nil)
+	iterator, err := k.storeService.OpenKVStore(ctx).Iterator(MatchKeyUnfinishedPrefix, storetypes.PrefixEndBytes(MatchKeyUnfinishedPrefix))
 	if err != nil {
 		return nil, err
 	}
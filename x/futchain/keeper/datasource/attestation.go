@@ -0,0 +1,46 @@
+package datasource
+
+import (
+	"errors"
+	"fmt"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/goccy/go-json"
+)
+
+// ErrUnknownProvider is returned for attestations of providers without a registered key.
+var ErrUnknownProvider = errors.New("unknown data provider")
+
+// Attestation is the signature of a data provider over the payload of a match. Providers that sign their
+// data deliver it with the match, the payload being their JSON encoding of the match.
+type Attestation struct {
+	Provider  string `json:"provider"`
+	Payload   []byte `json:"payload"`
+	Signature []byte `json:"signature"`
+}
+
+// VerifyAttestation verifies the signature of the attestation of the match with the key of its provider,
+// and that the signed payload attests the match as it is.
+func (m *Match) VerifyAttestation(pubKeys map[string]cryptotypes.PubKey) error {
+	a := m.Attestation
+	if a == nil {
+		return errors.New("match is not attested")
+	}
+
+	pubKey, found := pubKeys[a.Provider]
+	if !found {
+		return fmt.Errorf("%w %q", ErrUnknownProvider, a.Provider)
+	}
+	if !pubKey.VerifySignature(a.Payload, a.Signature) {
+		return fmt.Errorf("invalid signature of provider %q", a.Provider)
+	}
+
+	var signed Match
+	if err := json.Unmarshal(a.Payload, &signed); err != nil {
+		return fmt.Errorf("invalid payload of provider %q: %w", a.Provider, err)
+	}
+	if signed.ID != m.ID || signed.LeagueID != m.LeagueID || signed.Home.ID != m.Home.ID || signed.Away.ID != m.Away.ID || signed.Compare(m) != PriorityNoChanges {
+		return fmt.Errorf("payload of provider %q does not attest match %d", a.Provider, m.ID)
+	}
+	return nil
+}
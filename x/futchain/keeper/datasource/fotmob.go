@@ -135,6 +135,9 @@ type Match struct {
 	Status           Status `json:"status"`
 	Ongoing          bool   `json:"ongoing"`
 	TimeTS           int64  `json:"timeTS"`
+
+	// Attestation is set by the providers signing their data.
+	Attestation *Attestation `json:"attestation,omitempty"`
 }
 type Team struct {
 	ID       int    `json:"id"`
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// leagueProto converts an ingested league to its proto message.
+func leagueProto(league datasource.League) types.League {
+	return types.League{
+		Id:        int64(league.ID),
+		Name:      league.Name,
+		GroupName: league.GroupName,
+		IsGroup:   league.IsGroup,
+		Ccode:     league.Ccode,
+		PrimaryId: int64(league.PrimaryID),
+	}
+}
+
+// teamProto converts an ingested team to its proto message. The score is carried by the match.
+func teamProto(team datasource.Team) types.Team {
+	return types.Team{
+		Id:       int64(team.ID),
+		Name:     team.Name,
+		LongName: team.LongName,
+	}
+}
+
+// matchProto converts an ingested match, with its teams filled in, to its proto message.
+func matchProto(match datasource.Match, finalized bool) types.Match {
+	return types.Match{
+		Id:              int64(match.ID),
+		LeagueId:        int64(match.LeagueID),
+		Time:            match.Time,
+		Home:            teamProto(match.Home),
+		Away:            teamProto(match.Away),
+		HomeScore:       int64(match.Home.Score),
+		AwayScore:       int64(match.Away.Score),
+		StatusId:        int32(match.StatusID),
+		TournamentStage: match.TournamentStage,
+		Status: types.Status{
+			UtcTime:      match.Status.UtcTime.UTC(),
+			PeriodLength: int32(match.Status.PeriodLength),
+			Started:      match.Status.Started,
+			Cancelled:    match.Status.Cancelled,
+			Finished:     match.Status.Finished,
+			Ongoing:      match.Status.Ongoing,
+			LiveTime: types.LiveTime{
+				Long:      match.Status.LiveTime.Long,
+				MaxTime:   int32(match.Status.LiveTime.MaxTime),
+				AddedTime: int32(match.Status.LiveTime.AddedTime),
+			},
+		},
+		TimeTs:    match.TimeTS,
+		Finalized: finalized,
+	}
+}
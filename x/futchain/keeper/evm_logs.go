@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// EmitEvmLog mirrors a module state change as an Ethereum log attributed to the futchain precompile.
+// The log is packed with the base contract abi and emitted as a `tx_log` event, the same encoding
+// the evm module uses, so it can be decoded with evmtypes.ParseTxLogsFromEvent.
+// Events missing from the abi are silently skipped.
+func (k *Keeper) EmitEvmLog(ctx sdk.Context, name string, args ...interface{}) error {
+	event, ok := k.ABI.Events[name]
+	if !ok {
+		return nil
+	}
+	if len(args) != len(event.Inputs) {
+		return fmt.Errorf("invalid number of arguments for %s event", name)
+	}
+
+	var indexed, data []interface{}
+	for i, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, args[i])
+		} else {
+			data = append(data, args[i])
+		}
+	}
+
+	topics, err := abi.MakeTopics(indexed)
+	if err != nil {
+		return err
+	}
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return err
+	}
+
+	log := &ethtypes.Log{
+		Address:     common.HexToAddress(types.FutchainPrecompileAddress),
+		Topics:      []common.Hash{event.ID},
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+		BlockHash:   common.BytesToHash(ctx.HeaderHash()),
+		Index:       k.countEvmLogs(ctx),
+	}
+	for _, topic := range topics {
+		log.Topics = append(log.Topics, topic[0])
+	}
+
+	bz, err := json.Marshal(evmtypes.NewLogFromEth(log))
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(evmtypes.EventTypeTxLog, sdk.NewAttribute(evmtypes.AttributeKeyTxLog, string(bz))))
+	return nil
+}
+
+// countEvmLogs returns the number of logs already emitted in the current event manager.
+func (k *Keeper) countEvmLogs(ctx sdk.Context) uint {
+	var n uint
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == evmtypes.AttributeKeyTxLog {
+				n++
+			}
+		}
+	}
+	return n
+}
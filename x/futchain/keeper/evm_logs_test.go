@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestEmitEvmLog(t *testing.T) {
+	f := initFixture(t)
+
+	file, err := os.Open("../contracts/abi.json")
+	require.NoError(t, err)
+	defer file.Close()
+	f.keeper.ABI, err = abi.JSON(file)
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.EmitEvmLog(ctx, types.EvmEventMatchScoreChanged, big.NewInt(42), big.NewInt(2), big.NewInt(1)))
+	require.NoError(t, f.keeper.EmitEvmLog(ctx, types.EvmEventMatchFinished, big.NewInt(42), big.NewInt(2), big.NewInt(1), false))
+	require.Error(t, f.keeper.EmitEvmLog(ctx, types.EvmEventMatchFinished, big.NewInt(42)))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+
+	logs, err := evmtypes.ParseTxLogsFromEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+
+	event := f.keeper.ABI.Events[types.EvmEventMatchScoreChanged]
+	require.Equal(t, common.HexToAddress(types.FutchainPrecompileAddress), logs[0].Address)
+	require.Equal(t, []common.Hash{event.ID, common.BigToHash(big.NewInt(42))}, logs[0].Topics)
+
+	values, err := event.Inputs.NonIndexed().Unpack(logs[0].Data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(2), big.NewInt(1)}, values)
+
+	logs, err = evmtypes.ParseTxLogsFromEvent(abci.Event(events[1]))
+	require.NoError(t, err)
+	require.Equal(t, uint(1), logs[0].Index)
+}
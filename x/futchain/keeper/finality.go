@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// GetMatchFinality returns the finality state of a match and the height it was, or is expected to be, finalized at.
+// The height is zero for open matches.
+func (k *Keeper) GetMatchFinality(ctx context.Context, matchID int64) (uint8, int64, error) {
+	height, err := k.FinalizedMatches.Get(ctx, matchID)
+	if err == nil {
+		return types.FinalityFinalized, height, nil
+	}
+	if !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return 0, 0, err
+	}
+
+	changed, err := k.PendingFinality.Get(ctx, matchID)
+	if err == nil {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+		return types.FinalityPending, changed + int64(params.FinalityBlocks), nil
+	}
+	if !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return 0, 0, err
+	}
+
+	return types.FinalityOpen, 0, nil
+}
+
+// IsMatchFinalized reports whether the result of the match is final.
+func (k *Keeper) IsMatchFinalized(ctx context.Context, matchID int64) (bool, error) {
+	return k.FinalizedMatches.Has(ctx, matchID)
+}
+
+// StartFinalityWindow (re)starts the dispute window of a finished or cancelled match at the current height.
+func (k *Keeper) StartFinalityWindow(ctx context.Context, matchID int64) error {
+	return k.PendingFinality.Set(ctx, matchID, sdk.UnwrapSDKContext(ctx).BlockHeight())
+}
+
+// ReopenMatch removes a match from its dispute window, after the source reported it as not finished anymore.
+func (k *Keeper) ReopenMatch(ctx context.Context, matchID int64) error {
+	return k.PendingFinality.Remove(ctx, matchID)
+}
+
+// FinalizeMatches finalizes the matches whose result did not change during the dispute window, then settles
+// their markets and queues their callbacks.
+func (k *Keeper) FinalizeMatches(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	iterator, err := k.PendingFinality.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	pending, err := iterator.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range pending {
+		matchID, changed := entry.Key, entry.Value
+		if changed+int64(params.FinalityBlocks) > ctx.BlockHeight() {
+			continue
+		}
+
+		// a failed finalization is rolled back as a whole, the match stays pending for the next block
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.finalizeMatch(cacheCtx, matchID); err != nil {
+			ctx.Logger().Error("failed to finalize match", "error", err, "match", matchID)
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// finalizeMatch marks the result of the match as final, then settles its open markets, queues its callbacks,
+// tallies the validator and reporter reports on it and sends it to the subscribed IBC channels.
+func (k *Keeper) finalizeMatch(ctx sdk.Context, matchID int64) error {
+	match, err := k.GetMatch(ctx, int(matchID))
+	if err != nil {
+		return err
+	}
+
+	// the height the result came in at, the start of its dispute window unless it is finalized right away
+	resultHeight, err := k.PendingFinality.Get(ctx, matchID)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		resultHeight = ctx.BlockHeight()
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.PendingFinality.Remove(ctx, matchID); err != nil {
+		return err
+	}
+	if err := k.FinalizedMatches.Set(ctx, matchID, ctx.BlockHeight()); err != nil {
+		return err
+	}
+	if err := k.setMatchResult(ctx, *match); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("match has been finalized", "match", matchID, "event", "match_finalized")
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMatchFinalized{
+		MatchId:   matchID,
+		HomeScore: int64(match.Home.Score),
+		AwayScore: int64(match.Away.Score),
+		Cancelled: match.Status.Cancelled,
+	}); err != nil {
+		ctx.Logger().Error("failed to emit event", "error", err, "event", "match_finalized", "match", matchID)
+	}
+	if err := k.EmitEvmLog(ctx, types.EvmEventMatchFinalized, big.NewInt(matchID), big.NewInt(int64(match.Home.Score)), big.NewInt(int64(match.Away.Score)), match.Status.Cancelled); err != nil {
+		ctx.Logger().Error("failed to emit evm log", "error", err, "event", types.EvmEventMatchFinalized, "match", matchID)
+	}
+
+	if err := k.SettleMatchMarkets(ctx, int(matchID)); err != nil {
+		ctx.Logger().Error("failed to settle match markets", "error", err, "match", matchID)
+	}
+	if err := k.EnqueueMatchCallbacks(ctx, int(matchID)); err != nil {
+		ctx.Logger().Error("failed to enqueue match callbacks", "error", err, "match", matchID)
+	}
+	if err := k.TallyOracleReports(ctx, match, resultHeight); err != nil {
+		ctx.Logger().Error("failed to tally oracle reports", "error", err, "match", matchID)
+	}
+	if err := k.TallyMatchReports(ctx, match); err != nil {
+		ctx.Logger().Error("failed to tally match reports", "error", err, "match", matchID)
+	}
+	if err := k.SendMatchResultPackets(ctx, matchID); err != nil {
+		ctx.Logger().Error("failed to send match result packets", "error", err, "match", matchID)
+	}
+
+	return nil
+}
+
+// reviseFinalizedMatch applies the corrected result of a finalized match, whose stored match and result record
+// are already updated: the markets still open are settled on it. The reports on the match were tallied and its
+// IBC packets were sent when it was finalized, they are not redone.
+func (k *Keeper) reviseFinalizedMatch(ctx sdk.Context, match datasource.Match) error {
+	if err := k.SettleMatchMarkets(ctx, match.ID); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("finalized match result has been revised", "match", match.ID, "event", "match_result_revised")
+	return ctx.EventManager().EmitTypedEvent(&types.EventMatchResultRevised{
+		MatchId:   int64(match.ID),
+		HomeScore: int64(match.Home.Score),
+		AwayScore: int64(match.Away.Score),
+		Cancelled: match.Status.Cancelled,
+	})
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestMatchFinality(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.FinalityBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	match := setupMarketMatch(t, f, 30)
+	matchID := int64(match.ID)
+
+	alice := sdk.AccAddress("alice")
+	f.bankKeeper.balances[string(alice)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	marketID, err := f.keeper.CreateMarket(ctx, alice, matchID, types.MARKET_TYPE_MATCH_RESULT, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.PlaceStake(ctx, alice, marketID, types.OutcomeHome, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	state, height, err := f.keeper.GetMatchFinality(ctx, matchID)
+	require.NoError(t, err)
+	require.Equal(t, types.FinalityOpen, state)
+	require.Zero(t, height)
+
+	match.Home.Score = 1
+	match.Status.Started, match.Status.Finished = true, true
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+	require.NoError(t, f.keeper.StartFinalityWindow(ctx, matchID))
+
+	state, height, err = f.keeper.GetMatchFinality(ctx, matchID)
+	require.NoError(t, err)
+	require.Equal(t, types.FinalityPending, state)
+	require.Equal(t, int64(110), height)
+
+	// a revision restarts the window
+	ctx = ctx.WithBlockHeight(105)
+	require.NoError(t, f.keeper.StartFinalityWindow(ctx, matchID))
+
+	ctx = ctx.WithBlockHeight(110)
+	require.NoError(t, f.keeper.FinalizeMatches(ctx))
+	state, _, err = f.keeper.GetMatchFinality(ctx, matchID)
+	require.NoError(t, err)
+	require.Equal(t, types.FinalityPending, state)
+
+	market, err := f.keeper.Markets.Get(ctx, marketID)
+	require.NoError(t, err)
+	require.Equal(t, types.MARKET_STATUS_OPEN, market.Status)
+
+	ctx = ctx.WithBlockHeight(115).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.FinalizeMatches(ctx))
+	state, height, err = f.keeper.GetMatchFinality(ctx, matchID)
+	require.NoError(t, err)
+	require.Equal(t, types.FinalityFinalized, state)
+	require.Equal(t, int64(115), height)
+
+	// markets settle on finality
+	market, err = f.keeper.Markets.Get(ctx, marketID)
+	require.NoError(t, err)
+	require.Equal(t, types.MARKET_STATUS_SETTLED, market.Status)
+
+	var finalized bool
+	for _, event := range ctx.EventManager().Events() {
+		finalized = finalized || event.Type == proto.MessageName(&types.EventMatchFinalized{})
+	}
+	require.True(t, finalized)
+
+	// reopening removes the match from its dispute window
+	other := setupMarketMatch(t, f, 31)
+	require.NoError(t, f.keeper.StartFinalityWindow(ctx, int64(other.ID)))
+	require.NoError(t, f.keeper.ReopenMatch(ctx, int64(other.ID)))
+	state, _, err = f.keeper.GetMatchFinality(ctx, int64(other.ID))
+	require.NoError(t, err)
+	require.Equal(t, types.FinalityOpen, state)
+
+	// a match that can not be loaded is not finalized, and stays pending
+	require.NoError(t, f.keeper.StartFinalityWindow(ctx, 404))
+	ctx = ctx.WithBlockHeight(125)
+	require.NoError(t, f.keeper.FinalizeMatches(ctx))
+	state, _, err = f.keeper.GetMatchFinality(ctx, 404)
+	require.NoError(t, err)
+	require.Equal(t, types.FinalityPending, state)
+}
+
+func TestReviseFinalizedMatch(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(20).WithBlockTime(time.Unix(1_000_000, 0))
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	vals := setupValidators(t, f, 10, 10)
+	params := types.DefaultParams()
+	params.OracleReportWindow = 4
+	params.MaxMissedReports = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	match := setupMarketMatch(t, f, 50)
+	for _, val := range vals {
+		require.NoError(t, f.keeper.SubmitOracleReport(ctx, types.OracleReport{ValidatorAddress: val, MatchId: int64(match.ID), HomeScore: 1}))
+	}
+	require.NoError(t, f.keeper.OverrideMatch(ctx, int64(match.ID), 1, 0, true, true, false))
+
+	info := func(val string) types.ValidatorOracleInfo {
+		t.Helper()
+		res, err := qs.ValidatorOracleInfo(ctx, &types.QueryValidatorOracleInfoRequest{ValidatorAddress: val})
+		require.NoError(t, err)
+		return res.Info
+	}
+	for _, val := range vals {
+		require.Equal(t, types.ValidatorOracleInfo{ValidatorAddress: val, IndexOffset: 1}, info(val))
+	}
+
+	// correcting the finalized result does not tally the removed reports as missed
+	ctx = ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.OverrideMatch(ctx, int64(match.ID), 2, 0, true, true, false))
+	require.Empty(t, f.slashingKeeper.slashed)
+	require.Empty(t, f.slashingKeeper.jailed)
+	for _, val := range vals {
+		require.Equal(t, types.ValidatorOracleInfo{ValidatorAddress: val, IndexOffset: 1}, info(val))
+	}
+
+	state, height, err := f.keeper.GetMatchFinality(ctx, int64(match.ID))
+	require.NoError(t, err)
+	require.Equal(t, types.FinalityFinalized, state)
+	require.Equal(t, int64(20), height)
+	result, err := f.keeper.GetMatchResult(ctx, uint64(match.ID))
+	require.NoError(t, err)
+	require.Equal(t, types.MatchResult{MatchID: uint64(match.ID), HomeScore: 2, Started: true, Finished: true, Finalized: true}, result)
+
+	var revised, finalized bool
+	for _, event := range ctx.EventManager().Events() {
+		revised = revised || event.Type == proto.MessageName(&types.EventMatchResultRevised{})
+		finalized = finalized || event.Type == proto.MessageName(&types.EventMatchFinalized{})
+	}
+	require.True(t, revised)
+	require.False(t, finalized)
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// OnRecvFutchainPacket handles a packet received from a counterparty chain and returns the result of its
+// acknowledgement: the current result of the queried or subscribed match.
+func (k *Keeper) OnRecvFutchainPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	var data types.FutchainPacketData
+	if err := k.cdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacket, err.Error())
+	}
+
+	var result types.MatchResultPacketData
+	switch p := data.Packet.(type) {
+	case *types.FutchainPacketData_QueryMatch:
+		var err error
+		if result, err = k.matchResultPacket(ctx, p.QueryMatch.MatchId); err != nil {
+			return nil, err
+		}
+
+	case *types.FutchainPacketData_Subscribe:
+		var err error
+		if result, err = k.matchResultPacket(ctx, p.Subscribe.MatchId); err != nil {
+			return nil, err
+		}
+		// the acknowledgement already carries the final result
+		if !result.Finalized {
+			if err := k.IBCSubscriptions.Set(ctx, collections.Join(result.MatchId, packet.GetDestChannel())); err != nil {
+				return nil, err
+			}
+			ctx.EventManager().EmitEvent(sdk.NewEvent("ibc_match_subscribed",
+				sdk.NewAttribute("match_id", strconv.FormatInt(result.MatchId, 10)),
+				sdk.NewAttribute("channel", packet.GetDestChannel()),
+			))
+		}
+
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidPacket, "unsupported packet %T", data.Packet)
+	}
+
+	return k.cdc.MarshalJSON(&result)
+}
+
+// SendMatchResultPackets sends the result of a finalized match on the IBC channels subscribed to it, and
+// removes their subscriptions. A result that can not be sent on a channel is dropped.
+func (k *Keeper) SendMatchResultPackets(ctx sdk.Context, matchID int64) error {
+	if k.channelKeeper == nil {
+		return nil
+	}
+
+	iterator, err := k.IBCSubscriptions.Iterate(ctx, collections.NewPrefixedPairRange[int64, string](matchID))
+	if err != nil {
+		return err
+	}
+	subscriptions, err := iterator.Keys()
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	result, err := k.matchResultPacket(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.MarshalJSON(&types.FutchainPacketData{Packet: &types.FutchainPacketData_MatchResult{MatchResult: &result}})
+	if err != nil {
+		return err
+	}
+	timeout := uint64(ctx.BlockTime().Add(types.ResultPacketTimeout).UnixNano())
+
+	for _, subscription := range subscriptions {
+		if err := k.IBCSubscriptions.Remove(ctx, subscription); err != nil {
+			return err
+		}
+
+		channelID := subscription.K2()
+		sequence, err := k.channelKeeper.SendPacket(ctx, types.PortID, channelID, clienttypes.ZeroHeight(), timeout, bz)
+		if err != nil {
+			ctx.Logger().Error("failed to send match result packet", "error", err, "match", matchID, "channel", channelID)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent("ibc_match_result_sent",
+			sdk.NewAttribute("match_id", strconv.FormatInt(matchID, 10)),
+			sdk.NewAttribute("channel", channelID),
+			sdk.NewAttribute("sequence", strconv.FormatUint(sequence, 10)),
+		))
+	}
+
+	return nil
+}
+
+// RemoveChannelSubscriptions removes the subscriptions of a closed IBC channel.
+func (k *Keeper) RemoveChannelSubscriptions(ctx sdk.Context, channelID string) error {
+	iterator, err := k.IBCSubscriptions.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	subscriptions, err := iterator.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, subscription := range subscriptions {
+		if subscription.K2() != channelID {
+			continue
+		}
+		if err := k.IBCSubscriptions.Remove(ctx, subscription); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchResultPacket returns the current result of a match.
+func (k *Keeper) matchResultPacket(ctx sdk.Context, matchID int64) (types.MatchResultPacketData, error) {
+	if matchID <= 0 {
+		return types.MatchResultPacketData{}, errorsmod.Wrapf(types.ErrInvalidPacket, "invalid match id %d", matchID)
+	}
+	match, err := k.GetMatch(ctx, int(matchID))
+	if err != nil {
+		return types.MatchResultPacketData{}, errorsmod.Wrapf(types.ErrInvalidPacket, "match %d: %s", matchID, err)
+	}
+	finalized, err := k.IsMatchFinalized(ctx, matchID)
+	if err != nil {
+		return types.MatchResultPacketData{}, err
+	}
+
+	return types.MatchResultPacketData{
+		MatchId:   int64(match.ID),
+		LeagueId:  int64(match.LeagueID),
+		HomeId:    int64(match.Home.ID),
+		HomeName:  match.Home.Name,
+		AwayId:    int64(match.Away.ID),
+		AwayName:  match.Away.Name,
+		HomeScore: int64(match.Home.Score),
+		AwayScore: int64(match.Away.Score),
+		Started:   match.Status.Started,
+		Finished:  match.Status.Finished,
+		Cancelled: match.Status.Cancelled,
+		Finalized: finalized,
+		Height:    ctx.BlockHeight(),
+	}, nil
+}
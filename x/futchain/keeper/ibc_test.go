@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestIBCMatchSubscriptions(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(5)
+	setupMarketMatch(t, f, 100)
+
+	recv := func(channel, data string) (map[string]any, error) {
+		bz, err := f.keeper.OnRecvFutchainPacket(ctx, channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: channel, Data: []byte(data)})
+		if err != nil {
+			return nil, err
+		}
+		var result map[string]any
+		require.NoError(t, json.Unmarshal(bz, &result))
+		return result, nil
+	}
+
+	result, err := recv("channel-0", `{"query_match":{"match_id":"100"}}`)
+	require.NoError(t, err)
+	require.Equal(t, "100", result["match_id"])
+	require.Equal(t, "Home", result["home_name"])
+	require.Equal(t, false, result["finalized"])
+
+	_, err = recv("channel-0", `{"query_match":{"match_id":"101"}}`)
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+	_, err = recv("channel-0", `{"match_result":{"match_id":"100"}}`)
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+	_, err = recv("channel-0", `not json`)
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+
+	for _, channel := range []string{"channel-0", "channel-1", "channel-2"} {
+		_, err = recv(channel, `{"subscribe":{"match_id":"100"}}`)
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.keeper.RemoveChannelSubscriptions(ctx, "channel-2"))
+
+	// the result is sent on the subscribed channels once the match finalizes
+	require.NoError(t, f.keeper.OverrideMatch(ctx, 100, 2, 1, true, true, false))
+	require.Len(t, f.channelKeeper.sent, 2)
+	require.Equal(t, "channel-0", f.channelKeeper.sent[0].channel)
+	require.Equal(t, "channel-1", f.channelKeeper.sent[1].channel)
+
+	var packet struct {
+		MatchResult map[string]any `json:"match_result"`
+	}
+	require.NoError(t, json.Unmarshal(f.channelKeeper.sent[0].data, &packet))
+	require.Equal(t, "2", packet.MatchResult["home_score"])
+	require.Equal(t, "1", packet.MatchResult["away_score"])
+	require.Equal(t, true, packet.MatchResult["finalized"])
+
+	// a finalized match is answered right away, without a subscription
+	result, err = recv("channel-3", `{"subscribe":{"match_id":"100"}}`)
+	require.NoError(t, err)
+	require.Equal(t, true, result["finalized"])
+	has, err := f.keeper.IBCSubscriptions.Has(ctx, collections.Join(int64(100), "channel-3"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// entityKey returns the store key of the team, league or match with a canonical id.
+func (k *Keeper) entityKey(entityType types.EntityType, id int64) []byte {
+	switch entityType {
+	case types.ENTITY_TYPE_TEAM:
+		return k.TeamKey(int(id))
+	case types.ENTITY_TYPE_LEAGUE:
+		return k.LeagueKey(int(id))
+	default:
+		return k.MatchKey(int(id))
+	}
+}
+
+// hasEntity reports whether a team, league or match with a canonical id is stored.
+func (k *Keeper) hasEntity(ctx context.Context, entityType types.EntityType, id int64) (bool, error) {
+	return k.storeService.OpenKVStore(ctx).Has(k.entityKey(entityType, id))
+}
+
+// isCanonicalIDUsed reports whether a canonical id is mapped, merged or stored.
+func (k *Keeper) isCanonicalIDUsed(ctx context.Context, entityType types.EntityType, id int64) (bool, error) {
+	if merged, err := k.MergedIDs.Has(ctx, collections.Join(int32(entityType), id)); err != nil || merged {
+		return merged, err
+	}
+	mappings, err := k.externalIDs(ctx, entityType, id)
+	if err != nil || len(mappings) > 0 {
+		return len(mappings) > 0, err
+	}
+	return k.hasEntity(ctx, entityType, id)
+}
+
+// allocateCanonicalID returns the next canonical id that is not used yet.
+func (k *Keeper) allocateCanonicalID(ctx context.Context, entityType types.EntityType) (int64, error) {
+	for {
+		seq, err := k.CanonicalIDSeq.Next(ctx)
+		if err != nil {
+			return 0, err
+		}
+		id := int64(seq) + 1
+		if id > types.MaxCanonicalID {
+			return 0, errorsmod.Wrap(types.ErrInvalidIDMapping, "no canonical ids left")
+		}
+		// the entities ingested before the id mappings keep the ids of the source provider, see Migrate1to2,
+		// and the authority upserts entities with ids of its choice
+		used, err := k.isCanonicalIDUsed(ctx, entityType, id)
+		if err != nil {
+			return 0, err
+		}
+		if !used {
+			return id, nil
+		}
+	}
+}
+
+// setIDMapping maps the id of an entity at a provider to a canonical id, replacing its previous mapping.
+func (k *Keeper) setIDMapping(ctx context.Context, entityType types.EntityType, provider, externalID string, canonicalID int64) error {
+	key := collections.Join3(int32(entityType), provider, externalID)
+	old, err := k.IDMappings.Get(ctx, key)
+	switch {
+	case err == nil:
+		if err := k.CanonicalIDMappings.Remove(ctx, collections.Join4(int32(entityType), old, provider, externalID)); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.IDMappings.Set(ctx, key, canonicalID); err != nil {
+		return err
+	}
+	return k.CanonicalIDMappings.Set(ctx, collections.Join4(int32(entityType), canonicalID, provider, externalID))
+}
+
+// externalIDs returns the mappings of the ids of an entity at the providers to its canonical id.
+func (k *Keeper) externalIDs(ctx context.Context, entityType types.EntityType, canonicalID int64) ([]types.IDMapping, error) {
+	iter, err := k.CanonicalIDMappings.Iterate(ctx, collections.NewSuperPrefixedQuadRange[int32, int64, string, string](int32(entityType), canonicalID))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var mappings []types.IDMapping
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, types.IDMapping{EntityType: entityType, Provider: key.K3(), ExternalId: key.K4(), CanonicalId: canonicalID})
+	}
+	return mappings, nil
+}
+
+// ExternalIDs returns the mappings of the ids of an entity at the providers to its canonical id, and the
+// canonical id it was merged into, if it was merged as a duplicate.
+func (k *Keeper) ExternalIDs(ctx context.Context, entityType types.EntityType, canonicalID int64) ([]types.IDMapping, int64, error) {
+	if err := types.ValidateEntityType(entityType); err != nil {
+		return nil, 0, err
+	}
+	mappings, err := k.externalIDs(ctx, entityType, canonicalID)
+	if err != nil {
+		return nil, 0, err
+	}
+	mergedInto, err := k.MergedIDs.Get(ctx, collections.Join(int32(entityType), canonicalID))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, 0, err
+	}
+	return mappings, mergedInto, nil
+}
+
+// CanonicalID returns the canonical id of an entity by its id at a provider. It returns
+// collections.ErrNotFound if the id is not mapped.
+func (k *Keeper) CanonicalID(ctx context.Context, entityType types.EntityType, provider, externalID string) (int64, error) {
+	if err := types.ValidateEntityType(entityType); err != nil {
+		return 0, err
+	}
+	return k.IDMappings.Get(ctx, collections.Join3(int32(entityType), provider, externalID))
+}
+
+// ResolveCanonicalID returns the canonical id of an entity by its id at a provider, mapping the id to a newly
+// allocated canonical id if it is not mapped yet.
+func (k *Keeper) ResolveCanonicalID(ctx context.Context, entityType types.EntityType, provider, externalID string) (int64, error) {
+	if err := types.ValidateEntityType(entityType); err != nil {
+		return 0, err
+	}
+	if err := types.ValidateExternalID(provider, externalID); err != nil {
+		return 0, err
+	}
+
+	id, err := k.IDMappings.Get(ctx, collections.Join3(int32(entityType), provider, externalID))
+	if err == nil {
+		return id, nil
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	if id, err = k.allocateCanonicalID(ctx, entityType); err != nil {
+		return 0, err
+	}
+	return id, k.setIDMapping(ctx, entityType, provider, externalID, id)
+}
+
+// CanonicalizeIDs replaces the ids of the leagues, matches and teams fetched from a provider with their
+// canonical ids, see ResolveCanonicalID.
+func (k *Keeper) CanonicalizeIDs(ctx context.Context, provider string, leagues []datasource.League) ([]datasource.League, error) {
+	resolve := func(entityType types.EntityType, id *int) error {
+		canonicalID, err := k.ResolveCanonicalID(ctx, entityType, provider, strconv.Itoa(*id))
+		if err != nil {
+			return err
+		}
+		*id = int(canonicalID)
+		return nil
+	}
+
+	for i := range leagues {
+		l := &leagues[i]
+		if err := resolve(types.ENTITY_TYPE_LEAGUE, &l.ID); err != nil {
+			return nil, err
+		}
+		if l.PrimaryID != 0 {
+			if err := resolve(types.ENTITY_TYPE_LEAGUE, &l.PrimaryID); err != nil {
+				return nil, err
+			}
+		}
+		for j := range l.Matches {
+			m := &l.Matches[j]
+			for _, id := range []struct {
+				entityType types.EntityType
+				id         *int
+			}{
+				{types.ENTITY_TYPE_MATCH, &m.ID},
+				{types.ENTITY_TYPE_LEAGUE, &m.LeagueID},
+				{types.ENTITY_TYPE_TEAM, &m.Home.ID},
+				{types.ENTITY_TYPE_TEAM, &m.Away.ID},
+			} {
+				if err := resolve(id.entityType, id.id); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return leagues, nil
+}
+
+// MapExternalID maps the id of an entity at a provider to the canonical id of a stored entity, which must not
+// be a merged duplicate.
+func (k *Keeper) MapExternalID(ctx context.Context, entityType types.EntityType, provider, externalID string, canonicalID int64) error {
+	if err := types.ValidateEntityType(entityType); err != nil {
+		return err
+	}
+	if err := types.ValidateExternalID(provider, externalID); err != nil {
+		return err
+	}
+	if err := k.checkMergeable(ctx, entityType, canonicalID); err != nil {
+		return err
+	}
+	return k.setIDMapping(ctx, entityType, provider, externalID, canonicalID)
+}
+
+// checkMergeable returns an error unless an entity is stored and is not a merged duplicate.
+func (k *Keeper) checkMergeable(ctx context.Context, entityType types.EntityType, id int64) error {
+	if ok, err := k.hasEntity(ctx, entityType, id); err != nil {
+		return err
+	} else if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidIDMapping, "%s %d does not exist", entityType, id)
+	}
+	mergedInto, err := k.MergedIDs.Get(ctx, collections.Join(int32(entityType), id))
+	if err == nil {
+		return errorsmod.Wrapf(types.ErrInvalidIDMapping, "%s %d is merged into %d", entityType, id, mergedInto)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// MergeEntities merges a duplicate team, league or match into its canonical entity. The ids of the duplicate
+// at the providers are mapped to the canonical id, so the next ingestions update the canonical entity. The
+// duplicate stays stored for the data referring to it: a team or league is removed from the name search, and
+// a match that is not finalized yet is voided, which refunds its markets.
+func (k *Keeper) MergeEntities(ctx sdk.Context, entityType types.EntityType, duplicateID, canonicalID int64) error {
+	if err := types.ValidateEntityType(entityType); err != nil {
+		return err
+	}
+	if duplicateID == canonicalID {
+		return errorsmod.Wrapf(types.ErrInvalidIDMapping, "can not merge %s %d into itself", entityType, duplicateID)
+	}
+	if err := k.checkMergeable(ctx, entityType, duplicateID); err != nil {
+		return err
+	}
+	if err := k.checkMergeable(ctx, entityType, canonicalID); err != nil {
+		return err
+	}
+
+	mappings, err := k.externalIDs(ctx, entityType, duplicateID)
+	if err != nil {
+		return err
+	}
+	for _, mapping := range mappings {
+		if err := k.setIDMapping(ctx, entityType, mapping.Provider, mapping.ExternalId, canonicalID); err != nil {
+			return err
+		}
+	}
+
+	// the duplicates merged into the duplicate resolve to the canonical id in one step
+	var merged []int64
+	if err := k.MergedIDs.Walk(ctx, collections.NewPrefixedPairRange[int32, int64](int32(entityType)), func(key collections.Pair[int32, int64], into int64) (bool, error) {
+		if into == duplicateID {
+			merged = append(merged, key.K2())
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, id := range append(merged, duplicateID) {
+		if err := k.MergedIDs.Set(ctx, collections.Join(int32(entityType), id), canonicalID); err != nil {
+			return err
+		}
+	}
+
+	switch entityType {
+	case types.ENTITY_TYPE_TEAM:
+		team, err := k.GetTeam(ctx, int(duplicateID))
+		if err != nil {
+			return err
+		}
+		return indexNames(ctx, k.TeamNames, duplicateID, teamNames(team), nil)
+	case types.ENTITY_TYPE_LEAGUE:
+		league, err := k.GetLeague(ctx, int(duplicateID))
+		if err != nil {
+			return err
+		}
+		return indexNames(ctx, k.LeagueNames, duplicateID, leagueNames(league), nil)
+	default:
+		finalized, err := k.IsMatchFinalized(ctx, duplicateID)
+		if err != nil || finalized {
+			return err
+		}
+		return k.VoidMatch(ctx, duplicateID)
+	}
+}
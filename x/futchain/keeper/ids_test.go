@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestCanonicalIDs(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.DefaultParams()))
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	// a team upserted by the authority
+	_, err = f.keeper.SaveTeamIfNotExists(ctx, datasource.Team{ID: 3, Name: "Upserted"})
+	require.NoError(t, err)
+
+	// the source ids are mapped to the ids the chain allocates, skipping the used ones
+	fetched := func() []datasource.League {
+		return []datasource.League{{ID: 71, Name: "Süper Lig", Matches: []datasource.Match{
+			{ID: 100, LeagueID: 71, Home: datasource.Team{ID: 7}, Away: datasource.Team{ID: 8}},
+			{ID: 101, LeagueID: 71, Home: datasource.Team{ID: 9}, Away: datasource.Team{ID: 8}},
+		}}}
+	}
+	leagues, err := f.keeper.CanonicalizeIDs(ctx, types.SourceProvider, fetched())
+	require.NoError(t, err)
+	require.Equal(t, []datasource.League{{ID: 1, Name: "Süper Lig", Matches: []datasource.Match{
+		{ID: 2, LeagueID: 1, Home: datasource.Team{ID: 4}, Away: datasource.Team{ID: 5}},
+		{ID: 6, LeagueID: 1, Home: datasource.Team{ID: 7}, Away: datasource.Team{ID: 5}},
+	}}}, leagues)
+	for _, team := range []datasource.Team{{ID: 4, Name: "Fenerbahçe"}, {ID: 7, Name: "Fenerbahce SK"}} {
+		_, err := f.keeper.SaveTeamIfNotExists(ctx, team)
+		require.NoError(t, err)
+	}
+
+	// the ids of the other providers are allocated the same way
+	id, err := f.keeper.ResolveCanonicalID(ctx, types.ENTITY_TYPE_TEAM, "sofascore", "3052")
+	require.NoError(t, err)
+	require.Equal(t, int64(8), id)
+	id, err = f.keeper.ResolveCanonicalID(ctx, types.ENTITY_TYPE_TEAM, "sofascore", "3052")
+	require.NoError(t, err)
+	require.Equal(t, int64(8), id)
+
+	_, err = f.keeper.ResolveCanonicalID(ctx, types.ENTITY_TYPE_UNSPECIFIED, "sofascore", "1")
+	require.ErrorIs(t, err, types.ErrInvalidIDMapping)
+	_, err = f.keeper.ResolveCanonicalID(ctx, types.ENTITY_TYPE_TEAM, "", "1")
+	require.ErrorIs(t, err, types.ErrInvalidIDMapping)
+
+	// mapping an id of another provider to a stored team
+	_, err = ms.MapExternalID(ctx, &types.MsgMapExternalID{Authority: authorityStr, EntityType: types.ENTITY_TYPE_TEAM, Provider: "sofascore", ExternalId: "3052", CanonicalId: 4, Reason: "same team"})
+	require.NoError(t, err)
+	_, err = ms.MapExternalID(ctx, &types.MsgMapExternalID{Authority: authorityStr, EntityType: types.ENTITY_TYPE_TEAM, Provider: "sofascore", ExternalId: "1", CanonicalId: 404})
+	require.ErrorIs(t, err, types.ErrInvalidIDMapping)
+
+	canonical, err := qs.CanonicalID(ctx, &types.QueryCanonicalIDRequest{EntityType: types.ENTITY_TYPE_TEAM, Provider: "sofascore", ExternalId: "3052"})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), canonical.CanonicalId)
+	_, err = qs.CanonicalID(ctx, &types.QueryCanonicalIDRequest{EntityType: types.ENTITY_TYPE_TEAM, Provider: "sofascore", ExternalId: "1"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = qs.CanonicalID(ctx, &types.QueryCanonicalIDRequest{Provider: "sofascore", ExternalId: "3052"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// merging the duplicate team
+	_, err = ms.MergeEntities(ctx, &types.MsgMergeEntities{Authority: authorityStr, EntityType: types.ENTITY_TYPE_TEAM, DuplicateId: 7, CanonicalId: 7})
+	require.ErrorIs(t, err, types.ErrInvalidIDMapping)
+	_, err = ms.MergeEntities(ctx, &types.MsgMergeEntities{Authority: sdk.AccAddress("alice").String(), EntityType: types.ENTITY_TYPE_TEAM, DuplicateId: 7, CanonicalId: 4})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.MergeEntities(ctx, &types.MsgMergeEntities{Authority: authorityStr, EntityType: types.ENTITY_TYPE_TEAM, DuplicateId: 7, CanonicalId: 4, Reason: "duplicate"})
+	require.NoError(t, err)
+	events := ctx.EventManager().Events()
+	merged, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventEntitiesMerged{EntityType: types.ENTITY_TYPE_TEAM, DuplicateId: 7, CanonicalId: 4, Authority: authorityStr, Reason: "duplicate"}, merged)
+	_, err = ms.MergeEntities(ctx, &types.MsgMergeEntities{Authority: authorityStr, EntityType: types.ENTITY_TYPE_TEAM, DuplicateId: 4, CanonicalId: 7})
+	require.ErrorIs(t, err, types.ErrInvalidIDMapping)
+
+	external, err := qs.ExternalIDs(ctx, &types.QueryExternalIDsRequest{EntityType: types.ENTITY_TYPE_TEAM, CanonicalId: 4})
+	require.NoError(t, err)
+	require.Equal(t, []types.IDMapping{
+		{EntityType: types.ENTITY_TYPE_TEAM, Provider: types.SourceProvider, ExternalId: "7", CanonicalId: 4},
+		{EntityType: types.ENTITY_TYPE_TEAM, Provider: types.SourceProvider, ExternalId: "9", CanonicalId: 4},
+		{EntityType: types.ENTITY_TYPE_TEAM, Provider: "sofascore", ExternalId: "3052", CanonicalId: 4},
+	}, external.Mappings)
+	require.Zero(t, external.MergedInto)
+
+	external, err = qs.ExternalIDs(ctx, &types.QueryExternalIDsRequest{EntityType: types.ENTITY_TYPE_TEAM, CanonicalId: 7})
+	require.NoError(t, err)
+	require.Empty(t, external.Mappings)
+	require.Equal(t, int64(4), external.MergedInto)
+
+	// the duplicate is not searched anymore, and its source id is ingested as the canonical team
+	found, err := f.keeper.SearchTeams(ctx, "fenerbahce", 0)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	require.Equal(t, 4, found[0].ID)
+
+	leagues, err = f.keeper.CanonicalizeIDs(ctx, types.SourceProvider, fetched())
+	require.NoError(t, err)
+	require.Equal(t, 4, leagues[0].Matches[1].Home.ID)
+
+	mappings, err := qs.IDMappings(ctx, &types.QueryIDMappingsRequest{EntityType: types.ENTITY_TYPE_TEAM, Provider: "sofascore"})
+	require.NoError(t, err)
+	require.Equal(t, []types.IDMapping{{EntityType: types.ENTITY_TYPE_TEAM, Provider: "sofascore", ExternalId: "3052", CanonicalId: 4}}, mappings.Mappings)
+	mappings, err = qs.IDMappings(ctx, &types.QueryIDMappingsRequest{EntityType: types.ENTITY_TYPE_MATCH})
+	require.NoError(t, err)
+	require.Len(t, mappings.Mappings, 2)
+}
+
+func TestMergeMatches(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	duplicate := setupMarketMatch(t, f, 40)
+	setupMarketMatch(t, f, 41)
+
+	alice := sdk.AccAddress("alice")
+	f.bankKeeper.balances[string(alice)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	marketID, err := f.keeper.CreateMarket(ctx, alice, int64(duplicate.ID), types.MARKET_TYPE_MATCH_RESULT, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.PlaceStake(ctx, alice, marketID, types.OutcomeHome, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	require.NoError(t, f.keeper.MapExternalID(ctx, types.ENTITY_TYPE_MATCH, types.SourceProvider, "1040", int64(duplicate.ID)))
+
+	// the markets of a merged match are refunded
+	_, err = ms.MergeEntities(ctx, &types.MsgMergeEntities{Authority: authorityStr, EntityType: types.ENTITY_TYPE_MATCH, DuplicateId: 40, CanonicalId: 41, Reason: "listed twice"})
+	require.NoError(t, err)
+
+	market, err := f.keeper.Markets.Get(ctx, marketID)
+	require.NoError(t, err)
+	require.Equal(t, types.MARKET_STATUS_REFUNDED, market.Status)
+	require.Equal(t, int64(1000), f.bankKeeper.balances[string(alice)].AmountOf(sdk.DefaultBondDenom).Int64())
+
+	id, err := f.keeper.ResolveCanonicalID(ctx, types.ENTITY_TYPE_MATCH, types.SourceProvider, "1040")
+	require.NoError(t, err)
+	require.Equal(t, int64(41), id)
+}
@@ -7,7 +7,9 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
@@ -25,6 +27,92 @@ type Keeper struct {
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 
+	// Subscriptions maps (match id, subscriber) to the callback gas limit.
+	Subscriptions collections.Map[collections.Pair[int64, []byte], uint64]
+	// SubscriptionFees maps (match id, subscriber) to the callback fee held by the module account.
+	SubscriptionFees collections.Map[collections.Pair[int64, []byte], math.Int]
+	PendingCallbacks collections.KeySet[int64]
+
+	MarketSeq    collections.Sequence
+	Markets      collections.Map[uint64, types.Market]
+	MatchMarkets collections.KeySet[collections.Pair[int64, uint64]]
+	// Stakes maps (market id, staker, outcome) to the staked amount.
+	Stakes collections.Map[collections.Triple[uint64, []byte, uint64], math.Int]
+	// UnsettledMarkets holds the open markets of finalized matches whose settlement failed.
+	UnsettledMarkets collections.KeySet[uint64]
+	// OutcomeCollateral maps a match id to the collateral locked for its outcome shares.
+	OutcomeCollateral collections.Map[int64, sdk.Coin]
+
+	// PendingFinality maps the matches in their dispute window to the height of their last result change.
+	PendingFinality collections.Map[int64, int64]
+	// FinalizedMatches maps the finalized matches to their finality height.
+	FinalizedMatches collections.Map[int64, int64]
+
+	CorrectionSeq collections.Sequence
+	Corrections   collections.Map[uint64, types.Correction]
+	// PendingCorrections indexes the pending corrections by (expiry height, correction id).
+	PendingCorrections collections.KeySet[collections.Pair[int64, uint64]]
+
+	// OracleReports maps (match id, validator) to the report of the validator on the match.
+	OracleReports          collections.Map[collections.Pair[int64, []byte], types.OracleReport]
+	ValidatorOracleReports collections.KeySet[collections.Pair[[]byte, int64]]
+	ValidatorOracleInfos   collections.Map[[]byte, types.ValidatorOracleInfo]
+	// OracleReportWindow maps (validator, index) to the report outcome at that index of the sliding window.
+	OracleReportWindow collections.Map[collections.Pair[[]byte, uint64], int32]
+	// OracleWindowStart is the height the oracle report window was enabled at. Missing reports only count
+	// for the matches whose result came in from that height.
+	OracleWindowStart collections.Item[int64]
+	// OracleWindowValidators is the set of the validators bonded when the oracle report window was enabled.
+	// Only their missing reports count.
+	OracleWindowValidators collections.KeySet[[]byte]
+	// TalliedOracleMatches is the set of the matches whose oracle reports were tallied, so a match finalized
+	// again is not tallied twice.
+	TalliedOracleMatches collections.KeySet[int64]
+
+	// OracleRewardWeights maps a validator to the stake it accrued with accurate reports in the current epoch.
+	OracleRewardWeights collections.Map[[]byte, math.Int]
+	// OracleRewards maps a validator to its claimable rewards in the bond denom.
+	OracleRewards collections.Map[[]byte, math.Int]
+
+	Reporters collections.Map[[]byte, types.Reporter]
+	// ReporterReports maps (match id, reporter) to the report of the reporter on the match.
+	ReporterReports collections.Map[collections.Pair[int64, []byte], types.MatchReport]
+	// PendingMatchReports holds the matches with new reports, aggregated in the next BeginBlock.
+	PendingMatchReports collections.KeySet[int64]
+	// UnbondingReporters indexes the unbonding reporters by (unbonding height, reporter).
+	UnbondingReporters collections.KeySet[collections.Pair[int64, []byte]]
+	// ReportCommits maps (round, match id, reporter) to the commit of the reporter on the match.
+	ReportCommits collections.Map[collections.Triple[uint64, int64, []byte], types.ReportCommit]
+	// ReporterRewardsPaid maps the current reporter reward epoch to the rewards paid in it.
+	ReporterRewardsPaid collections.Map[int64, math.Int]
+
+	// MatchAttestations maps a match id to the provider attestation of its ingested data.
+	MatchAttestations collections.Map[int64, types.MatchAttestation]
+	// MatchResults maps a match id to the encoding of its types.MatchResult record.
+	MatchResults collections.Map[uint64, []byte]
+	// IBCSubscriptions is the set of (match id, channel id) of the IBC channels subscribed to the result of a match.
+	IBCSubscriptions collections.KeySet[collections.Pair[int64, string]]
+
+	// TeamNames and LeagueNames index the teams and leagues by (name search term, id), see types.NameSearchTerms.
+	TeamNames   collections.KeySet[collections.Pair[string, int64]]
+	LeagueNames collections.KeySet[collections.Pair[string, int64]]
+
+	// IDMappings maps the (entity type, provider, external id) of an entity to its canonical id, and
+	// CanonicalIDMappings indexes them by (entity type, canonical id, provider, external id).
+	IDMappings          collections.Map[collections.Triple[int32, string, string], int64]
+	CanonicalIDMappings collections.KeySet[collections.Quad[int32, int64, string, string]]
+	// MergedIDs maps the (entity type, id) of a merged duplicate to the canonical id it was merged into.
+	MergedIDs collections.Map[collections.Pair[int32, int64], int64]
+	// CanonicalIDSeq allocates the canonical ids from 1.
+	CanonicalIDSeq collections.Sequence
+
+	bankKeeper     types.BankKeeper
+	stakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
+	erc20Keeper    types.Erc20Keeper
+	evmKeeper      types.EVMKeeper
+	channelKeeper  types.ChannelKeeper
+
 	Datasource *datasource.DatasourceFM
 	ABI        abi.ABI // base contract abi
 }
@@ -34,6 +122,12 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
+	erc20Keeper types.Erc20Keeper,
+	evmKeeper types.EVMKeeper,
+	channelKeeper types.ChannelKeeper,
 	c DatasourceConfig,
 	abi abi.ABI,
 ) Keeper {
@@ -51,6 +145,63 @@ func NewKeeper(
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 
+		Subscriptions:    collections.NewMap(sb, types.SubscriptionsKey, "subscriptions", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey), collections.Uint64Value),
+		SubscriptionFees: collections.NewMap(sb, types.SubscriptionFeesKey, "subscription_fees", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey), sdk.IntValue),
+		PendingCallbacks: collections.NewKeySet(sb, types.PendingCallbacksKey, "pending_callbacks", collections.Int64Key),
+
+		MarketSeq:    collections.NewSequence(sb, types.MarketSeqKey, "market_seq"),
+		Markets:      collections.NewMap(sb, types.MarketsKey, "markets", collections.Uint64Key, codec.CollValue[types.Market](cdc)),
+		MatchMarkets: collections.NewKeySet(sb, types.MatchMarketsKey, "match_markets", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		Stakes:       collections.NewMap(sb, types.StakesKey, "stakes", collections.TripleKeyCodec(collections.Uint64Key, collections.BytesKey, collections.Uint64Key), sdk.IntValue),
+
+		UnsettledMarkets: collections.NewKeySet(sb, types.UnsettledMarketsKey, "unsettled_markets", collections.Uint64Key),
+
+		OutcomeCollateral: collections.NewMap(sb, types.OutcomeCollateralKey, "outcome_collateral", collections.Int64Key, codec.CollValue[sdk.Coin](cdc)),
+
+		PendingFinality:  collections.NewMap(sb, types.PendingFinalityKey, "pending_finality", collections.Int64Key, collections.Int64Value),
+		FinalizedMatches: collections.NewMap(sb, types.FinalizedMatchesKey, "finalized_matches", collections.Int64Key, collections.Int64Value),
+
+		CorrectionSeq:      collections.NewSequence(sb, types.CorrectionSeqKey, "correction_seq"),
+		Corrections:        collections.NewMap(sb, types.CorrectionsKey, "corrections", collections.Uint64Key, codec.CollValue[types.Correction](cdc)),
+		PendingCorrections: collections.NewKeySet(sb, types.PendingCorrectionsKey, "pending_corrections", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+
+		OracleReports:          collections.NewMap(sb, types.OracleReportsKey, "oracle_reports", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey), codec.CollValue[types.OracleReport](cdc)),
+		ValidatorOracleReports: collections.NewKeySet(sb, types.ValidatorOracleReportsKey, "validator_oracle_reports", collections.PairKeyCodec(collections.BytesKey, collections.Int64Key)),
+		ValidatorOracleInfos:   collections.NewMap(sb, types.ValidatorOracleInfosKey, "validator_oracle_infos", collections.BytesKey, codec.CollValue[types.ValidatorOracleInfo](cdc)),
+		OracleReportWindow:     collections.NewMap(sb, types.OracleReportWindowKey, "oracle_report_window", collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key), collections.Int32Value),
+		OracleWindowStart:      collections.NewItem(sb, types.OracleWindowStartKey, "oracle_window_start", collections.Int64Value),
+		OracleWindowValidators: collections.NewKeySet(sb, types.OracleWindowValidatorsKey, "oracle_window_validators", collections.BytesKey),
+		TalliedOracleMatches:   collections.NewKeySet(sb, types.TalliedOracleMatchesKey, "tallied_oracle_matches", collections.Int64Key),
+
+		OracleRewardWeights: collections.NewMap(sb, types.OracleRewardWeightsKey, "oracle_reward_weights", collections.BytesKey, sdk.IntValue),
+		OracleRewards:       collections.NewMap(sb, types.OracleRewardsKey, "oracle_rewards", collections.BytesKey, sdk.IntValue),
+
+		Reporters:           collections.NewMap(sb, types.ReportersKey, "reporters", collections.BytesKey, codec.CollValue[types.Reporter](cdc)),
+		ReporterReports:     collections.NewMap(sb, types.ReporterReportsKey, "reporter_reports", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey), codec.CollValue[types.MatchReport](cdc)),
+		PendingMatchReports: collections.NewKeySet(sb, types.PendingMatchReportsKey, "pending_match_reports", collections.Int64Key),
+		UnbondingReporters:  collections.NewKeySet(sb, types.UnbondingReportersKey, "unbonding_reporters", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		ReportCommits:       collections.NewMap(sb, types.ReportCommitsKey, "report_commits", collections.TripleKeyCodec(collections.Uint64Key, collections.Int64Key, collections.BytesKey), codec.CollValue[types.ReportCommit](cdc)),
+		ReporterRewardsPaid: collections.NewMap(sb, types.ReporterRewardsPaidKey, "reporter_rewards_paid", collections.Int64Key, sdk.IntValue),
+
+		MatchAttestations: collections.NewMap(sb, types.MatchAttestationsKey, "match_attestations", collections.Int64Key, codec.CollValue[types.MatchAttestation](cdc)),
+		MatchResults:      collections.NewMap(sb, types.MatchResultsKey, "match_results", collections.Uint64Key, collections.BytesValue),
+		IBCSubscriptions:  collections.NewKeySet(sb, types.IBCSubscriptionsKey, "ibc_subscriptions", collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+
+		TeamNames:   collections.NewKeySet(sb, types.TeamNamesKey, "team_names", collections.PairKeyCodec(collections.StringKey, collections.Int64Key)),
+		LeagueNames: collections.NewKeySet(sb, types.LeagueNamesKey, "league_names", collections.PairKeyCodec(collections.StringKey, collections.Int64Key)),
+
+		IDMappings:          collections.NewMap(sb, types.IDMappingsKey, "id_mappings", collections.TripleKeyCodec(collections.Int32Key, collections.StringKey, collections.StringKey), collections.Int64Value),
+		CanonicalIDMappings: collections.NewKeySet(sb, types.CanonicalIDMappingsKey, "canonical_id_mappings", collections.QuadKeyCodec(collections.Int32Key, collections.Int64Key, collections.StringKey, collections.StringKey)),
+		MergedIDs:           collections.NewMap(sb, types.MergedIDsKey, "merged_ids", collections.PairKeyCodec(collections.Int32Key, collections.Int64Key), collections.Int64Value),
+		CanonicalIDSeq:      collections.NewSequence(sb, types.CanonicalIDSeqKey, "canonical_id_seq"),
+
+		bankKeeper:     bankKeeper,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+		erc20Keeper:    erc20Keeper,
+		evmKeeper:      evmKeeper,
+		channelKeeper:  channelKeeper,
+
 		Datasource: &datasource.DatasourceFM{
 			Client:  &http.Client{},
 			BaseURL: c.ApiURL,
@@ -3,16 +3,24 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/raifpy/futchain/x/futchain/keeper"
 	module "github.com/raifpy/futchain/x/futchain/module"
@@ -20,9 +28,178 @@ import (
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx            context.Context
+	keeper         keeper.Keeper
+	addressCodec   address.Codec
+	bankKeeper     *mockBankKeeper
+	stakingKeeper  *mockStakingKeeper
+	slashingKeeper *mockSlashingKeeper
+	erc20Keeper    *mockErc20Keeper
+	channelKeeper  *mockChannelKeeper
+}
+
+// mockBankKeeper keeps balances and denom metadata in memory, module accounts included.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	metadata map[string]banktypes.Metadata
+}
+
+func (b *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balances[string(addr)].AmountOf(denom))
+}
+
+func (b *mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	supply := sdk.NewCoin(denom, math.ZeroInt())
+	for addr, balance := range b.balances {
+		if addr != string(authtypes.NewModuleAddress("burned")) {
+			supply.Amount = supply.Amount.Add(balance.AmountOf(denom))
+		}
+	}
+	return supply
+}
+
+func (b *mockBankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName)
+	b.balances[string(addr)] = b.balances[string(addr)].Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(moduleName), authtypes.NewModuleAddress("burned"), amt)
+}
+
+func (b *mockBankKeeper) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := b.metadata[denom]
+	return metadata, found
+}
+
+func (b *mockBankKeeper) SetDenomMetaData(_ context.Context, metadata banktypes.Metadata) {
+	b.metadata[metadata.Base] = metadata
+}
+
+func (b *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[string(addr)]
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.balances[string(from)].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.balances[string(from)] = balance
+	b.balances[string(to)] = b.balances[string(to)].Add(amt...)
+	return nil
+}
+
+// mockErc20Keeper records the registered token pairs.
+type mockErc20Keeper struct {
+	pairs map[string]erc20types.TokenPair
+}
+
+func (e *mockErc20Keeper) IsDenomRegistered(_ sdk.Context, denom string) bool {
+	_, found := e.pairs[denom]
+	return found
+}
+
+func (e *mockErc20Keeper) SetToken(_ sdk.Context, pair erc20types.TokenPair) error {
+	e.pairs[pair.Denom] = pair
+	return nil
+}
+
+func (e *mockErc20Keeper) EnableDynamicPrecompile(sdk.Context, common.Address) error {
+	return nil
+}
+
+func (e *mockErc20Keeper) DeleteTokenPair(_ sdk.Context, pair erc20types.TokenPair) {
+	delete(e.pairs, pair.Denom)
+}
+
+func (e *mockErc20Keeper) DeleteDynamicPrecompile(sdk.Context, common.Address) {}
+
+func (e *mockErc20Keeper) UnRegisterERC20CodeHash(sdk.Context, common.Address) error {
+	return nil
+}
+
+type mockStakingKeeper struct {
+	validators []stakingtypes.Validator
+}
+
+func (mockStakingKeeper) BondDenom(context.Context) (string, error) {
+	return sdk.DefaultBondDenom, nil
+}
+
+func (mockStakingKeeper) ValidatorAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+}
+
+func (s *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	for _, validator := range s.validators {
+		if validator.OperatorAddress == addr.String() {
+			return validator, nil
+		}
+	}
+	return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+}
+
+func (s *mockStakingKeeper) GetBondedValidatorsByPower(context.Context) ([]stakingtypes.Validator, error) {
+	var bonded []stakingtypes.Validator
+	for _, validator := range s.validators {
+		if validator.IsBonded() {
+			bonded = append(bonded, validator)
+		}
+	}
+	return bonded, nil
+}
+
+func (mockStakingKeeper) PowerReduction(context.Context) math.Int {
+	return sdk.DefaultPowerReduction
+}
+
+type mockSlashingKeeper struct {
+	slashed map[string]math.LegacyDec
+	jailed  map[string]time.Time
+}
+
+func (s *mockSlashingKeeper) Slash(_ context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, _, _ int64) error {
+	s.slashed[consAddr.String()] = fraction
+	return nil
+}
+
+func (s *mockSlashingKeeper) Jail(_ context.Context, consAddr sdk.ConsAddress) error {
+	s.jailed[consAddr.String()] = time.Time{}
+	return nil
+}
+
+func (s *mockSlashingKeeper) JailUntil(_ context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error {
+	s.jailed[consAddr.String()] = jailTime
+	return nil
+}
+
+// sentPacket is a packet sent through the mockChannelKeeper.
+type sentPacket struct {
+	channel string
+	data    []byte
+}
+
+type mockChannelKeeper struct {
+	sent []sentPacket
+}
+
+func (c *mockChannelKeeper) SendPacket(_ sdk.Context, _ string, sourceChannel string, _ clienttypes.Height, _ uint64, data []byte) (uint64, error) {
+	c.sent = append(c.sent, sentPacket{channel: sourceChannel, data: data})
+	return uint64(len(c.sent)), nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -36,24 +213,43 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := &mockBankKeeper{balances: map[string]sdk.Coins{}, metadata: map[string]banktypes.Metadata{}}
+	erc20Keeper := &mockErc20Keeper{pairs: map[string]erc20types.TokenPair{}}
+	stakingKeeper := &mockStakingKeeper{}
+	slashingKeeper := &mockSlashingKeeper{slashed: map[string]math.LegacyDec{}, jailed: map[string]time.Time{}}
+	channelKeeper := &mockChannelKeeper{}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		bankKeeper,
+		stakingKeeper,
+		slashingKeeper,
+		erc20Keeper,
+		nil,
+		channelKeeper,
 		keeper.DatasourceConfig{},
 		abi.ABI{},
 	)
 
-	// Initialize params
-	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
+	// Initialize params. The tests fund their accounts with small amounts, the market creation
+	// deposit is covered by TestMarketSettlement.
+	params := types.DefaultParams()
+	params.MarketCreationDeposit = math.ZeroInt()
+	if err := k.Params.Set(ctx, params); err != nil {
 		t.Fatalf("failed to set params: %v", err)
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:            ctx,
+		keeper:         k,
+		addressCodec:   addressCodec,
+		bankKeeper:     bankKeeper,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+		erc20Keeper:    erc20Keeper,
+		channelKeeper:  channelKeeper,
 	}
 }
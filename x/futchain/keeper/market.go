@@ -0,0 +1,378 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// CreateMarket opens a prediction market on a match that has not started yet, taking the market
+// creation deposit from the creator. Stakes of the market are placed in the bond denom.
+func (k *Keeper) CreateMarket(ctx context.Context, creator sdk.AccAddress, matchID int64, marketType types.MarketType, line uint64) (uint64, error) {
+	if marketType == types.MARKET_TYPE_UNSPECIFIED {
+		return 0, errorsmod.Wrap(types.ErrInvalidMarket, "market type is required")
+	}
+	if _, ok := types.MarketType_name[int32(marketType)]; !ok {
+		return 0, errorsmod.Wrapf(types.ErrInvalidMarket, "unknown market type %d", marketType)
+	}
+	if marketType == types.MARKET_TYPE_OVER_UNDER {
+		if err := types.ValidateOverUnderLine(line); err != nil {
+			return 0, err
+		}
+	}
+
+	match, err := k.GetMatch(ctx, int(matchID))
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidMarket, "match %d: %s", matchID, err)
+	}
+	if isMatchClosed(ctx, match) {
+		return 0, errorsmod.Wrapf(types.ErrMarketClosed, "match %d has already started", matchID)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	markets := uint32(0)
+	if err := k.MatchMarkets.Walk(ctx, collections.NewPrefixedPairRange[int64, uint64](matchID), func(collections.Pair[int64, uint64]) (bool, error) {
+		markets++
+		return false, nil
+	}); err != nil {
+		return 0, err
+	}
+	if markets >= params.MaxMarketsPerMatch {
+		return 0, errorsmod.Wrapf(types.ErrInvalidMarket, "match %d already has %d markets", matchID, markets)
+	}
+
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return 0, err
+	}
+	deposit := sdk.NewCoin(denom, math.ZeroInt())
+	if !params.MarketCreationDeposit.IsNil() && params.MarketCreationDeposit.IsPositive() {
+		deposit.Amount = params.MarketCreationDeposit
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(deposit)); err != nil {
+			return 0, err
+		}
+	}
+
+	creatorStr, err := k.addressCodec.BytesToString(creator)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := k.MarketSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	market := types.Market{
+		Id:          id,
+		MatchId:     matchID,
+		MarketType:  marketType,
+		Line:        line,
+		Creator:     creatorStr,
+		Status:      types.MARKET_STATUS_OPEN,
+		TotalStaked: sdk.NewCoin(denom, math.ZeroInt()),
+		Deposit:     deposit,
+	}
+	if err := k.Markets.Set(ctx, id, market); err != nil {
+		return 0, err
+	}
+	if err := k.MatchMarkets.Set(ctx, collections.Join(matchID, id)); err != nil {
+		return 0, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("market_created",
+		sdk.NewAttribute("id", strconv.FormatUint(id, 10)),
+		sdk.NewAttribute("match_id", strconv.FormatInt(matchID, 10)),
+		sdk.NewAttribute("market_type", marketType.String()),
+		sdk.NewAttribute("creator", creatorStr),
+		sdk.NewAttribute("deposit", deposit.String()),
+	))
+
+	return id, nil
+}
+
+// PlaceStake moves the amount from the staker to the module account and adds it to the
+// staker's position on the outcome. Markets stop accepting stakes at the kickoff of the match.
+func (k *Keeper) PlaceStake(ctx context.Context, staker sdk.AccAddress, marketID, outcome uint64, amount sdk.Coin) error {
+	market, err := k.Markets.Get(ctx, marketID)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidMarket, "market %d: %s", marketID, err)
+	}
+	if market.Status != types.MARKET_STATUS_OPEN {
+		return errorsmod.Wrapf(types.ErrMarketClosed, "market %d is %s", marketID, market.Status)
+	}
+
+	match, err := k.GetMatch(ctx, int(market.MatchId))
+	if err != nil {
+		return err
+	}
+	if isMatchClosed(ctx, match) {
+		return errorsmod.Wrapf(types.ErrMarketClosed, "match %d has already started", market.MatchId)
+	}
+
+	if err := market.ValidateOutcome(outcome); err != nil {
+		return err
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidStake, "invalid amount %s", amount)
+	}
+	if amount.Denom != market.TotalStaked.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidStake, "expected %s denom, got %s", market.TotalStaked.Denom, amount.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, staker, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	key := collections.Join3(marketID, staker.Bytes(), outcome)
+	staked, err := k.Stakes.Get(ctx, key)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	if staked.IsNil() {
+		staked = math.ZeroInt()
+	}
+	if err := k.Stakes.Set(ctx, key, staked.Add(amount.Amount)); err != nil {
+		return err
+	}
+
+	market.TotalStaked = market.TotalStaked.Add(amount)
+	if err := k.Markets.Set(ctx, marketID, market); err != nil {
+		return err
+	}
+
+	stakerStr, err := k.addressCodec.BytesToString(staker)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("stake_placed",
+		sdk.NewAttribute("market_id", strconv.FormatUint(marketID, 10)),
+		sdk.NewAttribute("staker", stakerStr),
+		sdk.NewAttribute("outcome", strconv.FormatUint(outcome, 10)),
+		sdk.NewAttribute("amount", amount.String()),
+	))
+
+	return nil
+}
+
+// SettleMatchMarkets settles every open market of a finalized match, or refunds them if the
+// match was cancelled. Each market is settled in its own cached context so a failing market
+// does not prevent the others from being settled; it is refunded instead, or queued for
+// RetryMarketSettlements if that fails too.
+func (k *Keeper) SettleMatchMarkets(ctx sdk.Context, matchID int) error {
+	match, err := k.GetMatch(ctx, matchID)
+	if err != nil {
+		return err
+	}
+
+	iterator, err := k.MatchMarkets.Iterate(ctx, collections.NewPrefixedPairRange[int64, uint64](int64(matchID)))
+	if err != nil {
+		return err
+	}
+	keys, err := iterator.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		market, err := k.Markets.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if market.Status != types.MARKET_STATUS_OPEN {
+			continue
+		}
+
+		if !k.trySettleMarket(ctx, market, match) {
+			if err := k.UnsettledMarkets.Set(ctx, market.Id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// maxMarketSettlementRetries is the number of unsettled markets RetryMarketSettlements retries
+// in a block.
+const maxMarketSettlementRetries = 20
+
+// RetryMarketSettlements retries the settlement of the markets whose settlement failed, in
+// EndBlock. Markets of matches that are no longer finalized are left to their next
+// finalization.
+func (k *Keeper) RetryMarketSettlements(ctx sdk.Context) error {
+	iterator, err := k.UnsettledMarkets.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	var marketIDs []uint64
+	for ; iterator.Valid() && len(marketIDs) < maxMarketSettlementRetries; iterator.Next() {
+		marketID, err := iterator.Key()
+		if err != nil {
+			return err
+		}
+		marketIDs = append(marketIDs, marketID)
+	}
+
+	for _, marketID := range marketIDs {
+		market, err := k.Markets.Get(ctx, marketID)
+		if err != nil {
+			return err
+		}
+		finalized, err := k.IsMatchFinalized(ctx, market.MatchId)
+		if err != nil {
+			return err
+		}
+		if market.Status != types.MARKET_STATUS_OPEN || !finalized {
+			if err := k.UnsettledMarkets.Remove(ctx, marketID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		match, err := k.GetMatch(ctx, int(market.MatchId))
+		if err != nil {
+			return err
+		}
+		if k.trySettleMarket(ctx, market, match) {
+			if err := k.UnsettledMarkets.Remove(ctx, marketID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// trySettleMarket settles a market, or refunds it if the settlement fails, each in a cached
+// context. It reports whether either succeeded.
+func (k *Keeper) trySettleMarket(ctx sdk.Context, market types.Market, match *datasource.Match) bool {
+	for _, refund := range []bool{false, true} {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.settleMarket(cacheCtx, market, match, refund); err != nil {
+			ctx.Logger().Error("failed to settle market", "error", err, "market", market.Id, "match", match.ID, "refund", refund)
+			continue
+		}
+		write()
+		return true
+	}
+	return false
+}
+
+// settleMarket pays the pool of the market out to the stakers of the winning outcome, pro rata.
+// The rounding remainder goes to the last winner. Every stake is refunded if refund is set, the
+// match was cancelled or nobody staked on the winning outcome. The creation deposit is returned
+// to the creator either way.
+func (k *Keeper) settleMarket(ctx sdk.Context, market types.Market, match *datasource.Match, refund bool) error {
+	iterator, err := k.Stakes.Iterate(ctx, collections.NewPrefixedTripleRange[uint64, []byte, uint64](market.Id))
+	if err != nil {
+		return err
+	}
+	stakes, err := iterator.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	var winning uint64
+	refund = refund || match.Status.Cancelled
+	if !refund {
+		winning, err = market.Outcome(uint64(match.Home.Score), uint64(match.Away.Score))
+		if err != nil {
+			return err
+		}
+	}
+
+	winningTotal := math.ZeroInt()
+	for _, stake := range stakes {
+		if stake.Key.K3() == winning {
+			winningTotal = winningTotal.Add(stake.Value)
+		}
+	}
+	if winningTotal.IsZero() {
+		refund = true
+	}
+
+	denom := market.TotalStaked.Denom
+	if refund {
+		for _, stake := range stakes {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, stake.Key.K2(), sdk.NewCoins(sdk.NewCoin(denom, stake.Value))); err != nil {
+				return err
+			}
+		}
+		market.Status = types.MARKET_STATUS_REFUNDED
+	} else {
+		last := -1
+		for i, stake := range stakes {
+			if stake.Key.K3() == winning {
+				last = i
+			}
+		}
+
+		total, paid := market.TotalStaked.Amount, math.ZeroInt()
+		for i, stake := range stakes {
+			if stake.Key.K3() != winning {
+				continue
+			}
+			payout := stake.Value.Mul(total).Quo(winningTotal)
+			if i == last {
+				payout = total.Sub(paid)
+			}
+			paid = paid.Add(payout)
+			if !payout.IsPositive() {
+				continue
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, stake.Key.K2(), sdk.NewCoins(sdk.NewCoin(denom, payout))); err != nil {
+				return err
+			}
+		}
+		market.Status = types.MARKET_STATUS_SETTLED
+		market.WinningOutcome = winning
+	}
+
+	if !market.Deposit.IsNil() && market.Deposit.IsPositive() {
+		creator, err := k.addressCodec.StringToBytes(market.Creator)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(market.Deposit)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.Markets.Set(ctx, market.Id, market); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("market_settled",
+		sdk.NewAttribute("id", strconv.FormatUint(market.Id, 10)),
+		sdk.NewAttribute("match_id", strconv.FormatInt(market.MatchId, 10)),
+		sdk.NewAttribute("status", market.Status.String()),
+		sdk.NewAttribute("winning_outcome", strconv.FormatUint(market.WinningOutcome, 10)),
+	))
+
+	return nil
+}
+
+// isMatchClosed reports whether a match no longer accepts new markets or stakes. A match closes
+// at its kickoff time by the block time, so the stakes do not depend on the data source
+// reporting the start in time.
+func isMatchClosed(ctx context.Context, match *datasource.Match) bool {
+	if match.Status.Started || match.Status.Finished || match.Status.Cancelled {
+		return true
+	}
+	kickoff := match.Status.UtcTime
+	return !kickoff.IsZero() && !sdk.UnwrapSDKContext(ctx).BlockTime().Before(kickoff)
+}
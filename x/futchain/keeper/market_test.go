@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func setupMarketMatch(t *testing.T, f *fixture, id int) datasource.Match {
+	t.Helper()
+
+	home := datasource.Team{ID: 1, Name: "Home"}
+	away := datasource.Team{ID: 2, Name: "Away"}
+	for _, team := range []datasource.Team{home, away} {
+		_, err := f.keeper.SaveTeamIfNotExists(f.ctx, team)
+		require.NoError(t, err)
+	}
+	match := datasource.Match{ID: id, LeagueID: 1, Home: home, Away: away}
+	require.NoError(t, f.keeper.SetMatch(f.ctx, match))
+	return match
+}
+
+func TestMarketSettlement(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	match := setupMarketMatch(t, f, 10)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MarketCreationDeposit, params.MaxMarketsPerMatch = math.NewInt(10), 2
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	alice, bob, carol := sdk.AccAddress("alice"), sdk.AccAddress("bob"), sdk.AccAddress("carol")
+	aliceStr, err := f.addressCodec.BytesToString(alice)
+	require.NoError(t, err)
+	for _, addr := range []sdk.AccAddress{alice, bob, carol} {
+		f.bankKeeper.balances[string(addr)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	}
+
+	_, err = ms.CreateMarket(ctx, &types.MsgCreateMarket{Creator: aliceStr, MatchId: int64(match.ID)})
+	require.ErrorIs(t, err, types.ErrInvalidMarket)
+	_, err = ms.CreateMarket(ctx, &types.MsgCreateMarket{Creator: aliceStr, MatchId: 99, MarketType: types.MARKET_TYPE_MATCH_RESULT})
+	require.ErrorIs(t, err, types.ErrInvalidMarket)
+
+	res, err := ms.CreateMarket(ctx, &types.MsgCreateMarket{Creator: aliceStr, MatchId: int64(match.ID), MarketType: types.MARKET_TYPE_MATCH_RESULT})
+	require.NoError(t, err)
+	resultMarket := res.MarketId
+
+	// over/under lines are half goal lines, so a total never pushes
+	_, err = ms.CreateMarket(ctx, &types.MsgCreateMarket{Creator: aliceStr, MatchId: int64(match.ID), MarketType: types.MARKET_TYPE_OVER_UNDER, Line: 2})
+	require.ErrorIs(t, err, types.ErrInvalidMarket)
+	res, err = ms.CreateMarket(ctx, &types.MsgCreateMarket{Creator: aliceStr, MatchId: int64(match.ID), MarketType: types.MARKET_TYPE_OVER_UNDER, Line: 3})
+	require.NoError(t, err)
+	overUnderMarket := res.MarketId
+
+	// the creator deposits for each market, and a match has a limited number of markets
+	_, err = ms.CreateMarket(ctx, &types.MsgCreateMarket{Creator: aliceStr, MatchId: int64(match.ID), MarketType: types.MARKET_TYPE_CORRECT_SCORE})
+	require.ErrorIs(t, err, types.ErrInvalidMarket)
+	require.Equal(t, int64(1000-20), f.bankKeeper.balances[string(alice)].AmountOf(sdk.DefaultBondDenom).Int64())
+
+	stake := func(staker sdk.AccAddress, marketID, outcome uint64, amount int64) error {
+		return f.keeper.PlaceStake(ctx, staker, marketID, outcome, sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	require.ErrorIs(t, stake(alice, resultMarket, 3, 100), types.ErrInvalidStake)
+	require.ErrorIs(t, f.keeper.PlaceStake(ctx, alice, resultMarket, types.OutcomeHome, sdk.NewInt64Coin("other", 100)), types.ErrInvalidStake)
+
+	require.NoError(t, stake(alice, resultMarket, types.OutcomeHome, 100))
+	require.NoError(t, stake(bob, resultMarket, types.OutcomeHome, 200))
+	require.NoError(t, stake(carol, resultMarket, types.OutcomeAway, 301))
+	require.NoError(t, stake(alice, overUnderMarket, types.OutcomeOver, 50))
+
+	market, err := qs.Market(ctx, &types.QueryMarketRequest{Id: resultMarket})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(601), market.Market.TotalStaked.Amount)
+
+	stakes, err := qs.MarketStakes(ctx, &types.QueryMarketStakesRequest{MarketId: resultMarket})
+	require.NoError(t, err)
+	require.Len(t, stakes.Stakes, 3)
+
+	markets, err := qs.MatchMarkets(ctx, &types.QueryMatchMarketsRequest{MatchId: int64(match.ID)})
+	require.NoError(t, err)
+	require.Len(t, markets.Markets, 2)
+
+	// stakes are closed at the kickoff, even before the match is reported started
+	match.Status.UtcTime = time.Unix(1000, 0)
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+	require.NoError(t, f.keeper.PlaceStake(ctx.WithBlockTime(time.Unix(999, 0)), alice, overUnderMarket, types.OutcomeOver, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	require.ErrorIs(t, stake(alice, resultMarket, types.OutcomeHome, 100), types.ErrMarketClosed)
+	match.Status.Started = true
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+
+	match.Home.Score, match.Away.Score = 1, 0
+	match.Status.Finished = true
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+	require.NoError(t, f.keeper.SettleMatchMarkets(ctx, match.ID))
+
+	// home won: 601 is split 1:2 between alice and bob, the remainder goes to the last winner.
+	// nobody staked on under, so alice's over/under stakes are refunded. alice's deposits are
+	// returned.
+	balance := func(addr sdk.AccAddress) int64 {
+		return f.bankKeeper.balances[string(addr)].AmountOf(sdk.DefaultBondDenom).Int64()
+	}
+	require.Equal(t, int64(1000-100+201), balance(alice))
+	require.Equal(t, int64(1000-200+400), balance(bob))
+	require.Equal(t, int64(1000-301), balance(carol))
+
+	settled, err := f.keeper.Markets.Get(ctx, resultMarket)
+	require.NoError(t, err)
+	require.Equal(t, types.MARKET_STATUS_SETTLED, settled.Status)
+	require.Equal(t, types.OutcomeHome, settled.WinningOutcome)
+
+	refunded, err := f.keeper.Markets.Get(ctx, overUnderMarket)
+	require.NoError(t, err)
+	require.Equal(t, types.MARKET_STATUS_REFUNDED, refunded.Status)
+
+	// the 1.5 goals line: one goal is under, two are over
+	outcome, err := refunded.Outcome(1, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.OutcomeUnder, outcome)
+	outcome, err = refunded.Outcome(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.OutcomeOver, outcome)
+}
+
+func TestMarketCancelledMatch(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	match := setupMarketMatch(t, f, 11)
+	alice := sdk.AccAddress("alice")
+	f.bankKeeper.balances[string(alice)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	id, err := f.keeper.CreateMarket(ctx, alice, int64(match.ID), types.MARKET_TYPE_CORRECT_SCORE, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.PlaceStake(ctx, alice, id, types.CorrectScoreOutcome(2, 1), sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)))
+	require.Equal(t, int64(600), f.bankKeeper.balances[string(alice)].AmountOf(sdk.DefaultBondDenom).Int64())
+
+	match.Home.Score, match.Away.Score = 2, 1
+	match.Status.Cancelled = true
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+	require.NoError(t, f.keeper.SettleMatchMarkets(ctx, match.ID))
+
+	market, err := f.keeper.Markets.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.MARKET_STATUS_REFUNDED, market.Status)
+	require.Equal(t, int64(1000), f.bankKeeper.balances[string(alice)].AmountOf(sdk.DefaultBondDenom).Int64())
+
+	require.ErrorIs(t, f.keeper.PlaceStake(ctx, alice, id, 0, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), types.ErrMarketClosed)
+}
+
+func TestMarketSettlementRetry(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	match := setupMarketMatch(t, f, 12)
+	alice := sdk.AccAddress("alice")
+	f.bankKeeper.balances[string(alice)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	id, err := f.keeper.CreateMarket(ctx, alice, int64(match.ID), types.MARKET_TYPE_MATCH_RESULT, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.PlaceStake(ctx, alice, id, types.OutcomeHome, sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)))
+
+	match.Home.Score, match.Status.Started, match.Status.Finished = 1, true, true
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+	require.NoError(t, f.keeper.FinalizedMatches.Set(ctx, int64(match.ID), ctx.BlockHeight()))
+
+	// neither the payout nor the refund can be paid, the market is queued
+	moduleAddr := string(authtypes.NewModuleAddress(types.ModuleName))
+	pool := f.bankKeeper.balances[moduleAddr]
+	f.bankKeeper.balances[moduleAddr] = sdk.NewCoins()
+	require.NoError(t, f.keeper.SettleMatchMarkets(ctx, match.ID))
+	market, err := f.keeper.Markets.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.MARKET_STATUS_OPEN, market.Status)
+	queued, err := f.keeper.UnsettledMarkets.Has(ctx, id)
+	require.NoError(t, err)
+	require.True(t, queued)
+
+	require.NoError(t, f.keeper.RetryMarketSettlements(ctx))
+	queued, err = f.keeper.UnsettledMarkets.Has(ctx, id)
+	require.NoError(t, err)
+	require.True(t, queued)
+
+	f.bankKeeper.balances[moduleAddr] = pool
+	require.NoError(t, f.keeper.RetryMarketSettlements(ctx))
+	market, err = f.keeper.Markets.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.MARKET_STATUS_SETTLED, market.Status)
+	require.Equal(t, int64(1000), f.bankKeeper.balances[string(alice)].AmountOf(sdk.DefaultBondDenom).Int64())
+	queued, err = f.keeper.UnsettledMarkets.Has(ctx, id)
+	require.NoError(t, err)
+	require.False(t, queued)
+}
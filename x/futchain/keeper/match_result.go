@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// setMatchResult stores the result record of a match, so that it can be proven on other chains.
+func (k *Keeper) setMatchResult(ctx context.Context, match datasource.Match) error {
+	finalized, err := k.IsMatchFinalized(ctx, int64(match.ID))
+	if err != nil {
+		return err
+	}
+
+	result := types.MatchResult{
+		MatchID:   uint64(match.ID),
+		HomeScore: uint32(match.Home.Score),
+		AwayScore: uint32(match.Away.Score),
+		Started:   match.Status.Started,
+		Finished:  match.Status.Finished,
+		Cancelled: match.Status.Cancelled,
+		Finalized: finalized,
+	}
+	return k.MatchResults.Set(ctx, result.MatchID, result.Bytes())
+}
+
+// GetMatchResult returns the result record of a match.
+func (k *Keeper) GetMatchResult(ctx context.Context, matchID uint64) (types.MatchResult, error) {
+	bz, err := k.MatchResults.Get(ctx, matchID)
+	if err != nil {
+		return types.MatchResult{}, err
+	}
+	return types.ParseMatchResult(bz)
+}
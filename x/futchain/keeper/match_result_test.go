@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestMatchResults(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	match := setupMarketMatch(t, f, 90)
+	result, err := f.keeper.GetMatchResult(ctx, 90)
+	require.NoError(t, err)
+	require.Equal(t, types.MatchResult{MatchID: 90}, result)
+
+	match.Home.Score, match.Status.Started = 2, true
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+	result, err = f.keeper.GetMatchResult(ctx, 90)
+	require.NoError(t, err)
+	require.Equal(t, types.MatchResult{MatchID: 90, HomeScore: 2, Started: true}, result)
+
+	bz, err := f.keeper.MatchResults.Get(ctx, 90)
+	require.NoError(t, err)
+	require.Equal(t, result.Bytes(), bz)
+
+	require.NoError(t, f.keeper.OverrideMatch(ctx, 90, 2, 1, true, true, false))
+	result, err = f.keeper.GetMatchResult(ctx, 90)
+	require.NoError(t, err)
+	require.Equal(t, types.MatchResult{MatchID: 90, HomeScore: 2, AwayScore: 1, Started: true, Finished: true, Finalized: true}, result)
+
+	_, err = types.ParseMatchResult(bz[1:])
+	require.Error(t, err)
+
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	res, err := qs.MatchResultRecord(ctx, &types.QueryMatchResultRecordRequest{MatchId: 90})
+	require.NoError(t, err)
+	require.Equal(t, types.MatchResultKey(90), res.Key)
+	require.Equal(t, result.Bytes(), res.Value)
+	require.Equal(t, "/store/futchain/key", res.ProofPath)
+	require.True(t, res.Finalized)
+
+	_, err = qs.MatchResultRecord(ctx, &types.QueryMatchResultRecordRequest{MatchId: 91})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
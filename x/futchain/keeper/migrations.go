@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource/flatbuffers"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// Migrator migrates the store of the module between its consensus versions.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a Migrator of the store of a keeper.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params introduced since version 1 to their defaults, maps the source ids of the
+// stored teams, leagues and matches to themselves, as they were ingested with them, and builds the name
+// search index of the stored teams and leagues, and the result records of the stored matches, for the
+// items ingested before they were introduced.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	k := m.keeper
+
+	if err := migrateParams1to2(ctx, k); err != nil {
+		return err
+	}
+
+	teams, err := storedItems(k.datasourceStore(ctx, TeamKey))
+	if err != nil {
+		return err
+	}
+	for _, value := range teams {
+		team, err := flatbuffers.NewTeamEncoder().DecodeFromBinary(value)
+		if err != nil {
+			return err
+		}
+		if err := k.adoptSourceID(ctx, types.ENTITY_TYPE_TEAM, int64(team.ID)); err != nil {
+			return err
+		}
+		// merged duplicates are left out of the search
+		if merged, err := k.MergedIDs.Has(ctx, collections.Join(int32(types.ENTITY_TYPE_TEAM), int64(team.ID))); err != nil {
+			return err
+		} else if merged {
+			continue
+		}
+		if err := indexNames(ctx, k.TeamNames, int64(team.ID), nil, teamNames(team)); err != nil {
+			return err
+		}
+	}
+
+	leagues, err := storedItems(k.datasourceStore(ctx, LeagueKey))
+	if err != nil {
+		return err
+	}
+	for _, value := range leagues {
+		league, err := flatbuffers.NewLeagueEncoder().DecodeFromBinary(value)
+		if err != nil {
+			return err
+		}
+		if err := k.adoptSourceID(ctx, types.ENTITY_TYPE_LEAGUE, int64(league.ID)); err != nil {
+			return err
+		}
+		if merged, err := k.MergedIDs.Has(ctx, collections.Join(int32(types.ENTITY_TYPE_LEAGUE), int64(league.ID))); err != nil {
+			return err
+		} else if merged {
+			continue
+		}
+		if err := indexNames(ctx, k.LeagueNames, int64(league.ID), nil, leagueNames(league)); err != nil {
+			return err
+		}
+	}
+
+	matches, err := storedItems(k.datasourceStore(ctx, MatchKey))
+	if err != nil {
+		return err
+	}
+	for _, value := range matches {
+		match, err := flatbuffers.NewMatchEncoder().DecodeFromBinary(value)
+		if err != nil {
+			return err
+		}
+		if err := k.adoptSourceID(ctx, types.ENTITY_TYPE_MATCH, int64(match.ID)); err != nil {
+			return err
+		}
+		if err := k.setMatchResult(ctx, *match); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// migrateParams1to2 keeps the fetch settings of the version 1 params, the only params it has, and sets the
+// others to their defaults. They decode as zero or nil values, which disable or break the features using
+// them.
+func migrateParams1to2(ctx sdk.Context, k *Keeper) error {
+	v1, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params := types.DefaultParams()
+	params.Timezone = v1.Timezone
+	if v1.FetchModulo > 0 {
+		params.FetchModulo = v1.FetchModulo
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.Params.Set(ctx, params)
+}
+
+// adoptSourceID maps the source id of an entity ingested before the id mappings to its id, unless the entity
+// is mapped already, so the next ingestions keep updating it.
+func (k *Keeper) adoptSourceID(ctx sdk.Context, entityType types.EntityType, id int64) error {
+	if mappings, err := k.externalIDs(ctx, entityType, id); err != nil || len(mappings) > 0 {
+		return err
+	}
+	externalID := strconv.FormatInt(id, 10)
+	if mapped, err := k.IDMappings.Has(ctx, collections.Join3(int32(entityType), types.SourceProvider, externalID)); err != nil || mapped {
+		return err
+	}
+	return k.setIDMapping(ctx, entityType, types.SourceProvider, externalID, id)
+}
+
+// storedItems returns the values of the ingested items of a datasource store. They are read before the
+// migration writes to the store.
+func storedItems(store storetypes.KVStore) ([][]byte, error) {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var values [][]byte
+	for ; iter.Valid(); iter.Next() {
+		// the unfinished match index shares the match prefix
+		if len(iter.Key()) != 8 {
+			continue
+		}
+		values = append(values, iter.Value())
+	}
+	return values, nil
+}
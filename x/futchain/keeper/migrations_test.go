@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := f.keeper.SaveTeamIfNotExists(ctx, datasource.Team{ID: 1, Name: "Fenerbahçe", LongName: "Fenerbahçe SK"})
+	require.NoError(t, err)
+	_, err = f.keeper.SaveLeagueIfNotExists(ctx, datasource.League{ID: 71, Name: "Süper Lig"})
+	require.NoError(t, err)
+	match := setupMarketMatch(t, f, 90)
+	match.Home.Score, match.Status.Started = 1, true
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+	require.NoError(t, f.keeper.SaveUnfinishedMatch(ctx, match))
+
+	// the state of version 1 has only the fetch params, no search index nor result records
+	require.NoError(t, f.keeper.Params.Set(ctx, types.Params{Timezone: "UTC", FetchModulo: 3}))
+	require.NoError(t, f.keeper.TeamNames.Clear(ctx, nil))
+	require.NoError(t, f.keeper.LeagueNames.Clear(ctx, nil))
+	require.NoError(t, f.keeper.MatchResults.Clear(ctx, nil))
+
+	require.NoError(t, keeper.NewMigrator(&f.keeper).Migrate1to2(ctx))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.NewParams("UTC", 3), params)
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.DefaultMaxCallbackGasLimit, params.MaxCallbackGasLimit)
+	require.Equal(t, types.DefaultFinalityBlocks, params.FinalityBlocks)
+	require.False(t, params.ReporterQuorum.IsNil())
+
+	teams, err := qs.SearchTeams(ctx, &types.QuerySearchTeamsRequest{Query: "fener"})
+	require.NoError(t, err)
+	require.Len(t, teams.Teams, 1)
+	require.Equal(t, int64(1), teams.Teams[0].Id)
+
+	leagues, err := qs.SearchLeagues(ctx, &types.QuerySearchLeaguesRequest{Query: "super"})
+	require.NoError(t, err)
+	require.Len(t, leagues.Leagues, 1)
+	require.Equal(t, int64(71), leagues.Leagues[0].Id)
+
+	result, err := f.keeper.GetMatchResult(ctx, 90)
+	require.NoError(t, err)
+	require.Equal(t, types.MatchResult{MatchID: 90, HomeScore: 1, Started: true}, result)
+
+	// the source keeps the ids of the stored entities, and the allocated ids skip them
+	for _, stored := range []struct {
+		entityType types.EntityType
+		id         int64
+	}{{types.ENTITY_TYPE_TEAM, 1}, {types.ENTITY_TYPE_TEAM, 2}, {types.ENTITY_TYPE_LEAGUE, 71}, {types.ENTITY_TYPE_MATCH, 90}} {
+		id, err := f.keeper.CanonicalID(ctx, stored.entityType, types.SourceProvider, strconv.FormatInt(stored.id, 10))
+		require.NoError(t, err)
+		require.Equal(t, stored.id, id)
+	}
+	id, err := f.keeper.ResolveCanonicalID(ctx, types.ENTITY_TYPE_TEAM, types.SourceProvider, "5")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), id)
+	require.NoError(t, keeper.NewMigrator(&f.keeper).Migrate1to2(ctx))
+}
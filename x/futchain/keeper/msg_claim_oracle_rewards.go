@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func (k msgServer) ClaimOracleRewards(ctx context.Context, req *types.MsgClaimOracleRewards) (*types.MsgClaimOracleRewardsResponse, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid validator address")
+	}
+
+	amount, err := k.Keeper.ClaimOracleRewards(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimOracleRewardsResponse{Amount: amount}, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func (k msgServer) ProposeCorrection(ctx context.Context, req *types.MsgProposeCorrection) (*types.MsgProposeCorrectionResponse, error) {
+	proposer, err := k.addressCodec.StringToBytes(req.Proposer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid proposer address")
+	}
+
+	id, err := k.Keeper.ProposeCorrection(sdk.UnwrapSDKContext(ctx), proposer, req.Msg, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeCorrectionResponse{CorrectionId: id}, nil
+}
+
+func (k msgServer) VoteCorrection(ctx context.Context, req *types.MsgVoteCorrection) (*types.MsgVoteCorrectionResponse, error) {
+	voter, err := k.addressCodec.StringToBytes(req.Voter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid voter address")
+	}
+
+	status, err := k.Keeper.VoteCorrection(sdk.UnwrapSDKContext(ctx), voter, req.CorrectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVoteCorrectionResponse{Status: status}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func (k msgServer) CreateMarket(ctx context.Context, req *types.MsgCreateMarket) (*types.MsgCreateMarketResponse, error) {
+	creator, err := k.addressCodec.StringToBytes(req.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	id, err := k.Keeper.CreateMarket(ctx, creator, req.MatchId, req.MarketType, req.Line)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateMarketResponse{MarketId: id}, nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func (k msgServer) OverrideMatch(ctx context.Context, req *types.MsgOverrideMatch) (*types.MsgOverrideMatchResponse, error) {
+	if err := k.checkDataAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.Keeper.OverrideMatch(sdkCtx, req.MatchId, int(req.HomeScore), int(req.AwayScore), req.Started, req.Finished, req.Cancelled); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("match_overridden",
+		sdk.NewAttribute("id", strconv.FormatInt(req.MatchId, 10)),
+		sdk.NewAttribute("authority", req.Authority),
+		sdk.NewAttribute("home_score", strconv.FormatInt(req.HomeScore, 10)),
+		sdk.NewAttribute("away_score", strconv.FormatInt(req.AwayScore, 10)),
+		sdk.NewAttribute("started", strconv.FormatBool(req.Started)),
+		sdk.NewAttribute("finished", strconv.FormatBool(req.Finished)),
+		sdk.NewAttribute("cancelled", strconv.FormatBool(req.Cancelled)),
+		sdk.NewAttribute("reason", req.Reason),
+	))
+
+	return &types.MsgOverrideMatchResponse{}, nil
+}
+
+func (k msgServer) VoidMatch(ctx context.Context, req *types.MsgVoidMatch) (*types.MsgVoidMatchResponse, error) {
+	if err := k.checkDataAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.Keeper.VoidMatch(sdkCtx, req.MatchId); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("match_voided",
+		sdk.NewAttribute("id", strconv.FormatInt(req.MatchId, 10)),
+		sdk.NewAttribute("authority", req.Authority),
+		sdk.NewAttribute("reason", req.Reason),
+	))
+
+	return &types.MsgVoidMatchResponse{}, nil
+}
+
+func (k msgServer) UpsertTeam(ctx context.Context, req *types.MsgUpsertTeam) (*types.MsgUpsertTeamResponse, error) {
+	if err := k.checkDataAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	team := datasource.Team{ID: int(req.Id), Name: req.Name, LongName: req.LongName}
+	if err := k.Keeper.UpsertTeam(ctx, team); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("team_upserted",
+		sdk.NewAttribute("id", strconv.FormatInt(req.Id, 10)),
+		sdk.NewAttribute("authority", req.Authority),
+		sdk.NewAttribute("name", req.Name),
+		sdk.NewAttribute("reason", req.Reason),
+	))
+
+	return &types.MsgUpsertTeamResponse{}, nil
+}
+
+func (k msgServer) UpsertLeague(ctx context.Context, req *types.MsgUpsertLeague) (*types.MsgUpsertLeagueResponse, error) {
+	if err := k.checkDataAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	league := datasource.League{
+		ID:        int(req.Id),
+		PrimaryID: int(req.PrimaryId),
+		Name:      req.Name,
+		GroupName: req.GroupName,
+		IsGroup:   req.IsGroup,
+		Ccode:     req.Ccode,
+	}
+	if err := k.Keeper.UpsertLeague(ctx, league); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("league_upserted",
+		sdk.NewAttribute("id", strconv.FormatInt(req.Id, 10)),
+		sdk.NewAttribute("authority", req.Authority),
+		sdk.NewAttribute("name", req.Name),
+		sdk.NewAttribute("reason", req.Reason),
+	))
+
+	return &types.MsgUpsertLeagueResponse{}, nil
+}
+
+// checkDataAuthority returns an error unless the signer is the module authority. The data council corrects
+// data through the threshold corrections, which run on behalf of the authority.
+func (k msgServer) checkDataAuthority(signer string) error {
+	addr, err := k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), addr) {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected the module authority, got %s", signer)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestMsgCorrections(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	councilStr, err := f.addressCodec.BytesToString(sdk.AccAddress("council"))
+	require.NoError(t, err)
+	aliceStr, err := f.addressCodec.BytesToString(sdk.AccAddress("alice"))
+	require.NoError(t, err)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	match := setupMarketMatch(t, f, 40)
+	matchID := int64(match.ID)
+
+	// only the authority can correct data, a data council member goes through the threshold corrections
+	override := &types.MsgOverrideMatch{Authority: councilStr, MatchId: matchID, HomeScore: 2, AwayScore: 1, Started: true, Finished: true, Reason: "feed error"}
+	params.DataCouncilMembers = []string{councilStr}
+	params.CorrectionThreshold = 1
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: params})
+	require.NoError(t, err)
+
+	_, err = ms.OverrideMatch(ctx, override)
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.OverrideMatch(ctx, &types.MsgOverrideMatch{Authority: aliceStr, MatchId: matchID})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.OverrideMatch(ctx, &types.MsgOverrideMatch{Authority: authorityStr, MatchId: 99, Finished: true})
+	require.ErrorIs(t, err, types.ErrInvalidCorrection)
+	_, err = ms.OverrideMatch(ctx, &types.MsgOverrideMatch{Authority: authorityStr, MatchId: matchID, HomeScore: -1})
+	require.ErrorIs(t, err, types.ErrInvalidCorrection)
+	override.Authority = authorityStr
+
+	// a finished result is finalized right away
+	_, err = ms.OverrideMatch(ctx, override)
+	require.NoError(t, err)
+
+	got, err := f.keeper.GetMatch(ctx, match.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, got.Home.Score)
+	require.Equal(t, 1, got.Away.Score)
+	require.True(t, got.Status.Finished)
+
+	finalized, err := f.keeper.IsMatchFinalized(ctx, matchID)
+	require.NoError(t, err)
+	require.True(t, finalized)
+
+	// finalized matches can be corrected but not reopened
+	_, err = ms.OverrideMatch(ctx, &types.MsgOverrideMatch{Authority: authorityStr, MatchId: matchID, Started: true})
+	require.ErrorIs(t, err, types.ErrInvalidCorrection)
+
+	// voiding refunds the open markets
+	other := setupMarketMatch(t, f, 41)
+	alice := sdk.AccAddress("alice")
+	f.bankKeeper.balances[string(alice)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	marketID, err := f.keeper.CreateMarket(ctx, alice, int64(other.ID), types.MARKET_TYPE_MATCH_RESULT, 0)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.PlaceStake(ctx, alice, marketID, types.OutcomeHome, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+
+	_, err = ms.VoidMatch(ctx, &types.MsgVoidMatch{Authority: authorityStr, MatchId: int64(other.ID), Reason: "abandoned"})
+	require.NoError(t, err)
+
+	market, err := f.keeper.Markets.Get(ctx, marketID)
+	require.NoError(t, err)
+	require.Equal(t, types.MARKET_STATUS_REFUNDED, market.Status)
+	require.Equal(t, int64(1000), f.bankKeeper.balances[string(alice)].AmountOf(sdk.DefaultBondDenom).Int64())
+
+	// teams and leagues
+	_, err = ms.UpsertTeam(ctx, &types.MsgUpsertTeam{Authority: authorityStr, Id: 1})
+	require.ErrorIs(t, err, types.ErrInvalidCorrection)
+	_, err = ms.UpsertTeam(ctx, &types.MsgUpsertTeam{Authority: authorityStr, Id: 1, Name: "Fixed", LongName: "Fixed FC"})
+	require.NoError(t, err)
+	team, err := f.keeper.GetTeam(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "Fixed FC", team.LongName)
+
+	_, err = ms.UpsertLeague(ctx, &types.MsgUpsertLeague{Authority: aliceStr, Id: 5, Name: "League"})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.UpsertLeague(ctx, &types.MsgUpsertLeague{Authority: authorityStr, Id: 5, Name: "League", GroupName: "A", IsGroup: true})
+	require.NoError(t, err)
+	league, err := f.keeper.GetLeague(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, "A", league.GroupName)
+
+	var events []string
+	for _, event := range ctx.EventManager().Events() {
+		events = append(events, event.Type)
+	}
+	require.Subset(t, events, []string{"match_overridden", "match_voided", "team_upserted", "league_upserted"})
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func (k msgServer) MergeEntities(ctx context.Context, req *types.MsgMergeEntities) (*types.MsgMergeEntitiesResponse, error) {
+	if err := k.checkDataAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.Keeper.MergeEntities(sdkCtx, req.EntityType, req.DuplicateId, req.CanonicalId); err != nil {
+		return nil, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventEntitiesMerged{
+		EntityType:  req.EntityType,
+		DuplicateId: req.DuplicateId,
+		CanonicalId: req.CanonicalId,
+		Authority:   req.Authority,
+		Reason:      req.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMergeEntitiesResponse{}, nil
+}
+
+func (k msgServer) MapExternalID(ctx context.Context, req *types.MsgMapExternalID) (*types.MsgMapExternalIDResponse, error) {
+	if err := k.checkDataAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.MapExternalID(ctx, req.EntityType, req.Provider, req.ExternalId, req.CanonicalId); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventExternalIDMapped{
+		EntityType:  req.EntityType,
+		Provider:    req.Provider,
+		ExternalId:  req.ExternalId,
+		CanonicalId: req.CanonicalId,
+		Authority:   req.Authority,
+		Reason:      req.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMapExternalIDResponse{}, nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func (k msgServer) MintOutcomeShares(ctx context.Context, req *types.MsgMintOutcomeShares) (*types.MsgMintOutcomeSharesResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := k.Keeper.MintOutcomeShares(ctx, sender, req.MatchId, req.Collateral); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintOutcomeSharesResponse{}, nil
+}
+
+func (k msgServer) BurnOutcomeShares(ctx context.Context, req *types.MsgBurnOutcomeShares) (*types.MsgBurnOutcomeSharesResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := k.Keeper.BurnOutcomeShares(ctx, sender, req.MatchId, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnOutcomeSharesResponse{}, nil
+}
+
+func (k msgServer) RedeemOutcomeShares(ctx context.Context, req *types.MsgRedeemOutcomeShares) (*types.MsgRedeemOutcomeSharesResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	payout, err := k.Keeper.RedeemOutcomeShares(ctx, sender, req.MatchId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemOutcomeSharesResponse{Payout: payout}, nil
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func (k msgServer) PlaceStake(ctx context.Context, req *types.MsgPlaceStake) (*types.MsgPlaceStakeResponse, error) {
+	staker, err := k.addressCodec.StringToBytes(req.Staker)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid staker address")
+	}
+
+	if err := k.Keeper.PlaceStake(ctx, staker, req.MarketId, req.Outcome, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlaceStakeResponse{}, nil
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func (k msgServer) RegisterReporter(ctx context.Context, req *types.MsgRegisterReporter) (*types.MsgRegisterReporterResponse, error) {
+	reporterAddr, err := k.addressCodec.StringToBytes(req.Reporter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid reporter address")
+	}
+
+	if err := k.Keeper.RegisterReporter(ctx, reporterAddr, req.Moniker, req.Bond); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterReporterResponse{}, nil
+}
+
+func (k msgServer) UnbondReporter(ctx context.Context, req *types.MsgUnbondReporter) (*types.MsgUnbondReporterResponse, error) {
+	reporterAddr, err := k.addressCodec.StringToBytes(req.Reporter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid reporter address")
+	}
+
+	height, err := k.Keeper.UnbondReporter(ctx, reporterAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnbondReporterResponse{UnbondingHeight: height}, nil
+}
+
+func (k msgServer) SubmitMatchReport(ctx context.Context, req *types.MsgSubmitMatchReport) (*types.MsgSubmitMatchReportResponse, error) {
+	report := req.Report
+	report.Reporter = req.Reporter
+	if err := k.Keeper.SubmitMatchReport(ctx, report); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitMatchReportResponse{}, nil
+}
+
+func (k msgServer) CommitReport(ctx context.Context, req *types.MsgCommitReport) (*types.MsgCommitReportResponse, error) {
+	commit := types.ReportCommit{
+		Reporter: req.Reporter,
+		MatchId:  req.MatchId,
+		Hash:     req.Hash,
+	}
+	round, err := k.Keeper.CommitReport(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCommitReportResponse{Round: round}, nil
+}
+
+func (k msgServer) RevealReport(ctx context.Context, req *types.MsgRevealReport) (*types.MsgRevealReportResponse, error) {
+	report := req.Report
+	report.Reporter = req.Reporter
+	if err := k.Keeper.RevealReport(ctx, report, req.Salt); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevealReportResponse{}, nil
+}
+
+func (k msgServer) FundReporterRewards(ctx context.Context, req *types.MsgFundReporterRewards) (*types.MsgFundReporterRewardsResponse, error) {
+	depositor, err := k.addressCodec.StringToBytes(req.Depositor)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid depositor address")
+	}
+
+	if err := k.Keeper.FundReporterRewards(ctx, depositor, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundReporterRewardsResponse{}, nil
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func (k msgServer) SubmitOracleReport(ctx context.Context, req *types.MsgSubmitOracleReport) (*types.MsgSubmitOracleReportResponse, error) {
+	report := types.OracleReport{
+		ValidatorAddress: req.ValidatorAddress,
+		MatchId:          req.MatchId,
+		HomeScore:        req.HomeScore,
+		AwayScore:        req.AwayScore,
+		Cancelled:        req.Cancelled,
+	}
+	if err := k.Keeper.SubmitOracleReport(ctx, report); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitOracleReportResponse{}, nil
+}
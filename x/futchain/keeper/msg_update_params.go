@@ -24,6 +24,16 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	previous, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if previous.OracleReportWindow == 0 && req.Params.OracleReportWindow > 0 {
+		if err := k.startOracleWindow(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := k.Params.Set(ctx, req.Params); err != nil {
 		return nil, err
 	}
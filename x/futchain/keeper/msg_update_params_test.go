@@ -36,12 +36,65 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "zero fetch modulo",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "fetch modulo must be positive",
+		},
+		{
+			name: "invalid data council member",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: func() types.Params {
+					p := params
+					p.DataCouncilMembers, p.CorrectionThreshold = []string{"council"}, 1
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "invalid data council member",
+		},
+		{
+			name: "max callback gas limit over the bound",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: func() types.Params {
+					p := params
+					p.MaxCallbackGasLimit = types.MaxCallbackGasLimitBound + 1
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "max callback gas limit must be at most",
+		},
+		{
+			name: "callback gas price over the bound",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: func() types.Params {
+					p := params
+					p.CallbackGasPrice = types.MaxCallbackGasPriceBound + 1
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "callback gas price must be at most",
+		},
+		{
+			name: "finality blocks over the bound",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: func() types.Params {
+					p := params
+					p.FinalityBlocks = types.MaxFinalityBlocks + 1
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "finality blocks must be at most",
 		},
 		{
 			name: "all good",
@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// SubmitOracleReport stores the report of a bonded validator on the result of a match, replacing its
+// previous report. Reports are accepted until the match is finalized.
+func (k *Keeper) SubmitOracleReport(ctx context.Context, report types.OracleReport) error {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(report.ValidatorAddress)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid validator address")
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidOracleReport, "validator %s: %s", report.ValidatorAddress, err)
+	}
+	if !validator.IsBonded() {
+		return errorsmod.Wrapf(types.ErrInvalidOracleReport, "validator %s is not bonded", report.ValidatorAddress)
+	}
+
+	if report.HomeScore < 0 || report.AwayScore < 0 {
+		return errorsmod.Wrapf(types.ErrInvalidOracleReport, "invalid score %d - %d", report.HomeScore, report.AwayScore)
+	}
+	if _, err := k.GetMatch(ctx, int(report.MatchId)); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidOracleReport, "match %d: %s", report.MatchId, err)
+	}
+	if finalized, err := k.IsMatchFinalized(ctx, report.MatchId); err != nil {
+		return err
+	} else if finalized {
+		return errorsmod.Wrapf(types.ErrInvalidOracleReport, "match %d is already finalized", report.MatchId)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	report.Height = sdkCtx.BlockHeight()
+	if err := k.OracleReports.Set(ctx, collections.Join(report.MatchId, []byte(valAddr)), report); err != nil {
+		return err
+	}
+	if err := k.ValidatorOracleReports.Set(ctx, collections.Join([]byte(valAddr), report.MatchId)); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("oracle_report_submitted",
+		sdk.NewAttribute("validator", report.ValidatorAddress),
+		sdk.NewAttribute("match_id", strconv.FormatInt(report.MatchId, 10)),
+		sdk.NewAttribute("home_score", strconv.FormatInt(report.HomeScore, 10)),
+		sdk.NewAttribute("away_score", strconv.FormatInt(report.AwayScore, 10)),
+		sdk.NewAttribute("cancelled", strconv.FormatBool(report.Cancelled)),
+	))
+
+	return nil
+}
+
+// TallyOracleReports compares the reports of the bonded validators with the finalized result of the
+// match, records the outcomes in their sliding windows, and punishes the validators past the thresholds.
+// Accurate validators accrue their stake as reward weight. The reports on the match are removed.
+// A missing report is only recorded if the result came in at resultHeight once the window was enabled,
+// and the validator was bonded when it was, so the validators are not punished for the matches they
+// could not know were tallied. A match is tallied once, even if it is finalized again.
+func (k *Keeper) TallyOracleReports(ctx sdk.Context, match *datasource.Match, resultHeight int64) error {
+	matchID := int64(match.ID)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	tallied, err := k.TalliedOracleMatches.Has(ctx, matchID)
+	if err != nil {
+		return err
+	}
+
+	countMisses := false
+	if params.OracleReportWindow > 0 && !tallied {
+		windowStart, err := k.oracleWindowStart(ctx)
+		if err != nil {
+			return err
+		}
+		countMisses = resultHeight >= windowStart
+	}
+
+	rewards := oracleRewardsEnabled(params)
+	if (params.OracleReportWindow > 0 || rewards) && !tallied {
+		if err := k.TalliedOracleMatches.Set(ctx, matchID); err != nil {
+			return err
+		}
+		validators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, validator := range validators {
+			valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+			if err != nil {
+				return err
+			}
+
+			outcome := types.REPORT_OUTCOME_VALID
+			report, err := k.OracleReports.Get(ctx, collections.Join(matchID, valAddr))
+			switch {
+			case errorsmod.IsOf(err, collections.ErrNotFound):
+				if !countMisses {
+					continue
+				}
+				if ok, err := k.OracleWindowValidators.Has(ctx, valAddr); err != nil {
+					return err
+				} else if !ok {
+					continue
+				}
+				outcome = types.REPORT_OUTCOME_MISSED
+			case err != nil:
+				return err
+			case report.HomeScore != int64(match.Home.Score) || report.AwayScore != int64(match.Away.Score) || report.Cancelled != match.Status.Cancelled:
+				outcome = types.REPORT_OUTCOME_DEVIATED
+			}
+
+			if rewards && outcome == types.REPORT_OUTCOME_VALID {
+				if err := k.accrueOracleReward(ctx, validator, valAddr); err != nil {
+					return err
+				}
+			}
+			if params.OracleReportWindow > 0 {
+				if err := k.recordOracleOutcome(ctx, params, validator, valAddr, outcome); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	iterator, err := k.OracleReports.Iterate(ctx, collections.NewPrefixedPairRange[int64, []byte](matchID))
+	if err != nil {
+		return err
+	}
+	keys, err := iterator.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.OracleReports.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.ValidatorOracleReports.Remove(ctx, collections.Join(key.K2(), matchID)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startOracleWindow records the current height as the start of the oracle report window, and the validators
+// bonded at it.
+func (k *Keeper) startOracleWindow(ctx context.Context) error {
+	if err := k.OracleWindowStart.Set(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight()); err != nil {
+		return err
+	}
+	if err := k.OracleWindowValidators.Clear(ctx, nil); err != nil {
+		return err
+	}
+	validators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return err
+	}
+	for _, validator := range validators {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return err
+		}
+		if err := k.OracleWindowValidators.Set(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// oracleWindowStart returns the height the oracle report window was enabled at. A window enabled in the
+// genesis params starts at the first tally.
+func (k *Keeper) oracleWindowStart(ctx sdk.Context) (int64, error) {
+	windowStart, err := k.OracleWindowStart.Get(ctx)
+	if !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return windowStart, err
+	}
+	return ctx.BlockHeight(), k.startOracleWindow(ctx)
+}
+
+// recordOracleOutcome records the report outcome of a validator in its sliding window, like x/slashing
+// does for missed blocks, and slashes and jails the validator once a counter passes its threshold.
+func (k *Keeper) recordOracleOutcome(ctx sdk.Context, params types.Params, validator stakingtypes.Validator, valAddr []byte, outcome types.ReportOutcome) error {
+	info, err := k.ValidatorOracleInfos.Get(ctx, valAddr)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		info = types.ValidatorOracleInfo{ValidatorAddress: validator.GetOperator()}
+	} else if err != nil {
+		return err
+	}
+
+	index := info.IndexOffset % params.OracleReportWindow
+	previous, err := k.OracleReportWindow.Get(ctx, collections.Join(valAddr, index))
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	switch types.ReportOutcome(previous) {
+	case types.REPORT_OUTCOME_MISSED:
+		info.MissedCounter--
+	case types.REPORT_OUTCOME_DEVIATED:
+		info.DeviationCounter--
+	}
+	switch outcome {
+	case types.REPORT_OUTCOME_MISSED:
+		info.MissedCounter++
+	case types.REPORT_OUTCOME_DEVIATED:
+		info.DeviationCounter++
+	}
+	if err := k.OracleReportWindow.Set(ctx, collections.Join(valAddr, index), int32(outcome)); err != nil {
+		return err
+	}
+	info.IndexOffset++
+
+	if info.MissedCounter <= params.MaxMissedReports && info.DeviationCounter <= params.MaxDeviatingReports {
+		return k.ValidatorOracleInfos.Set(ctx, valAddr, info)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+
+	if err := k.slashingKeeper.Slash(ctx, consAddr, params.SlashFractionOracle, power, distributionHeight); err != nil {
+		return err
+	}
+	if !validator.IsJailed() {
+		if err := k.slashingKeeper.Jail(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+	if err := k.slashingKeeper.JailUntil(ctx, consAddr, ctx.BlockHeader().Time.Add(params.OracleJailDuration)); err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("slashed and jailed validator for oracle reports", "validator", validator.GetOperator(), "missed", info.MissedCounter, "deviations", info.DeviationCounter)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("oracle_validator_slashed",
+		sdk.NewAttribute("validator", validator.GetOperator()),
+		sdk.NewAttribute("missed", strconv.FormatUint(info.MissedCounter, 10)),
+		sdk.NewAttribute("deviations", strconv.FormatUint(info.DeviationCounter, 10)),
+		sdk.NewAttribute("slash_fraction", params.SlashFractionOracle.String()),
+	))
+
+	// the validator starts over with a clean window, as x/slashing does after downtime
+	if err := k.OracleReportWindow.Clear(ctx, collections.NewPrefixedPairRange[[]byte, uint64](valAddr)); err != nil {
+		return err
+	}
+	info.IndexOffset, info.MissedCounter, info.DeviationCounter = 0, 0, 0
+	return k.ValidatorOracleInfos.Set(ctx, valAddr, info)
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// oracleRewardsEnabled reports whether the params distribute oracle rewards.
+func oracleRewardsEnabled(params types.Params) bool {
+	return params.OracleRewardEpochBlocks > 0 && !params.OracleRewardPerEpoch.IsNil() && params.OracleRewardPerEpoch.IsPositive()
+}
+
+// accrueOracleReward adds the stake of a validator that reported a finalized result accurately to its
+// weight in the current reward epoch.
+func (k *Keeper) accrueOracleReward(ctx context.Context, validator stakingtypes.Validator, valAddr []byte) error {
+	weight, err := k.GetOracleRewardWeight(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	return k.OracleRewardWeights.Set(ctx, valAddr, weight.Add(validator.GetTokens()))
+}
+
+// GetOracleRewardWeight returns the stake a validator accrued with accurate reports in the current epoch.
+func (k *Keeper) GetOracleRewardWeight(ctx context.Context, valAddr []byte) (math.Int, error) {
+	weight, err := k.OracleRewardWeights.Get(ctx, valAddr)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return weight, err
+}
+
+// GetOracleRewards returns the claimable oracle rewards of a validator.
+func (k *Keeper) GetOracleRewards(ctx context.Context, valAddr []byte) (sdk.Coin, error) {
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	rewards, err := k.OracleRewards.Get(ctx, valAddr)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return sdk.NewCoin(denom, math.ZeroInt()), nil
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(denom, rewards), nil
+}
+
+// DistributeOracleRewards mints the reward of the epoch at its last block and shares it between the
+// validators pro rata to their accrued weight. The rounding remainder goes to the last validator.
+func (k *Keeper) DistributeOracleRewards(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !oracleRewardsEnabled(params) || ctx.BlockHeight()%int64(params.OracleRewardEpochBlocks) != 0 {
+		return nil
+	}
+
+	iterator, err := k.OracleRewardWeights.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	weights, err := iterator.KeyValues()
+	if err != nil {
+		return err
+	}
+	if err := k.OracleRewardWeights.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	total := math.ZeroInt()
+	for _, weight := range weights {
+		total = total.Add(weight.Value)
+	}
+	if total.IsZero() {
+		return nil
+	}
+
+	denom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	reward := sdk.NewCoins(sdk.NewCoin(denom, params.OracleRewardPerEpoch))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, reward); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.OracleRewardsPoolName, reward); err != nil {
+		return err
+	}
+
+	paid := math.ZeroInt()
+	for i, weight := range weights {
+		share := params.OracleRewardPerEpoch.Mul(weight.Value).Quo(total)
+		if i == len(weights)-1 {
+			share = params.OracleRewardPerEpoch.Sub(paid)
+		}
+		paid = paid.Add(share)
+
+		rewards, err := k.OracleRewards.Get(ctx, weight.Key)
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			rewards = math.ZeroInt()
+		} else if err != nil {
+			return err
+		}
+		if err := k.OracleRewards.Set(ctx, weight.Key, rewards.Add(share)); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("oracle_rewards_distributed",
+		sdk.NewAttribute("amount", reward.String()),
+		sdk.NewAttribute("validators", strconv.Itoa(len(weights))),
+	))
+
+	return nil
+}
+
+// ClaimOracleRewards pays the claimable oracle rewards of a validator out to its operator account.
+func (k *Keeper) ClaimOracleRewards(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coin, error) {
+	rewards, err := k.GetOracleRewards(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !rewards.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrNoOracleRewards, "validator %s", valAddr)
+	}
+
+	if err := k.OracleRewards.Remove(ctx, valAddr); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.OracleRewardsPoolName, sdk.AccAddress(valAddr), sdk.NewCoins(rewards)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("oracle_rewards_claimed",
+		sdk.NewAttribute("validator", valAddr.String()),
+		sdk.NewAttribute("amount", rewards.String()),
+	))
+
+	return rewards, nil
+}
@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// setupValidators adds a validator per power to the staking keeper, bonded unless its power is zero.
+func setupValidators(t *testing.T, f *fixture, powers ...int64) []string {
+	t.Helper()
+
+	var operators []string
+	for _, power := range powers {
+		operator := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+		validator, err := stakingtypes.NewValidator(operator, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+		require.NoError(t, err)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = sdk.DefaultPowerReduction.MulRaw(power)
+		if power == 0 {
+			validator.Status = stakingtypes.Unbonded
+		}
+		f.stakingKeeper.validators = append(f.stakingKeeper.validators, validator)
+		operators = append(operators, operator)
+	}
+	return operators
+}
+
+func TestOracleReports(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithBlockTime(time.Unix(1_000_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	vals := setupValidators(t, f, 10, 10, 10, 0)
+	honest, absent, deviant, unbonded := vals[0], vals[1], vals[2], vals[3]
+
+	params := types.DefaultParams()
+	params.OracleReportWindow = 4
+	params.MaxMissedReports = 1
+	params.MaxDeviatingReports = 0
+	params.SlashFractionOracle = math.LegacyNewDecWithPrec(1, 2)
+	params.OracleJailDuration = time.Hour
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	first := setupMarketMatch(t, f, 60)
+	second := setupMarketMatch(t, f, 61)
+
+	report := func(validator string, matchID, home, away int64) error {
+		_, err := ms.SubmitOracleReport(ctx, &types.MsgSubmitOracleReport{ValidatorAddress: validator, MatchId: matchID, HomeScore: home, AwayScore: away})
+		return err
+	}
+
+	require.ErrorIs(t, report(unbonded, 60, 1, 0), types.ErrInvalidOracleReport)
+	require.ErrorIs(t, report(honest, 99, 1, 0), types.ErrInvalidOracleReport)
+	require.ErrorIs(t, report(honest, 60, -1, 0), types.ErrInvalidOracleReport)
+
+	require.NoError(t, report(honest, 60, 0, 0))
+	require.NoError(t, report(honest, 60, 1, 0)) // replaces the previous report
+	require.NoError(t, report(deviant, 60, 2, 0))
+	require.NoError(t, report(honest, 61, 2, 2))
+
+	reports, err := qs.ValidatorOracleReports(ctx, &types.QueryValidatorOracleReportsRequest{ValidatorAddress: honest})
+	require.NoError(t, err)
+	require.Len(t, reports.Reports, 2)
+	require.Equal(t, int64(1), reports.Reports[0].HomeScore)
+
+	require.NoError(t, f.keeper.OverrideMatch(ctx, int64(first.ID), 1, 0, true, true, false))
+	require.ErrorIs(t, report(honest, 60, 1, 0), types.ErrInvalidOracleReport)
+
+	info := func(validator string) types.ValidatorOracleInfo {
+		res, err := qs.ValidatorOracleInfo(ctx, &types.QueryValidatorOracleInfoRequest{ValidatorAddress: validator})
+		require.NoError(t, err)
+		return res.Info
+	}
+	consAddr := func(i int) string {
+		addr, err := f.stakingKeeper.validators[i].GetConsAddr()
+		require.NoError(t, err)
+		return sdk.ConsAddress(addr).String()
+	}
+
+	require.Equal(t, types.ValidatorOracleInfo{ValidatorAddress: honest, IndexOffset: 1}, info(honest))
+	require.Equal(t, types.ValidatorOracleInfo{ValidatorAddress: absent, IndexOffset: 1, MissedCounter: 1}, info(absent))
+	// the deviation passed the threshold: slashed, jailed and reset
+	require.Equal(t, types.ValidatorOracleInfo{ValidatorAddress: deviant}, info(deviant))
+	require.Equal(t, params.SlashFractionOracle, f.slashingKeeper.slashed[consAddr(2)])
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), f.slashingKeeper.jailed[consAddr(2)])
+	require.NotContains(t, f.slashingKeeper.slashed, consAddr(1))
+
+	// reports on finalized matches are removed
+	reports, err = qs.ValidatorOracleReports(ctx, &types.QueryValidatorOracleReportsRequest{ValidatorAddress: honest})
+	require.NoError(t, err)
+	require.Len(t, reports.Reports, 1)
+
+	require.NoError(t, f.keeper.OverrideMatch(ctx, int64(second.ID), 2, 2, true, true, false))
+	require.Equal(t, types.ValidatorOracleInfo{ValidatorAddress: honest, IndexOffset: 2}, info(honest))
+	require.Contains(t, f.slashingKeeper.slashed, consAddr(1))
+	require.NotContains(t, f.slashingKeeper.slashed, consAddr(0))
+
+	// a match finalized again is not tallied twice
+	match, err := f.keeper.GetMatch(ctx, second.ID)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.TallyOracleReports(ctx, match, ctx.BlockHeight()))
+	require.Equal(t, types.ValidatorOracleInfo{ValidatorAddress: honest, IndexOffset: 2}, info(honest))
+
+	_, err = qs.ValidatorOracleInfo(ctx, &types.QueryValidatorOracleInfoRequest{ValidatorAddress: unbonded})
+	require.Error(t, err)
+}
+
+func TestOracleMissesFromWindowStart(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(15).WithBlockTime(time.Unix(1_000_000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	vals := setupValidators(t, f, 10)
+	consAddr, err := f.stakingKeeper.validators[0].GetConsAddr()
+	require.NoError(t, err)
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.FinalityBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	finish := func(id int) {
+		match := setupMarketMatch(t, f, id)
+		match.Status.Started, match.Status.Finished = true, true
+		require.NoError(t, f.keeper.SetMatch(ctx, match))
+		require.NoError(t, f.keeper.StartFinalityWindow(ctx, int64(match.ID)))
+	}
+
+	// the result of the first match came in before the window was enabled
+	finish(70)
+
+	ctx = ctx.WithBlockHeight(20)
+	params.OracleReportWindow = 4
+	params.MaxMissedReports = 0
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: params})
+	require.NoError(t, err)
+
+	// a validator bonded after the window was enabled
+	late := setupValidators(t, f, 10)[0]
+	lateConsAddr, err := f.stakingKeeper.validators[1].GetConsAddr()
+	require.NoError(t, err)
+
+	// the validator never reports, but is not punished for the first match
+	ctx = ctx.WithBlockHeight(25)
+	require.NoError(t, f.keeper.FinalizeMatches(ctx))
+	_, err = qs.ValidatorOracleInfo(ctx, &types.QueryValidatorOracleInfoRequest{ValidatorAddress: vals[0]})
+	require.Error(t, err)
+	require.NotContains(t, f.slashingKeeper.slashed, sdk.ConsAddress(consAddr).String())
+
+	// from the window start on, it is
+	ctx = ctx.WithBlockHeight(30)
+	finish(71)
+	ctx = ctx.WithBlockHeight(40)
+	require.NoError(t, f.keeper.FinalizeMatches(ctx))
+	require.Contains(t, f.slashingKeeper.slashed, sdk.ConsAddress(consAddr).String())
+
+	// the misses of the validator bonded later do not count
+	require.NotContains(t, f.slashingKeeper.slashed, sdk.ConsAddress(lateConsAddr).String())
+	_, err = qs.ValidatorOracleInfo(ctx, &types.QueryValidatorOracleInfoRequest{ValidatorAddress: late})
+	require.Error(t, err)
+}
+
+func TestOracleRewards(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(9)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	vals := setupValidators(t, f, 10, 30, 10)
+
+	params := types.DefaultParams()
+	params.OracleRewardEpochBlocks = 5
+	params.OracleRewardPerEpoch = math.NewInt(1000)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	match := setupMarketMatch(t, f, 70)
+	for i, home := range []int64{1, 1, 2} {
+		_, err := ms.SubmitOracleReport(ctx, &types.MsgSubmitOracleReport{ValidatorAddress: vals[i], MatchId: int64(match.ID), HomeScore: home})
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.keeper.OverrideMatch(ctx, int64(match.ID), 1, 0, true, true, false))
+
+	res, err := qs.OracleRewards(ctx, &types.QueryOracleRewardsRequest{ValidatorAddress: vals[1]})
+	require.NoError(t, err)
+	require.Equal(t, sdk.DefaultPowerReduction.MulRaw(30), res.EpochWeight)
+	require.True(t, res.Rewards.IsZero())
+
+	// rewards are only distributed at the end of the epoch
+	require.NoError(t, f.keeper.DistributeOracleRewards(ctx))
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, f.keeper.DistributeOracleRewards(ctx))
+
+	expected := map[string]int64{vals[0]: 250, vals[1]: 750, vals[2]: 0}
+	for operator, amount := range expected {
+		res, err := qs.OracleRewards(ctx, &types.QueryOracleRewardsRequest{ValidatorAddress: operator})
+		require.NoError(t, err)
+		require.Equal(t, amount, res.Rewards.Amount.Int64())
+		require.True(t, res.EpochWeight.IsZero())
+	}
+
+	claim, err := ms.ClaimOracleRewards(ctx, &types.MsgClaimOracleRewards{ValidatorAddress: vals[0]})
+	require.NoError(t, err)
+	require.Equal(t, int64(250), claim.Amount.Amount.Int64())
+
+	valAddr, err := sdk.ValAddressFromBech32(vals[0])
+	require.NoError(t, err)
+	require.Equal(t, int64(250), f.bankKeeper.balances[string(valAddr)].AmountOf(sdk.DefaultBondDenom).Int64())
+
+	_, err = ms.ClaimOracleRewards(ctx, &types.MsgClaimOracleRewards{ValidatorAddress: vals[0]})
+	require.ErrorIs(t, err, types.ErrNoOracleRewards)
+	_, err = ms.ClaimOracleRewards(ctx, &types.MsgClaimOracleRewards{ValidatorAddress: vals[2]})
+	require.ErrorIs(t, err, types.ErrNoOracleRewards)
+}
@@ -0,0 +1,330 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// OutcomeTokens returns the outcome share tokens of a match.
+func (k *Keeper) OutcomeTokens(matchID int64) []types.OutcomeToken {
+	tokens := make([]types.OutcomeToken, 0, len(types.MatchOutcomes))
+	for _, outcome := range types.MatchOutcomes {
+		denom := types.OutcomeDenom(matchID, outcome)
+		tokens = append(tokens, types.OutcomeToken{
+			Outcome:      outcome,
+			Denom:        denom,
+			Erc20Address: types.OutcomeTokenAddress(denom).Hex(),
+		})
+	}
+	return tokens
+}
+
+// GetOutcomeCollateral returns the collateral locked for the outcome shares of a match.
+func (k *Keeper) GetOutcomeCollateral(ctx context.Context, matchID int64) (sdk.Coin, error) {
+	collateral, err := k.OutcomeCollateral.Get(ctx, matchID)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		denom, err := k.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return sdk.NewCoin(denom, math.ZeroInt()), nil
+	}
+	return collateral, err
+}
+
+// MintOutcomeShares locks the collateral and mints the same amount of every outcome share of the
+// match to the sender. The outcome denoms are registered as ERC-20 tokens on the first mint, and removed
+// once the match is over and their supply is redeemed or burned.
+func (k *Keeper) MintOutcomeShares(ctx context.Context, sender sdk.AccAddress, matchID int64, amount sdk.Coin) error {
+	match, err := k.GetMatch(ctx, int(matchID))
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "match %d: %s", matchID, err)
+	}
+	if match.Status.Finished || match.Status.Cancelled {
+		return errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "match %d is already over", matchID)
+	}
+
+	collateral, err := k.GetOutcomeCollateral(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "invalid collateral %s", amount)
+	}
+	if amount.Denom != collateral.Denom {
+		return errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "expected %s collateral, got %s", collateral.Denom, amount.Denom)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.registerOutcomeTokens(sdkCtx, matchID, collateral.Denom); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	shares := outcomeShares(matchID, amount.Amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, shares); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, shares); err != nil {
+		return err
+	}
+
+	if err := k.OutcomeCollateral.Set(ctx, matchID, collateral.Add(amount)); err != nil {
+		return err
+	}
+
+	senderStr, err := k.addressCodec.BytesToString(sender)
+	if err != nil {
+		return err
+	}
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("outcome_shares_minted",
+		sdk.NewAttribute("match_id", strconv.FormatInt(matchID, 10)),
+		sdk.NewAttribute("sender", senderStr),
+		sdk.NewAttribute("amount", amount.String()),
+	))
+
+	return nil
+}
+
+// BurnOutcomeShares burns complete sets of outcome shares of the match and returns the same amount
+// of collateral to the sender. Complete sets can be burned at any time, even once the match is over.
+func (k *Keeper) BurnOutcomeShares(ctx context.Context, sender sdk.AccAddress, matchID int64, amount math.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "invalid amount %s", amount)
+	}
+
+	collateral, err := k.GetOutcomeCollateral(ctx, matchID)
+	if err != nil {
+		return err
+	}
+	if collateral.Amount.LT(amount) {
+		return errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "only %s collateral is locked for match %d", collateral, matchID)
+	}
+
+	shares := outcomeShares(matchID, amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, shares); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shares); err != nil {
+		return err
+	}
+
+	payout := sdk.NewCoin(collateral.Denom, amount)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(payout)); err != nil {
+		return err
+	}
+
+	if err := k.OutcomeCollateral.Set(ctx, matchID, collateral.Sub(payout)); err != nil {
+		return err
+	}
+	if err := k.pruneOutcomeTokens(sdk.UnwrapSDKContext(ctx), matchID); err != nil {
+		return err
+	}
+
+	senderStr, err := k.addressCodec.BytesToString(sender)
+	if err != nil {
+		return err
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("outcome_shares_burned",
+		sdk.NewAttribute("match_id", strconv.FormatInt(matchID, 10)),
+		sdk.NewAttribute("sender", senderStr),
+		sdk.NewAttribute("amount", payout.String()),
+	))
+
+	return nil
+}
+
+// RedeemOutcomeShares burns the outcome shares held by the sender that have a value once the result is
+// finalized, and pays out their collateral. Every share of the winning outcome pays out the same amount of
+// collateral, the shares of the other outcomes are worthless. If the match was cancelled, every share of
+// any outcome pays out its pro rata part of the collateral, a third of it for the shares minted together.
+func (k *Keeper) RedeemOutcomeShares(ctx context.Context, sender sdk.AccAddress, matchID int64) (sdk.Coin, error) {
+	match, err := k.GetMatch(ctx, int(matchID))
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "match %d: %s", matchID, err)
+	}
+	if !match.Status.Finished && !match.Status.Cancelled {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "match %d has no result", matchID)
+	}
+	if finalized, err := k.IsMatchFinalized(ctx, matchID); err != nil {
+		return sdk.Coin{}, err
+	} else if !finalized {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "result of match %d is not final yet", matchID)
+	}
+
+	collateral, err := k.GetOutcomeCollateral(ctx, matchID)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	var shares sdk.Coins
+	payout := sdk.NewCoin(collateral.Denom, math.ZeroInt())
+	if match.Status.Cancelled {
+		// the collateral is shared by every share left, so the redemption order does not change the payouts
+		held, supply := math.ZeroInt(), math.ZeroInt()
+		for _, outcome := range types.MatchOutcomes {
+			denom := types.OutcomeDenom(matchID, outcome)
+			balance := k.bankKeeper.GetBalance(ctx, sender, denom)
+			shares, held = shares.Add(balance), held.Add(balance.Amount)
+			supply = supply.Add(k.bankKeeper.GetSupply(ctx, denom).Amount)
+		}
+		if !held.IsPositive() {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "no shares of match %d to redeem", matchID)
+		}
+		payout.Amount = collateral.Amount.Mul(held).Quo(supply)
+	} else {
+		winning, err := types.Market{MarketType: types.MARKET_TYPE_MATCH_RESULT}.Outcome(uint64(match.Home.Score), uint64(match.Away.Score))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		winningShares := k.bankKeeper.GetBalance(ctx, sender, types.OutcomeDenom(matchID, winning))
+		if !winningShares.IsPositive() {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "no %s shares to redeem", winningShares.Denom)
+		}
+		shares, payout.Amount = sdk.NewCoins(winningShares), winningShares.Amount
+	}
+	if collateral.Amount.LT(payout.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidOutcomeShare, "only %s collateral is locked for match %d", collateral, matchID)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if payout.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(payout)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if err := k.OutcomeCollateral.Set(ctx, matchID, collateral.Sub(payout)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.pruneOutcomeTokens(sdk.UnwrapSDKContext(ctx), matchID); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	senderStr, err := k.addressCodec.BytesToString(sender)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("outcome_shares_redeemed",
+		sdk.NewAttribute("match_id", strconv.FormatInt(matchID, 10)),
+		sdk.NewAttribute("sender", senderStr),
+		sdk.NewAttribute("amount", payout.String()),
+	))
+
+	return payout, nil
+}
+
+// registerOutcomeTokens registers the bank metadata and the ERC-20 token pairs of the outcome shares
+// of a match, if they are not registered yet. The shares use the decimals of the collateral.
+func (k *Keeper) registerOutcomeTokens(ctx sdk.Context, matchID int64, collateralDenom string) error {
+	if k.erc20Keeper == nil {
+		return nil
+	}
+
+	var decimals uint32
+	if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, collateralDenom); found {
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display {
+				decimals = unit.Exponent
+			}
+		}
+	}
+
+	for _, token := range k.OutcomeTokens(matchID) {
+		if k.erc20Keeper.IsDenomRegistered(ctx, token.Denom) {
+			continue
+		}
+
+		symbol := fmt.Sprintf("FUT%d%s", matchID, strings.ToUpper(token.Denom[strings.LastIndex(token.Denom, "/")+1:]))
+		metadata := banktypes.Metadata{
+			Description: fmt.Sprintf("Outcome share of futchain match %d, redeemable 1:1 for %s if the outcome wins, pro rata if the match is cancelled", matchID, collateralDenom),
+			DenomUnits:  []*banktypes.DenomUnit{{Denom: token.Denom, Exponent: 0}},
+			Base:        token.Denom,
+			Display:     token.Denom,
+			Name:        token.Denom,
+			Symbol:      symbol,
+		}
+		if decimals > 0 {
+			metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: strings.ToLower(symbol), Exponent: decimals})
+			metadata.Display = strings.ToLower(symbol)
+		}
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+		k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+		address := types.OutcomeTokenAddress(token.Denom)
+		if err := k.erc20Keeper.SetToken(ctx, erc20types.NewTokenPair(address, token.Denom, erc20types.OWNER_MODULE)); err != nil {
+			return err
+		}
+		if err := k.erc20Keeper.EnableDynamicPrecompile(ctx, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneOutcomeTokens removes the ERC-20 token pairs and precompiles of the outcome shares of a match whose
+// supply is redeemed or burned, once the result of the match is finalized, so the tokens of past matches do
+// not pile up. The pairs of the shares still held are kept.
+func (k *Keeper) pruneOutcomeTokens(ctx sdk.Context, matchID int64) error {
+	if k.erc20Keeper == nil {
+		return nil
+	}
+
+	match, err := k.GetMatch(ctx, int(matchID))
+	if err != nil {
+		return err
+	}
+	if !match.Status.Finished && !match.Status.Cancelled {
+		return nil
+	}
+	if finalized, err := k.IsMatchFinalized(ctx, matchID); err != nil || !finalized {
+		return err
+	}
+
+	for _, token := range k.OutcomeTokens(matchID) {
+		if !k.erc20Keeper.IsDenomRegistered(ctx, token.Denom) || !k.bankKeeper.GetSupply(ctx, token.Denom).IsZero() {
+			continue
+		}
+
+		address := types.OutcomeTokenAddress(token.Denom)
+		k.erc20Keeper.DeleteTokenPair(ctx, erc20types.NewTokenPair(address, token.Denom, erc20types.OWNER_MODULE))
+		k.erc20Keeper.DeleteDynamicPrecompile(ctx, address)
+		if err := k.erc20Keeper.UnRegisterERC20CodeHash(ctx, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// outcomeShares returns a complete set of outcome shares of the match.
+func outcomeShares(matchID int64, amount math.Int) sdk.Coins {
+	shares := sdk.NewCoins()
+	for _, outcome := range types.MatchOutcomes {
+		shares = shares.Add(sdk.NewCoin(types.OutcomeDenom(matchID, outcome), amount))
+	}
+	return shares
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestOutcomeShares(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	match := setupMarketMatch(t, f, 20)
+	matchID := int64(match.ID)
+
+	alice, bob := sdk.AccAddress("alice"), sdk.AccAddress("bob")
+	f.bankKeeper.balances[string(alice)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	balance := func(addr sdk.AccAddress, denom string) int64 {
+		return f.bankKeeper.balances[string(addr)].AmountOf(denom).Int64()
+	}
+	home, draw, away := types.OutcomeDenom(matchID, types.OutcomeHome), types.OutcomeDenom(matchID, types.OutcomeDraw), types.OutcomeDenom(matchID, types.OutcomeAway)
+	require.Equal(t, "futchain/match/20/home", home)
+
+	require.ErrorIs(t, f.keeper.MintOutcomeShares(ctx, alice, matchID, sdk.NewInt64Coin("other", 10)), types.ErrInvalidOutcomeShare)
+	require.NoError(t, f.keeper.MintOutcomeShares(ctx, alice, matchID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)))
+
+	// the shares are registered as erc20 tokens on the first mint
+	for _, denom := range []string{home, draw, away} {
+		require.Equal(t, int64(300), balance(alice, denom))
+		pair, found := f.erc20Keeper.pairs[denom]
+		require.True(t, found)
+		require.Equal(t, types.OutcomeTokenAddress(denom).Hex(), pair.Erc20Address)
+		_, found = f.bankKeeper.metadata[denom]
+		require.True(t, found)
+	}
+
+	res, err := qs.OutcomeTokens(ctx, &types.QueryOutcomeTokensRequest{MatchId: matchID})
+	require.NoError(t, err)
+	require.Len(t, res.Tokens, 3)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300), res.Collateral)
+
+	// complete sets can be burned back into collateral
+	require.NoError(t, f.keeper.BurnOutcomeShares(ctx, alice, matchID, math.NewInt(100)))
+	require.Equal(t, int64(800), balance(alice, sdk.DefaultBondDenom))
+	require.Equal(t, int64(200), balance(alice, draw))
+
+	// alice sells her home shares to bob
+	f.bankKeeper.balances[string(alice)] = f.bankKeeper.balances[string(alice)].Sub(sdk.NewInt64Coin(home, 150))
+	f.bankKeeper.balances[string(bob)] = sdk.NewCoins(sdk.NewInt64Coin(home, 150))
+
+	_, err = f.keeper.RedeemOutcomeShares(ctx, bob, matchID)
+	require.ErrorIs(t, err, types.ErrInvalidOutcomeShare)
+
+	match.Home.Score, match.Away.Score = 2, 0
+	match.Status.Finished = true
+	require.NoError(t, f.keeper.SetMatch(ctx, match))
+
+	require.ErrorIs(t, f.keeper.MintOutcomeShares(ctx, alice, matchID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), types.ErrInvalidOutcomeShare)
+
+	// shares are only redeemable once the result is final
+	require.NoError(t, f.keeper.StartFinalityWindow(ctx, matchID))
+	_, err = f.keeper.RedeemOutcomeShares(ctx, bob, matchID)
+	require.ErrorIs(t, err, types.ErrInvalidOutcomeShare)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(types.DefaultFinalityBlocks))
+	require.NoError(t, f.keeper.FinalizeMatches(ctx))
+
+	payout, err := f.keeper.RedeemOutcomeShares(ctx, bob, matchID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 150), payout)
+	require.Equal(t, int64(0), balance(bob, home))
+	require.Len(t, f.erc20Keeper.pairs, 3)
+
+	payout, err = f.keeper.RedeemOutcomeShares(ctx, alice, matchID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), payout)
+
+	// losing shares are worthless
+	_, err = f.keeper.RedeemOutcomeShares(ctx, alice, matchID)
+	require.ErrorIs(t, err, types.ErrInvalidOutcomeShare)
+
+	collateral, err := f.keeper.GetOutcomeCollateral(ctx, matchID)
+	require.NoError(t, err)
+	require.True(t, collateral.IsZero())
+
+	// the erc20 token of the winning shares is removed once they are all redeemed, the losing shares are still held
+	require.Len(t, f.erc20Keeper.pairs, 2)
+	_, found := f.erc20Keeper.pairs[home]
+	require.False(t, found)
+}
+
+func TestOutcomeSharesCancelledMatch(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	match := setupMarketMatch(t, f, 21)
+	matchID := int64(match.ID)
+
+	alice, bob := sdk.AccAddress("alice"), sdk.AccAddress("bob")
+	f.bankKeeper.balances[string(alice)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	balance := func(addr sdk.AccAddress, denom string) int64 {
+		return f.bankKeeper.balances[string(addr)].AmountOf(denom).Int64()
+	}
+	home := types.OutcomeDenom(matchID, types.OutcomeHome)
+
+	require.NoError(t, f.keeper.MintOutcomeShares(ctx, alice, matchID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)))
+	f.bankKeeper.balances[string(alice)] = f.bankKeeper.balances[string(alice)].Sub(sdk.NewInt64Coin(home, 100))
+	f.bankKeeper.balances[string(bob)] = sdk.NewCoins(sdk.NewInt64Coin(home, 100))
+
+	require.NoError(t, f.keeper.VoidMatch(ctx, matchID))
+
+	// every share is worth a third of its collateral: 100 of the 900 shares
+	payout, err := f.keeper.RedeemOutcomeShares(ctx, bob, matchID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 33), payout)
+	require.Equal(t, int64(0), balance(bob, home))
+	require.Len(t, f.erc20Keeper.pairs, 3)
+
+	// the rounding remainder goes to the last holder
+	payout, err = f.keeper.RedeemOutcomeShares(ctx, alice, matchID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 267), payout)
+	require.Equal(t, int64(1000-300+267), balance(alice, sdk.DefaultBondDenom))
+
+	_, err = f.keeper.RedeemOutcomeShares(ctx, alice, matchID)
+	require.ErrorIs(t, err, types.ErrInvalidOutcomeShare)
+
+	collateral, err := f.keeper.GetOutcomeCollateral(ctx, matchID)
+	require.NoError(t, err)
+	require.True(t, collateral.IsZero())
+	require.Empty(t, f.erc20Keeper.pairs)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Correction(ctx context.Context, req *types.QueryCorrectionRequest) (*types.QueryCorrectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	correction, err := q.k.Corrections.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "correction not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCorrectionResponse{Correction: correction}, nil
+}
+
+func (q queryServer) Corrections(ctx context.Context, req *types.QueryCorrectionsRequest) (*types.QueryCorrectionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	corrections, pageRes, err := query.CollectionPaginate(ctx, q.k.Corrections, req.Pagination,
+		func(_ uint64, correction types.Correction) (types.Correction, error) {
+			return correction, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCorrectionsResponse{Corrections: corrections, Pagination: pageRes}, nil
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) CanonicalID(ctx context.Context, req *types.QueryCanonicalIDRequest) (*types.QueryCanonicalIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	id, err := q.k.CanonicalID(ctx, req.EntityType, req.Provider, req.ExternalId)
+	if err != nil {
+		return nil, idMappingError(err)
+	}
+	return &types.QueryCanonicalIDResponse{CanonicalId: id}, nil
+}
+
+func (q queryServer) ExternalIDs(ctx context.Context, req *types.QueryExternalIDsRequest) (*types.QueryExternalIDsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	mappings, mergedInto, err := q.k.ExternalIDs(ctx, req.EntityType, req.CanonicalId)
+	if err != nil {
+		return nil, idMappingError(err)
+	}
+	return &types.QueryExternalIDsResponse{Mappings: mappings, MergedInto: mergedInto}, nil
+}
+
+func (q queryServer) IDMappings(ctx context.Context, req *types.QueryIDMappingsRequest) (*types.QueryIDMappingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateEntityType(req.EntityType); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	mappings, pageRes, err := query.CollectionPaginate(ctx, q.k.IDMappings, req.Pagination,
+		func(key collections.Triple[int32, string, string], canonicalID int64) (types.IDMapping, error) {
+			return types.IDMapping{
+				EntityType:  types.EntityType(key.K1()),
+				Provider:    key.K2(),
+				ExternalId:  key.K3(),
+				CanonicalId: canonicalID,
+			}, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[int32, string, string]]) {
+			prefix := collections.TriplePrefix[int32, string, string](int32(req.EntityType))
+			if req.Provider != "" {
+				prefix = collections.TripleSuperPrefix[int32, string, string](int32(req.EntityType), req.Provider)
+			}
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIDMappingsResponse{Mappings: mappings, Pagination: pageRes}, nil
+}
+
+// idMappingError returns the status of an id mapping error: an invalid argument for an invalid entity type,
+// and not found for an unmapped id.
+func idMappingError(err error) error {
+	switch {
+	case errors.Is(err, types.ErrInvalidIDMapping):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, collections.ErrNotFound):
+		return status.Error(codes.NotFound, "id mapping not found")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
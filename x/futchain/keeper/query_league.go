@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"github.com/raifpy/futchain/x/futchain/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,12 +19,11 @@ func (q queryServer) League(ctx context.Context, req *types.QueryLeagueRequest)
 	}
 	leag, err := q.k.GetLeague(ctx, int(req.Id))
 	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "league not found")
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryLeagueResponse{
-		Id:        int64(leag.ID),
-		Name:      leag.Name,
-		GroupName: leag.GroupName,
-	}, nil
+	return &types.QueryLeagueResponse{League: leagueProto(*leag)}, nil
 }
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource/flatbuffers"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Leagues(ctx context.Context, req *types.QueryLeaguesRequest) (*types.QueryLeaguesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var leagues []types.League
+	pageRes, err := query.Paginate(q.k.datasourceStore(ctx, LeagueKey), req.Pagination, func(_, value []byte) error {
+		league, err := flatbuffers.NewLeagueEncoder().DecodeFromBinary(value)
+		if err != nil {
+			return err
+		}
+		leagues = append(leagues, leagueProto(*league))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLeaguesResponse{Leagues: leagues, Pagination: pageRes}, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) MarketStakes(ctx context.Context, req *types.QueryMarketStakesRequest) (*types.QueryMarketStakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	market, err := q.k.Markets.Get(ctx, req.MarketId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "market not found")
+	}
+
+	stakes, pageRes, err := query.CollectionPaginate(ctx, q.k.Stakes, req.Pagination,
+		func(key collections.Triple[uint64, []byte, uint64], amount math.Int) (types.Stake, error) {
+			staker, err := q.k.addressCodec.BytesToString(key.K2())
+			if err != nil {
+				return types.Stake{}, err
+			}
+			return types.Stake{
+				MarketId: key.K1(),
+				Staker:   staker,
+				Outcome:  key.K3(),
+				Amount:   sdk.NewCoin(market.TotalStaked.Denom, amount),
+			}, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[uint64, []byte, uint64]]) {
+			prefix := collections.TriplePrefix[uint64, []byte, uint64](req.MarketId)
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMarketStakesResponse{Stakes: stakes, Pagination: pageRes}, nil
+}
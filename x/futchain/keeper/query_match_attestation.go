@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) MatchAttestation(ctx context.Context, req *types.QueryMatchAttestationRequest) (*types.QueryMatchAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	attestation, provider, err := q.k.GetMatchAttestation(ctx, req.MatchId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "match attestation not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMatchAttestationResponse{Attestation: attestation, Provider: provider}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) MatchMarkets(ctx context.Context, req *types.QueryMatchMarketsRequest) (*types.QueryMatchMarketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	markets, pageRes, err := query.CollectionPaginate(ctx, q.k.MatchMarkets, req.Pagination,
+		func(key collections.Pair[int64, uint64], _ collections.NoValue) (types.Market, error) {
+			return q.k.Markets.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[int64, uint64](req.MatchId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMatchMarketsResponse{Markets: markets, Pagination: pageRes}, nil
+}
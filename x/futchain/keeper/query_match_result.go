@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) MatchResultRecord(ctx context.Context, req *types.QueryMatchResultRecordRequest) (*types.QueryMatchResultRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	result, err := q.k.GetMatchResult(ctx, req.MatchId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "match result not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMatchResultRecordResponse{
+		MatchId:   result.MatchID,
+		HomeScore: result.HomeScore,
+		AwayScore: result.AwayScore,
+		Started:   result.Started,
+		Finished:  result.Finished,
+		Cancelled: result.Cancelled,
+		Finalized: result.Finalized,
+		Key:       types.MatchResultKey(req.MatchId),
+		Value:     result.Bytes(),
+		ProofPath: types.MatchResultProofPath,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource/flatbuffers"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Matches(ctx context.Context, req *types.QueryMatchesRequest) (*types.QueryMatchesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.FromTime < 0 || req.ToTime < 0 || (req.ToTime != 0 && req.FromTime > req.ToTime) {
+		return nil, status.Error(codes.InvalidArgument, "invalid time range")
+	}
+	if _, ok := types.MatchStatusFilter_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	var matches []types.Match
+	pageRes, err := query.FilteredPaginate(q.k.datasourceStore(ctx, MatchKey), req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// the unfinished match index shares the match prefix
+		if len(key) != 8 {
+			return false, nil
+		}
+
+		match, err := flatbuffers.NewMatchEncoder().DecodeFromBinary(value)
+		if err != nil {
+			return false, err
+		}
+		if !matchesFilter(req, match) {
+			return false, nil
+		}
+
+		finalized, err := q.k.IsMatchFinalized(ctx, int64(match.ID))
+		if err != nil {
+			return false, err
+		}
+		if req.Status == types.MATCH_STATUS_FILTER_FINALIZED && !finalized {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+
+		// the teams are stored apart, with the scores kept on the match
+		if match, err = q.k.GetMatch(ctx, match.ID); err != nil {
+			return false, err
+		}
+		matches = append(matches, matchProto(*match, finalized))
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMatchesResponse{Matches: matches, Pagination: pageRes}, nil
+}
+
+// matchesFilter reports whether a match passes the league, team, time and status filters of a request.
+// The finalized status is checked by the caller.
+func matchesFilter(req *types.QueryMatchesRequest, match *datasource.Match) bool {
+	if req.LeagueId != 0 && int64(match.LeagueID) != req.LeagueId {
+		return false
+	}
+	if req.TeamId != 0 && int64(match.Home.ID) != req.TeamId && int64(match.Away.ID) != req.TeamId {
+		return false
+	}
+
+	kickoff := match.Status.UtcTime.Unix()
+	if req.FromTime != 0 && kickoff < req.FromTime {
+		return false
+	}
+	if req.ToTime != 0 && kickoff > req.ToTime {
+		return false
+	}
+
+	s := match.Status
+	switch req.Status {
+	case types.MATCH_STATUS_FILTER_UPCOMING:
+		return !s.Started && !s.Cancelled
+	case types.MATCH_STATUS_FILTER_LIVE:
+		return s.Started && !s.Finished && !s.Cancelled
+	case types.MATCH_STATUS_FILTER_FINISHED:
+		return s.Finished
+	case types.MATCH_STATUS_FILTER_CANCELLED:
+		return s.Cancelled
+	}
+	return true
+}
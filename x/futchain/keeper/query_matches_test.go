@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestListQueries(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	for _, league := range []datasource.League{{ID: 1, Name: "Premier League", Ccode: "ENG"}, {ID: 2, Name: "La Liga", Ccode: "ESP"}} {
+		require.NoError(t, f.keeper.SetLeague(f.ctx, league))
+	}
+	teams := []datasource.Team{{ID: 1, Name: "Home", LongName: "Home FC"}, {ID: 2, Name: "Away"}, {ID: 3, Name: "Third"}}
+	for _, team := range teams {
+		require.NoError(t, f.keeper.SetTeam(f.ctx, team))
+	}
+
+	kickoff := time.Unix(1_700_000_000, 0)
+	matches := []datasource.Match{
+		{ID: 10, LeagueID: 1, Home: datasource.Team{ID: 1, Score: 2}, Away: datasource.Team{ID: 2, Score: 1},
+			Status: datasource.Status{UtcTime: kickoff, Started: true, Finished: true}},
+		{ID: 11, LeagueID: 1, Home: datasource.Team{ID: 2}, Away: datasource.Team{ID: 3},
+			Status: datasource.Status{UtcTime: kickoff.Add(time.Hour), Started: true, LiveTime: datasource.LiveTime{Long: "51:35"}}},
+		{ID: 12, LeagueID: 2, Home: datasource.Team{ID: 3}, Away: datasource.Team{ID: 1},
+			Status: datasource.Status{UtcTime: kickoff.Add(2 * time.Hour)}},
+	}
+	for _, match := range matches {
+		require.NoError(t, f.keeper.SetMatch(f.ctx, match))
+		require.NoError(t, f.keeper.SaveUnfinishedMatch(f.ctx, match))
+	}
+	require.NoError(t, f.keeper.FinalizedMatches.Set(f.ctx, 10, 1))
+
+	leagues, err := qs.Leagues(f.ctx, &types.QueryLeaguesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.League{{Id: 1, Name: "Premier League", Ccode: "ENG"}, {Id: 2, Name: "La Liga", Ccode: "ESP"}}, leagues.Leagues)
+
+	teamsRes, err := qs.Teams(f.ctx, &types.QueryTeamsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, teamsRes.Teams, 2)
+	require.Equal(t, types.Team{Id: 1, Name: "Home", LongName: "Home FC"}, teamsRes.Teams[0])
+	require.EqualValues(t, 3, teamsRes.Pagination.Total)
+	require.NotNil(t, teamsRes.Pagination.NextKey)
+
+	ids := func(req *types.QueryMatchesRequest) []int64 {
+		res, err := qs.Matches(f.ctx, req)
+		require.NoError(t, err)
+		var ids []int64
+		for _, match := range res.Matches {
+			ids = append(ids, match.Id)
+		}
+		return ids
+	}
+
+	// the unfinished match index is not listed
+	all, err := qs.Matches(f.ctx, &types.QueryMatchesRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, all.Matches, 3)
+	require.EqualValues(t, 3, all.Pagination.Total)
+
+	match := all.Matches[0]
+	require.Equal(t, types.Team{Id: 1, Name: "Home", LongName: "Home FC"}, match.Home)
+	require.EqualValues(t, 2, match.HomeScore)
+	require.EqualValues(t, 1, match.AwayScore)
+	require.True(t, match.Finalized)
+	require.Equal(t, kickoff.UTC(), match.Status.UtcTime)
+	require.Equal(t, "51:35", all.Matches[1].Status.LiveTime.Long)
+
+	require.Equal(t, []int64{10, 11}, ids(&types.QueryMatchesRequest{LeagueId: 1}))
+	require.Equal(t, []int64{10, 12}, ids(&types.QueryMatchesRequest{TeamId: 1}))
+	require.Equal(t, []int64{11, 12}, ids(&types.QueryMatchesRequest{FromTime: kickoff.Add(time.Minute).Unix()}))
+	require.Equal(t, []int64{10, 11}, ids(&types.QueryMatchesRequest{ToTime: kickoff.Add(time.Hour).Unix()}))
+	require.Equal(t, []int64{12}, ids(&types.QueryMatchesRequest{Status: types.MATCH_STATUS_FILTER_UPCOMING}))
+	require.Equal(t, []int64{11}, ids(&types.QueryMatchesRequest{Status: types.MATCH_STATUS_FILTER_LIVE}))
+	require.Equal(t, []int64{10}, ids(&types.QueryMatchesRequest{Status: types.MATCH_STATUS_FILTER_FINALIZED}))
+	require.Empty(t, ids(&types.QueryMatchesRequest{Status: types.MATCH_STATUS_FILTER_CANCELLED}))
+	require.Equal(t, []int64{12}, ids(&types.QueryMatchesRequest{TeamId: 3, Pagination: &query.PageRequest{Offset: 1}}))
+
+	_, err = qs.Matches(f.ctx, &types.QueryMatchesRequest{FromTime: 2, ToTime: 1})
+	require.Error(t, err)
+}
+
+func TestItemQueries(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	league := datasource.League{ID: 5, Name: "Group A", GroupName: "A", IsGroup: true, Ccode: "INT", PrimaryID: 4}
+	require.NoError(t, f.keeper.SetLeague(f.ctx, league))
+	require.NoError(t, f.keeper.SetTeam(f.ctx, datasource.Team{ID: 1, Name: "Home", LongName: "Home FC"}))
+	require.NoError(t, f.keeper.SetTeam(f.ctx, datasource.Team{ID: 2, Name: "Away", LongName: "Away FC"}))
+	kickoff := time.Unix(1_700_000_000, 0)
+	require.NoError(t, f.keeper.SetMatch(f.ctx, datasource.Match{
+		ID: 10, LeagueID: 5, Time: "14.11.2023 22:13", StatusID: 2, TournamentStage: "1", TimeTS: kickoff.UnixMilli(),
+		Home: datasource.Team{ID: 1, Score: 1}, Away: datasource.Team{ID: 2},
+		Status: datasource.Status{UtcTime: kickoff, PeriodLength: 45, Started: true, Ongoing: true,
+			LiveTime: datasource.LiveTime{Long: "51:35", MaxTime: 90, AddedTime: 2}},
+	}))
+
+	leagueRes, err := qs.League(f.ctx, &types.QueryLeagueRequest{Id: 5})
+	require.NoError(t, err)
+	require.Equal(t, types.League{Id: 5, Name: "Group A", GroupName: "A", IsGroup: true, Ccode: "INT", PrimaryId: 4}, leagueRes.League)
+
+	teamRes, err := qs.Team(f.ctx, &types.QueryTeamRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.Team{Id: 1, Name: "Home", LongName: "Home FC"}, teamRes.Team)
+
+	matchRes, err := qs.Match(f.ctx, &types.QueryMatchRequest{Id: 10})
+	require.NoError(t, err)
+	require.Equal(t, types.Match{
+		Id: 10, LeagueId: 5, Time: "14.11.2023 22:13", StatusId: 2, TournamentStage: "1", TimeTs: kickoff.UnixMilli(),
+		Home:      types.Team{Id: 1, Name: "Home", LongName: "Home FC"},
+		Away:      types.Team{Id: 2, Name: "Away", LongName: "Away FC"},
+		HomeScore: 1,
+		Status: types.Status{UtcTime: kickoff.UTC(), PeriodLength: 45, Started: true, Ongoing: true,
+			LiveTime: types.LiveTime{Long: "51:35", MaxTime: 90, AddedTime: 2}},
+	}, matchRes.Match)
+
+	// missing items are not found, a match with a missing team is a node fault
+	_, err = qs.League(f.ctx, &types.QueryLeagueRequest{Id: 6})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = qs.Team(f.ctx, &types.QueryTeamRequest{Id: 3})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = qs.Match(f.ctx, &types.QueryMatchRequest{Id: 11})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	require.NoError(t, f.keeper.SetMatch(f.ctx, datasource.Match{ID: 11, Home: datasource.Team{ID: 1}, Away: datasource.Team{ID: 3}}))
+	_, err = qs.Match(f.ctx, &types.QueryMatchRequest{Id: 11})
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	_, err = f.keeper.GetMatch(f.ctx, 12)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) OracleRewards(ctx context.Context, req *types.QueryOracleRewardsRequest) (*types.QueryOracleRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := q.k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+
+	rewards, err := q.k.GetOracleRewards(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	weight, err := q.k.GetOracleRewardWeight(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOracleRewardsResponse{Rewards: rewards, EpochWeight: weight}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) OutcomeTokens(ctx context.Context, req *types.QueryOutcomeTokensRequest) (*types.QueryOutcomeTokensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MatchId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid match id")
+	}
+
+	collateral, err := q.k.GetOutcomeCollateral(ctx, req.MatchId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOutcomeTokensResponse{
+		Tokens:     q.k.OutcomeTokens(req.MatchId),
+		Collateral: collateral,
+	}, nil
+}
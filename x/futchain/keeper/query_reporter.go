@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Reporter(ctx context.Context, req *types.QueryReporterRequest) (*types.QueryReporterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	reporterAddr, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reporter address")
+	}
+
+	reporter, err := q.k.Reporters.Get(ctx, reporterAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "reporter not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReporterResponse{Reporter: reporter}, nil
+}
+
+func (q queryServer) Reporters(ctx context.Context, req *types.QueryReportersRequest) (*types.QueryReportersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	reporters, pageRes, err := query.CollectionPaginate(ctx, q.k.Reporters, req.Pagination,
+		func(_ []byte, reporter types.Reporter) (types.Reporter, error) {
+			return reporter, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReportersResponse{Reporters: reporters, Pagination: pageRes}, nil
+}
+
+func (q queryServer) MatchReports(ctx context.Context, req *types.QueryMatchReportsRequest) (*types.QueryMatchReportsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	reports, pageRes, err := query.CollectionPaginate(ctx, q.k.ReporterReports, req.Pagination,
+		func(_ collections.Pair[int64, []byte], report types.MatchReport) (types.MatchReport, error) {
+			return report, nil
+		},
+		query.WithCollectionPaginationPairPrefix[int64, []byte](req.MatchId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMatchReportsResponse{Reports: reports, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ReportRound(ctx context.Context, req *types.QueryReportRoundRequest) (*types.QueryReportRoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !commitRevealEnabled(params) {
+		return nil, status.Error(codes.FailedPrecondition, "commit-reveal is disabled")
+	}
+
+	round, revealing, commitEnd, revealEnd := ReportRound(params, sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return &types.QueryReportRoundResponse{Round: round, Revealing: revealing, CommitEndHeight: commitEnd, RevealEndHeight: revealEnd}, nil
+}
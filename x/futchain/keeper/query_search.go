@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) SearchTeams(ctx context.Context, req *types.QuerySearchTeamsRequest) (*types.QuerySearchTeamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	found, err := q.k.SearchTeams(ctx, req.Query, req.Limit)
+	if err != nil {
+		return nil, searchError(err)
+	}
+
+	teams := make([]types.Team, len(found))
+	for i, team := range found {
+		teams[i] = teamProto(team)
+	}
+	return &types.QuerySearchTeamsResponse{Teams: teams}, nil
+}
+
+func (q queryServer) SearchLeagues(ctx context.Context, req *types.QuerySearchLeaguesRequest) (*types.QuerySearchLeaguesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	found, err := q.k.SearchLeagues(ctx, req.Query, req.Limit)
+	if err != nil {
+		return nil, searchError(err)
+	}
+
+	leagues := make([]types.League, len(found))
+	for i, league := range found {
+		leagues[i] = leagueProto(league)
+	}
+	return &types.QuerySearchLeaguesResponse{Leagues: leagues}, nil
+}
+
+// searchError returns the status of a search error: an invalid argument for an invalid query.
+func searchError(err error) error {
+	if errors.Is(err, types.ErrInvalidSearch) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"github.com/raifpy/futchain/x/futchain/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,11 +21,11 @@ func (q queryServer) Team(ctx context.Context, req *types.QueryTeamRequest) (*ty
 
 	team, err := q.k.GetTeam(ctx, int(req.Id))
 	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "team not found")
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryTeamResponse{
-		Id:   int64(team.ID),
-		Name: team.Name,
-	}, nil
+	return &types.QueryTeamResponse{Team: teamProto(*team)}, nil
 }
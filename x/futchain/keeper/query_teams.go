@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource/flatbuffers"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Teams(ctx context.Context, req *types.QueryTeamsRequest) (*types.QueryTeamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var teams []types.Team
+	pageRes, err := query.Paginate(q.k.datasourceStore(ctx, TeamKey), req.Pagination, func(_, value []byte) error {
+		team, err := flatbuffers.NewTeamEncoder().DecodeFromBinary(value)
+		if err != nil {
+			return err
+		}
+		teams = append(teams, teamProto(*team))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTeamsResponse{Teams: teams, Pagination: pageRes}, nil
+}
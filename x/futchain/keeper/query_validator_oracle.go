@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/raifpy/futchain/x/futchain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ValidatorOracleInfo(ctx context.Context, req *types.QueryValidatorOracleInfoRequest) (*types.QueryValidatorOracleInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := q.k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+
+	info, err := q.k.ValidatorOracleInfos.Get(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "validator oracle info not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorOracleInfoResponse{Info: info}, nil
+}
+
+func (q queryServer) ValidatorOracleReports(ctx context.Context, req *types.QueryValidatorOracleReportsRequest) (*types.QueryValidatorOracleReportsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := q.k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+
+	reports, pageRes, err := query.CollectionPaginate(ctx, q.k.ValidatorOracleReports, req.Pagination,
+		func(key collections.Pair[[]byte, int64], _ collections.NoValue) (types.OracleReport, error) {
+			return q.k.OracleReports.Get(ctx, collections.Join(key.K2(), key.K1()))
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, int64](valAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorOracleReportsResponse{Reports: reports, Pagination: pageRes}, nil
+}
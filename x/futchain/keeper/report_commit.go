@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// commitRevealEnabled reports whether reporters have to commit to their reports before revealing them.
+func commitRevealEnabled(params types.Params) bool {
+	return params.ReportCommitBlocks > 0 && params.ReportRevealBlocks > 0
+}
+
+// ReportRound returns the commit-reveal round at the height, whether the height is in its reveal phase,
+// and the last heights of its commit and reveal phases. Rounds start every commit plus reveal blocks,
+// from genesis.
+func ReportRound(params types.Params, height int64) (round uint64, revealing bool, commitEnd, revealEnd int64) {
+	commitBlocks, roundBlocks := int64(params.ReportCommitBlocks), int64(params.ReportCommitBlocks+params.ReportRevealBlocks)
+	start := height - height%roundBlocks
+	return uint64(height / roundBlocks), height-start >= commitBlocks, start + commitBlocks - 1, start + roundBlocks - 1
+}
+
+// CommitReport stores the hash an active reporter commits to for its report on a match, in the commit
+// phase of the current round. A new commit on the same match replaces the previous one.
+func (k *Keeper) CommitReport(ctx context.Context, commit types.ReportCommit) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !commitRevealEnabled(params) {
+		return 0, errorsmod.Wrap(types.ErrInvalidReportCommit, "commit-reveal is disabled, submit the report instead")
+	}
+
+	reporterAddr, err := k.addressCodec.StringToBytes(commit.Reporter)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "invalid reporter address")
+	}
+	if _, err := k.getActiveReporter(ctx, reporterAddr); err != nil {
+		return 0, err
+	}
+
+	if commit.MatchId <= 0 {
+		return 0, errorsmod.Wrapf(types.ErrInvalidReportCommit, "invalid match id %d", commit.MatchId)
+	}
+	if len(commit.Hash) != sha256.Size {
+		return 0, errorsmod.Wrapf(types.ErrInvalidReportCommit, "hash must be %d bytes, got %d", sha256.Size, len(commit.Hash))
+	}
+	if finalized, err := k.IsMatchFinalized(ctx, commit.MatchId); err != nil {
+		return 0, err
+	} else if finalized {
+		return 0, errorsmod.Wrapf(types.ErrInvalidReportCommit, "match %d is already finalized", commit.MatchId)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	round, revealing, _, revealEnd := ReportRound(params, sdkCtx.BlockHeight())
+	if revealing {
+		return 0, errorsmod.Wrapf(types.ErrInvalidReportCommit, "round %d is in its reveal phase until height %d", round, revealEnd)
+	}
+
+	commit.Round = round
+	commit.Height = sdkCtx.BlockHeight()
+	if err := k.ReportCommits.Set(ctx, collections.Join3(round, commit.MatchId, reporterAddr), commit); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("match_report_committed",
+		sdk.NewAttribute("reporter", commit.Reporter),
+		sdk.NewAttribute("match_id", strconv.FormatInt(commit.MatchId, 10)),
+		sdk.NewAttribute("round", strconv.FormatUint(round, 10)),
+		sdk.NewAttribute("hash", hex.EncodeToString(commit.Hash)),
+	))
+	return round, nil
+}
+
+// RevealReport checks a report against the commit of its reporter in the current round, in the reveal
+// phase of the round, and stores the report.
+func (k *Keeper) RevealReport(ctx context.Context, report types.MatchReport, salt []byte) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !commitRevealEnabled(params) {
+		return errorsmod.Wrap(types.ErrInvalidReportCommit, "commit-reveal is disabled, submit the report instead")
+	}
+
+	reporterAddr, err := k.addressCodec.StringToBytes(report.Reporter)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid reporter address")
+	}
+
+	round, revealing, commitEnd, _ := ReportRound(params, sdk.UnwrapSDKContext(ctx).BlockHeight())
+	if !revealing {
+		return errorsmod.Wrapf(types.ErrInvalidReportCommit, "round %d is in its commit phase until height %d", round, commitEnd)
+	}
+
+	key := collections.Join3(round, report.MatchId, reporterAddr)
+	commit, err := k.ReportCommits.Get(ctx, key)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrInvalidReportCommit, "no commit on match %d in round %d", report.MatchId, round)
+	} else if err != nil {
+		return err
+	}
+
+	hash, err := types.ReportCommitHash(report, salt)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, commit.Hash) {
+		return errorsmod.Wrapf(types.ErrInvalidReportCommit, "report does not match the commit on match %d", report.MatchId)
+	}
+
+	// an invalid report is not revealed, and its commit is penalized at the end of the round
+	if err := k.storeMatchReport(ctx, reporterAddr, report); err != nil {
+		return err
+	}
+	return k.ReportCommits.Remove(ctx, key)
+}
+
+// ExpireReportCommits removes the commits of the rounds whose reveal phase ends with this block. Their
+// reporters lose the non-reveal slash fraction of their bond.
+func (k *Keeper) ExpireReportCommits(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !commitRevealEnabled(params) {
+		// commits left from before the scheme was disabled can not be revealed anymore
+		return k.ReportCommits.Clear(ctx, nil)
+	}
+
+	round, _, _, revealEnd := ReportRound(params, ctx.BlockHeight())
+	if ctx.BlockHeight() == revealEnd {
+		round++
+	}
+
+	iterator, err := k.ReportCommits.Iterate(ctx, new(collections.Range[collections.Triple[uint64, int64, []byte]]).
+		EndExclusive(collections.Join3(round, int64(math.MinInt64), []byte{})))
+	if err != nil {
+		return err
+	}
+	entries, err := iterator.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := k.ReportCommits.Remove(ctx, entry.Key); err != nil {
+			return err
+		}
+
+		reporterAddr := entry.Key.K3()
+		reporter, err := k.Reporters.Get(ctx, reporterAddr)
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		reporter.MissedReveals++
+		slashed, err := k.slashReporterBond(ctx, &reporter, params.ReporterNonRevealSlashFraction)
+		if err != nil {
+			return err
+		}
+		if err := k.Reporters.Set(ctx, reporterAddr, reporter); err != nil {
+			return err
+		}
+
+		ctx.Logger().Info("slashed reporter for an unrevealed report", "reporter", reporter.Address, "match", entry.Value.MatchId, "round", entry.Value.Round, "amount", slashed)
+		ctx.EventManager().EmitEvent(sdk.NewEvent("reporter_slashed",
+			sdk.NewAttribute("reporter", reporter.Address),
+			sdk.NewAttribute("match_id", strconv.FormatInt(entry.Value.MatchId, 10)),
+			sdk.NewAttribute("round", strconv.FormatUint(entry.Value.Round, 10)),
+			sdk.NewAttribute("reason", "unrevealed"),
+			sdk.NewAttribute("amount", slashed.String()),
+		))
+	}
+
+	return nil
+}
@@ -0,0 +1,551 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// RegisterReporter bonds tokens of the bond denom to register an account as a data reporter. Active
+// reporters add the bond to their existing one, and update their moniker if it is given.
+func (k *Keeper) RegisterReporter(ctx context.Context, reporterAddr sdk.AccAddress, moniker string, bond sdk.Coin) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if !bond.IsValid() || !bond.IsPositive() || bond.Denom != bondDenom {
+		return errorsmod.Wrapf(types.ErrInvalidReporter, "bond must be a positive amount of %s, got %s", bondDenom, bond)
+	}
+
+	reporter, err := k.Reporters.Get(ctx, reporterAddr)
+	switch {
+	case errorsmod.IsOf(err, collections.ErrNotFound):
+		if bond.Amount.LT(params.MinReporterBond) {
+			return errorsmod.Wrapf(types.ErrInvalidReporter, "bond %s is lower than the minimum %s%s", bond, params.MinReporterBond, bondDenom)
+		}
+		address, err := k.addressCodec.BytesToString(reporterAddr)
+		if err != nil {
+			return err
+		}
+		reporter = types.Reporter{
+			Address: address,
+			Bond:    sdk.NewCoin(bondDenom, math.ZeroInt()),
+			Status:  types.REPORTER_STATUS_ACTIVE,
+		}
+	case err != nil:
+		return err
+	case reporter.Status != types.REPORTER_STATUS_ACTIVE:
+		return errorsmod.Wrapf(types.ErrInvalidReporter, "reporter %s is unbonding", reporter.Address)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, reporterAddr, types.ReporterBondsPoolName, sdk.NewCoins(bond)); err != nil {
+		return err
+	}
+	reporter.Bond = reporter.Bond.Add(bond)
+	if moniker != "" {
+		reporter.Moniker = moniker
+	}
+	if err := k.Reporters.Set(ctx, reporterAddr, reporter); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("reporter_bonded",
+		sdk.NewAttribute("reporter", reporter.Address),
+		sdk.NewAttribute("amount", bond.String()),
+		sdk.NewAttribute("bond", reporter.Bond.String()),
+	))
+	return nil
+}
+
+// UnbondReporter starts unbonding an active reporter and returns the height its bond is returned at.
+// The reports of the reporter on unfinalized matches are still tallied until then.
+func (k *Keeper) UnbondReporter(ctx context.Context, reporterAddr sdk.AccAddress) (int64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	reporter, err := k.Reporters.Get(ctx, reporterAddr)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidReporter, "account %s is not a reporter", reporterAddr)
+	} else if err != nil {
+		return 0, err
+	}
+	if reporter.Status != types.REPORTER_STATUS_ACTIVE {
+		return 0, errorsmod.Wrapf(types.ErrInvalidReporter, "reporter %s is already unbonding", reporter.Address)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reporter.Status = types.REPORTER_STATUS_UNBONDING
+	reporter.UnbondingHeight = sdkCtx.BlockHeight() + int64(params.ReporterUnbondingBlocks)
+	if err := k.Reporters.Set(ctx, reporterAddr, reporter); err != nil {
+		return 0, err
+	}
+	if err := k.UnbondingReporters.Set(ctx, collections.Join(reporter.UnbondingHeight, []byte(reporterAddr))); err != nil {
+		return 0, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("reporter_unbonding",
+		sdk.NewAttribute("reporter", reporter.Address),
+		sdk.NewAttribute("unbonding_height", strconv.FormatInt(reporter.UnbondingHeight, 10)),
+	))
+	return reporter.UnbondingHeight, nil
+}
+
+// ReleaseReporterBonds returns the bonds of the reporters whose unbonding height has been reached, and
+// removes them.
+func (k *Keeper) ReleaseReporterBonds(ctx sdk.Context) error {
+	iterator, err := k.UnbondingReporters.Iterate(ctx, new(collections.Range[collections.Pair[int64, []byte]]).
+		EndExclusive(collections.Join(ctx.BlockHeight()+1, []byte{})))
+	if err != nil {
+		return err
+	}
+	keys, err := iterator.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.UnbondingReporters.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		reporter, err := k.Reporters.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if reporter.Bond.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ReporterBondsPoolName, key.K2(), sdk.NewCoins(reporter.Bond)); err != nil {
+				return err
+			}
+		}
+		if err := k.Reporters.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent("reporter_unbonded",
+			sdk.NewAttribute("reporter", reporter.Address),
+			sdk.NewAttribute("amount", reporter.Bond.String()),
+		))
+	}
+
+	return nil
+}
+
+// SubmitMatchReport stores the plaintext report of an active reporter on the state of a match. It is only
+// accepted while the commit-reveal scheme is disabled.
+func (k *Keeper) SubmitMatchReport(ctx context.Context, report types.MatchReport) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if commitRevealEnabled(params) {
+		return errorsmod.Wrap(types.ErrInvalidMatchReport, "plaintext reports are disabled, commit and reveal the report instead")
+	}
+
+	reporterAddr, err := k.addressCodec.StringToBytes(report.Reporter)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid reporter address")
+	}
+	if _, err := k.getActiveReporter(ctx, reporterAddr); err != nil {
+		return err
+	}
+
+	return k.storeMatchReport(ctx, reporterAddr, report)
+}
+
+// getActiveReporter returns the reporter of the address, or an error if it is not an active reporter.
+func (k *Keeper) getActiveReporter(ctx context.Context, reporterAddr []byte) (types.Reporter, error) {
+	reporter, err := k.Reporters.Get(ctx, reporterAddr)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return types.Reporter{}, errorsmod.Wrapf(types.ErrInvalidReporter, "account %s is not a reporter", sdk.AccAddress(reporterAddr))
+	} else if err != nil {
+		return types.Reporter{}, err
+	}
+	if reporter.Status != types.REPORTER_STATUS_ACTIVE {
+		return types.Reporter{}, errorsmod.Wrapf(types.ErrInvalidReporter, "reporter %s is not active", reporter.Address)
+	}
+	return reporter, nil
+}
+
+// storeMatchReport stores the report of a reporter on the state of a stored match, replacing its previous
+// report, and queues the match for aggregation. Reports are accepted until the match is finalized.
+func (k *Keeper) storeMatchReport(ctx context.Context, reporterAddr []byte, report types.MatchReport) error {
+	if err := validateMatchReport(report); err != nil {
+		return err
+	}
+	if err := k.canonicalizeReport(ctx, &report); err != nil {
+		return err
+	}
+	// the reporters only report on the matches the chain knows, they cannot create any
+	if found, err := k.hasEntity(ctx, types.ENTITY_TYPE_MATCH, report.MatchId); err != nil {
+		return err
+	} else if !found {
+		return errorsmod.Wrapf(types.ErrInvalidMatchReport, "unknown match %d", report.MatchId)
+	}
+	if finalized, err := k.IsMatchFinalized(ctx, report.MatchId); err != nil {
+		return err
+	} else if finalized {
+		return errorsmod.Wrapf(types.ErrInvalidMatchReport, "match %d is already finalized", report.MatchId)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	report.Height = sdkCtx.BlockHeight()
+	if err := k.ReporterReports.Set(ctx, collections.Join(report.MatchId, reporterAddr), report); err != nil {
+		return err
+	}
+	if err := k.PendingMatchReports.Set(ctx, report.MatchId); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("match_report_submitted",
+		sdk.NewAttribute("reporter", report.Reporter),
+		sdk.NewAttribute("match_id", strconv.FormatInt(report.MatchId, 10)),
+		sdk.NewAttribute("home_score", strconv.FormatInt(report.HomeScore, 10)),
+		sdk.NewAttribute("away_score", strconv.FormatInt(report.AwayScore, 10)),
+		sdk.NewAttribute("finished", strconv.FormatBool(report.Finished)),
+		sdk.NewAttribute("cancelled", strconv.FormatBool(report.Cancelled)),
+	))
+	return nil
+}
+
+// canonicalizeReport replaces the ids of types.ReporterProvider a report carries with the canonical ids they
+// are mapped to, so the reports are stored, aggregated and tallied by the canonical ids. Reports with ids
+// that are not mapped are rejected: the reporters cannot allocate canonical ids.
+func (k *Keeper) canonicalizeReport(ctx context.Context, report *types.MatchReport) error {
+	for _, id := range []struct {
+		entityType types.EntityType
+		id         *int64
+	}{
+		{types.ENTITY_TYPE_MATCH, &report.MatchId},
+		{types.ENTITY_TYPE_LEAGUE, &report.LeagueId},
+		{types.ENTITY_TYPE_TEAM, &report.HomeId},
+		{types.ENTITY_TYPE_TEAM, &report.AwayId},
+	} {
+		canonicalID, err := k.CanonicalID(ctx, id.entityType, types.ReporterProvider, strconv.FormatInt(*id.id, 10))
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(types.ErrInvalidMatchReport, "%s id %d is not mapped", id.entityType, *id.id)
+		} else if err != nil {
+			return err
+		}
+		*id.id = canonicalID
+	}
+	return nil
+}
+
+func validateMatchReport(report types.MatchReport) error {
+	if report.MatchId <= 0 || report.LeagueId <= 0 || report.HomeId <= 0 || report.AwayId <= 0 {
+		return errorsmod.Wrap(types.ErrInvalidMatchReport, "match, league and team ids must be positive")
+	}
+	if report.HomeId == report.AwayId {
+		return errorsmod.Wrap(types.ErrInvalidMatchReport, "home and away teams must differ")
+	}
+	if report.HomeScore < 0 || report.AwayScore < 0 {
+		return errorsmod.Wrapf(types.ErrInvalidMatchReport, "invalid score %d - %d", report.HomeScore, report.AwayScore)
+	}
+	if report.Finished && !report.Started && !report.Cancelled {
+		return errorsmod.Wrap(types.ErrInvalidMatchReport, "a finished match must have started")
+	}
+	return nil
+}
+
+// matchReportResult is the part of a report the reporters have to agree on.
+type matchReportResult struct {
+	leagueID, homeID, awayID     int64
+	homeScore, awayScore         int64
+	started, finished, cancelled bool
+}
+
+func newMatchReportResult(report types.MatchReport) matchReportResult {
+	return matchReportResult{
+		leagueID:  report.LeagueId,
+		homeID:    report.HomeId,
+		awayID:    report.AwayId,
+		homeScore: report.HomeScore,
+		awayScore: report.AwayScore,
+		started:   report.Started,
+		finished:  report.Finished,
+		cancelled: report.Cancelled,
+	}
+}
+
+// AggregateMatchReports aggregates the reports on the matches reported since the last call. A match is
+// returned, grouped by league like the data source does, if the reports agreeing on its state carry more
+// than the reporter quorum of the active reporter bond. Nothing is aggregated while there are fewer active
+// reporters than the min reporters, or less active bond than the min total reporter bond, so a few
+// reporters cannot form a quorum on their own.
+func (k *Keeper) AggregateMatchReports(ctx sdk.Context) ([]datasource.League, error) {
+	iterator, err := k.PendingMatchReports.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	matchIDs, err := iterator.Keys()
+	if err != nil {
+		return nil, err
+	}
+	if len(matchIDs) == 0 {
+		return nil, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bonds := make(map[string]math.Int)
+	totalBond := math.ZeroInt()
+	if err := k.Reporters.Walk(ctx, nil, func(addr []byte, reporter types.Reporter) (bool, error) {
+		if reporter.Status == types.REPORTER_STATUS_ACTIVE {
+			bonds[string(addr)] = reporter.Bond.Amount
+			totalBond = totalBond.Add(reporter.Bond.Amount)
+		}
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	quorum := params.ReporterQuorum.MulInt(totalBond)
+	if len(bonds) < int(params.MinReporters) || totalBond.LT(params.MinTotalReporterBond) {
+		ctx.Logger().Debug("active reporters are below the minimum, match reports are not aggregated", "reporters", len(bonds), "bond", totalBond)
+		totalBond = math.ZeroInt()
+	}
+
+	var leagues []datasource.League
+	leagueIndex := make(map[int]int)
+	for _, matchID := range matchIDs {
+		if err := k.PendingMatchReports.Remove(ctx, matchID); err != nil {
+			return nil, err
+		}
+		if totalBond.IsZero() {
+			continue
+		}
+
+		var (
+			results []matchReportResult
+			reports []types.MatchReport
+			weights []math.Int
+		)
+		if err := k.ReporterReports.Walk(ctx, collections.NewPrefixedPairRange[int64, []byte](matchID), func(key collections.Pair[int64, []byte], report types.MatchReport) (bool, error) {
+			bond, active := bonds[string(key.K2())]
+			if !active {
+				return false, nil
+			}
+			result := newMatchReportResult(report)
+			for i := range results {
+				if results[i] == result {
+					weights[i] = weights[i].Add(bond)
+					return false, nil
+				}
+			}
+			results, reports, weights = append(results, result), append(reports, report), append(weights, bond)
+			return false, nil
+		}); err != nil {
+			return nil, err
+		}
+
+		best := -1
+		for i := range weights {
+			if math.LegacyNewDecFromInt(weights[i]).GT(quorum) && (best < 0 || weights[i].GT(weights[best])) {
+				best = i
+			}
+		}
+		if best < 0 {
+			ctx.Logger().Debug("match reports have no quorum", "match", matchID)
+			continue
+		}
+
+		match, err := k.reportedMatch(ctx, reports[best])
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			// the match has been merged away since
+			ctx.Logger().Debug("reported match is not stored", "match", matchID)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		i, found := leagueIndex[match.LeagueID]
+		if !found {
+			i = len(leagues)
+			leagueIndex[match.LeagueID] = i
+			leagues = append(leagues, datasource.League{ID: match.LeagueID, Name: reports[best].LeagueName})
+		}
+		leagues[i].Matches = append(leagues[i].Matches, match)
+	}
+
+	return leagues, nil
+}
+
+// reportedMatch returns the stored match of a report with the reported state overlaid, so the details the
+// reports do not carry are kept. It returns collections.ErrNotFound if the match is not stored.
+func (k *Keeper) reportedMatch(ctx context.Context, report types.MatchReport) (datasource.Match, error) {
+	stored, err := k.GetMatch(ctx, int(report.MatchId))
+	if err != nil {
+		return datasource.Match{}, err
+	}
+	match := *stored
+
+	match.Home.Score = int(report.HomeScore)
+	match.Away.Score = int(report.AwayScore)
+	match.Status.Started = report.Started
+	match.Status.Finished = report.Finished
+	match.Status.Cancelled = report.Cancelled
+	match.Status.Ongoing = report.Started && !report.Finished && !report.Cancelled
+	match.Ongoing = match.Status.Ongoing
+	return match, nil
+}
+
+// TallyMatchReports compares the reports on a match with its finalized result. Reporters that agreed are
+// paid the reporter reward from the reporter rewards pool, see payReporterReward, the others lose the
+// reporter slash fraction of their bond. The reports on the match are removed.
+func (k *Keeper) TallyMatchReports(ctx sdk.Context, match *datasource.Match) error {
+	matchID := int64(match.ID)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	iterator, err := k.ReporterReports.Iterate(ctx, collections.NewPrefixedPairRange[int64, []byte](matchID))
+	if err != nil {
+		return err
+	}
+	entries, err := iterator.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		reporterAddr, report := entry.Key.K2(), entry.Value
+		if err := k.ReporterReports.Remove(ctx, entry.Key); err != nil {
+			return err
+		}
+
+		reporter, err := k.Reporters.Get(ctx, reporterAddr)
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			// the reporter has unbonded since
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		accurate := report.HomeScore == int64(match.Home.Score) && report.AwayScore == int64(match.Away.Score) && report.Cancelled == match.Status.Cancelled
+		if accurate {
+			reporter.AccurateReports++
+			reward, err := k.payReporterReward(ctx, params, reporterAddr, reporter.Bond.Denom)
+			if err != nil {
+				return err
+			}
+			if reward.IsPositive() {
+				ctx.EventManager().EmitEvent(sdk.NewEvent("reporter_rewarded",
+					sdk.NewAttribute("reporter", reporter.Address),
+					sdk.NewAttribute("match_id", strconv.FormatInt(matchID, 10)),
+					sdk.NewAttribute("amount", reward.String()),
+				))
+			}
+		} else {
+			reporter.SlashedReports++
+			slashed, err := k.slashReporterBond(ctx, &reporter, params.ReporterSlashFraction)
+			if err != nil {
+				return err
+			}
+			ctx.Logger().Info("slashed reporter for a deviating report", "reporter", reporter.Address, "match", matchID, "amount", slashed)
+			ctx.EventManager().EmitEvent(sdk.NewEvent("reporter_slashed",
+				sdk.NewAttribute("reporter", reporter.Address),
+				sdk.NewAttribute("match_id", strconv.FormatInt(matchID, 10)),
+				sdk.NewAttribute("reason", "deviated"),
+				sdk.NewAttribute("amount", slashed.String()),
+			))
+		}
+
+		if err := k.Reporters.Set(ctx, reporterAddr, reporter); err != nil {
+			return err
+		}
+	}
+
+	return k.PendingMatchReports.Remove(ctx, matchID)
+}
+
+// payReporterReward pays the reporter reward to a reporter from the reporter rewards pool and returns the
+// paid amount. The rewards paid in a reporter reward epoch are capped at the reporter reward epoch cap, and
+// by the balance of the pool; nothing is minted.
+func (k *Keeper) payReporterReward(ctx sdk.Context, params types.Params, reporterAddr []byte, denom string) (sdk.Coin, error) {
+	reward := sdk.NewCoin(denom, math.ZeroInt())
+	if params.ReporterReward.IsNil() || !params.ReporterReward.IsPositive() || params.ReporterRewardEpochBlocks == 0 ||
+		params.ReporterRewardEpochCap.IsNil() || !params.ReporterRewardEpochCap.IsPositive() {
+		return reward, nil
+	}
+
+	epoch := ctx.BlockHeight() / int64(params.ReporterRewardEpochBlocks)
+	paid, err := k.ReporterRewardsPaid.Get(ctx, epoch)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		// a new epoch, only its own payments are kept
+		if err := k.ReporterRewardsPaid.Clear(ctx, nil); err != nil {
+			return sdk.Coin{}, err
+		}
+		paid = math.ZeroInt()
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	pool := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ReporterRewardsPoolName), denom)
+	reward.Amount = math.MinInt(params.ReporterReward, math.MinInt(params.ReporterRewardEpochCap.Sub(paid), pool.Amount))
+	if !reward.IsPositive() {
+		ctx.Logger().Debug("reporter rewards are exhausted", "epoch", epoch, "paid", paid, "pool", pool)
+		return sdk.NewCoin(denom, math.ZeroInt()), nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ReporterRewardsPoolName, reporterAddr, sdk.NewCoins(reward)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.ReporterRewardsPaid.Set(ctx, epoch, paid.Add(reward.Amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+	return reward, nil
+}
+
+// FundReporterRewards adds tokens of the bond denom of a depositor to the reporter rewards pool.
+func (k *Keeper) FundReporterRewards(ctx context.Context, depositor sdk.AccAddress, amount sdk.Coin) error {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if !amount.IsValid() || !amount.IsPositive() || amount.Denom != bondDenom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be a positive amount of %s, got %s", bondDenom, amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ReporterRewardsPoolName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("reporter_rewards_funded",
+		sdk.NewAttribute("depositor", depositor.String()),
+		sdk.NewAttribute("amount", amount.String()),
+	))
+	return nil
+}
+
+// slashReporterBond moves a fraction of the bond of a reporter to the reporter rewards pool and returns the
+// slashed amount. The caller stores the reporter.
+func (k *Keeper) slashReporterBond(ctx context.Context, reporter *types.Reporter, fraction math.LegacyDec) (sdk.Coin, error) {
+	slashed := sdk.NewCoin(reporter.Bond.Denom, math.LegacyNewDecFromInt(reporter.Bond.Amount).Mul(fraction).TruncateInt())
+	if !slashed.IsPositive() {
+		return slashed, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ReporterBondsPoolName, types.ReporterRewardsPoolName, sdk.NewCoins(slashed)); err != nil {
+		return sdk.Coin{}, err
+	}
+	reporter.Bond = reporter.Bond.Sub(slashed)
+	return slashed, nil
+}
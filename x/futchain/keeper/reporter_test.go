@@ -0,0 +1,271 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// mapReporterIDs maps the ids the reporters use for a match, its league and its teams to the stored ones.
+func mapReporterIDs(t *testing.T, f *fixture, match datasource.Match) {
+	t.Helper()
+
+	_, err := f.keeper.SaveLeagueIfNotExists(f.ctx, datasource.League{ID: match.LeagueID, Name: "League"})
+	require.NoError(t, err)
+	for _, id := range []struct {
+		entityType types.EntityType
+		id         int
+	}{
+		{types.ENTITY_TYPE_MATCH, match.ID},
+		{types.ENTITY_TYPE_LEAGUE, match.LeagueID},
+		{types.ENTITY_TYPE_TEAM, match.Home.ID},
+		{types.ENTITY_TYPE_TEAM, match.Away.ID},
+	} {
+		require.NoError(t, f.keeper.MapExternalID(f.ctx, id.entityType, types.ReporterProvider, strconv.Itoa(id.id), int64(id.id)))
+	}
+}
+
+func TestMatchReports(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.MinReporterBond = math.NewInt(100)
+	params.MinTotalReporterBond = math.NewInt(300)
+	params.ReporterReward = math.NewInt(7)
+	params.ReporterRewardEpochBlocks, params.ReporterRewardEpochCap = 100, math.NewInt(10)
+	params.ReporterUnbondingBlocks = 5
+	// plaintext reports
+	params.ReportCommitBlocks, params.ReportRevealBlocks = 0, 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	var reporters []string
+	for _, name := range []string{"alice", "bob", "carol"} {
+		addr := sdk.AccAddress(name)
+		f.bankKeeper.balances[string(addr)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+		reporter, err := f.addressCodec.BytesToString(addr)
+		require.NoError(t, err)
+		reporters = append(reporters, reporter)
+	}
+	alice, bob, carol := reporters[0], reporters[1], reporters[2]
+
+	register := func(reporter string, amount int64) error {
+		_, err := ms.RegisterReporter(ctx, &types.MsgRegisterReporter{Reporter: reporter, Moniker: "reporter", Bond: sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)})
+		return err
+	}
+	require.ErrorIs(t, register(alice, 60), types.ErrInvalidReporter)
+	require.NoError(t, register(alice, 100))
+	require.NoError(t, register(bob, 100))
+	require.NoError(t, register(carol, 100))
+	require.NoError(t, register(carol, 50)) // tops up the bond
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 350), f.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ReporterBondsPoolName), sdk.DefaultBondDenom))
+
+	match := setupMarketMatch(t, f, 70)
+	mapReporterIDs(t, f, match)
+	report := func(reporter string, home, away int64, finished bool) error {
+		_, err := ms.SubmitMatchReport(ctx, &types.MsgSubmitMatchReport{Reporter: reporter, Report: types.MatchReport{
+			MatchId: int64(match.ID), LeagueId: 1, LeagueName: "League", HomeId: 1, HomeName: "Home", AwayId: 2, AwayName: "Away",
+			HomeScore: home, AwayScore: away, Started: true, Finished: finished,
+		}})
+		return err
+	}
+
+	_, err := ms.SubmitMatchReport(ctx, &types.MsgSubmitMatchReport{Reporter: sdk.AccAddress("dave").String(), Report: types.MatchReport{MatchId: 70}})
+	require.ErrorIs(t, err, types.ErrInvalidReporter)
+	_, err = ms.SubmitMatchReport(ctx, &types.MsgSubmitMatchReport{Reporter: alice, Report: types.MatchReport{MatchId: 70, LeagueId: 1, HomeId: 1, AwayId: 1}})
+	require.ErrorIs(t, err, types.ErrInvalidMatchReport)
+	// the reporters cannot create matches
+	_, err = ms.SubmitMatchReport(ctx, &types.MsgSubmitMatchReport{Reporter: alice, Report: types.MatchReport{MatchId: 99, LeagueId: 1, HomeId: 1, AwayId: 2, Started: true}})
+	require.ErrorIs(t, err, types.ErrInvalidMatchReport)
+
+	// the rewards are paid from the funded pool, which the slashed bonds go to too
+	_, err = ms.FundReporterRewards(ctx, &types.MsgFundReporterRewards{Depositor: alice, Amount: sdk.NewInt64Coin("stake2", 20)})
+	require.Error(t, err)
+	f.bankKeeper.balances[string(sdk.AccAddress("funder"))] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))
+	_, err = ms.FundReporterRewards(ctx, &types.MsgFundReporterRewards{Depositor: sdk.AccAddress("funder").String(), Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)})
+	require.NoError(t, err)
+	rewardsPool := authtypes.NewModuleAddress(types.ReporterRewardsPoolName)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 20), f.bankKeeper.GetBalance(ctx, rewardsPool, sdk.DefaultBondDenom))
+
+	// 100 of the 350 active bond agrees, below the quorum
+	require.NoError(t, report(alice, 1, 0, false))
+	leagues, err := f.keeper.AggregateMatchReports(ctx)
+	require.NoError(t, err)
+	require.Empty(t, leagues)
+
+	require.NoError(t, report(carol, 1, 0, false))
+
+	// 250 of the 350 active bond agrees, but the active reporters are below the minimum
+	params.MinReporters = 4
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	leagues, err = f.keeper.AggregateMatchReports(ctx)
+	require.NoError(t, err)
+	require.Empty(t, leagues)
+	params.MinReporters = 3
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	require.NoError(t, report(carol, 1, 0, false))
+	leagues, err = f.keeper.AggregateMatchReports(ctx)
+	require.NoError(t, err)
+	require.Len(t, leagues, 1)
+	require.Equal(t, 1, leagues[0].ID)
+	require.Len(t, leagues[0].Matches, 1)
+	aggregated := leagues[0].Matches[0]
+	require.Equal(t, match.ID, aggregated.ID)
+	require.Equal(t, 1, aggregated.Home.Score)
+	require.Equal(t, "Home", aggregated.Home.Name)
+	require.True(t, aggregated.Status.Ongoing)
+
+	// matches are aggregated once per new report
+	leagues, err = f.keeper.AggregateMatchReports(ctx)
+	require.NoError(t, err)
+	require.Empty(t, leagues)
+
+	require.NoError(t, report(bob, 2, 0, true))
+	reports, err := qs.MatchReports(ctx, &types.QueryMatchReportsRequest{MatchId: int64(match.ID)})
+	require.NoError(t, err)
+	require.Len(t, reports.Reports, 3)
+
+	// alice and carol agree with the finalized result, bob is slashed
+	require.NoError(t, f.keeper.OverrideMatch(ctx, int64(match.ID), 1, 0, true, true, false))
+	require.ErrorIs(t, report(alice, 1, 0, true), types.ErrInvalidMatchReport)
+
+	get := func(reporter string) types.Reporter {
+		res, err := qs.Reporter(ctx, &types.QueryReporterRequest{Address: reporter})
+		require.NoError(t, err)
+		return res.Reporter
+	}
+	require.Equal(t, uint64(1), get(alice).AccurateReports)
+	require.Equal(t, uint64(1), get(carol).AccurateReports)
+	require.Equal(t, uint64(1), get(bob).SlashedReports)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 95), get(bob).Bond)
+	// alice is paid the reward, carol what is left of the epoch cap
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 907), f.bankKeeper.GetBalance(ctx, sdk.AccAddress("alice"), sdk.DefaultBondDenom))
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 853), f.bankKeeper.GetBalance(ctx, sdk.AccAddress("carol"), sdk.DefaultBondDenom))
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 15), f.bankKeeper.GetBalance(ctx, rewardsPool, sdk.DefaultBondDenom))
+
+	reports, err = qs.MatchReports(ctx, &types.QueryMatchReportsRequest{MatchId: int64(match.ID)})
+	require.NoError(t, err)
+	require.Empty(t, reports.Reports)
+
+	// the reports carry the source ids, stored as the canonical ids
+	mapped := setupMarketMatch(t, f, 71)
+	require.NoError(t, f.keeper.MapExternalID(ctx, types.ENTITY_TYPE_MATCH, types.ReporterProvider, "9071", int64(mapped.ID)))
+	_, err = ms.SubmitMatchReport(ctx, &types.MsgSubmitMatchReport{Reporter: alice, Report: types.MatchReport{
+		MatchId: 9071, LeagueId: 1, LeagueName: "League", HomeId: 1, HomeName: "Home", AwayId: 2, AwayName: "Away", Started: true,
+	}})
+	require.NoError(t, err)
+	reports, err = qs.MatchReports(ctx, &types.QueryMatchReportsRequest{MatchId: int64(mapped.ID)})
+	require.NoError(t, err)
+	require.Len(t, reports.Reports, 1)
+	require.Equal(t, int64(mapped.ID), reports.Reports[0].MatchId)
+
+	res, err := ms.UnbondReporter(ctx, &types.MsgUnbondReporter{Reporter: bob})
+	require.NoError(t, err)
+	require.Equal(t, int64(15), res.UnbondingHeight)
+	_, err = ms.UnbondReporter(ctx, &types.MsgUnbondReporter{Reporter: bob})
+	require.ErrorIs(t, err, types.ErrInvalidReporter)
+	require.ErrorIs(t, register(bob, 100), types.ErrInvalidReporter)
+
+	require.NoError(t, f.keeper.ReleaseReporterBonds(ctx.WithBlockHeight(14)))
+	require.Equal(t, types.REPORTER_STATUS_UNBONDING, get(bob).Status)
+
+	require.NoError(t, f.keeper.ReleaseReporterBonds(ctx.WithBlockHeight(15)))
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 995), f.bankKeeper.GetBalance(ctx, sdk.AccAddress("bob"), sdk.DefaultBondDenom))
+	_, err = qs.Reporter(ctx, &types.QueryReporterRequest{Address: bob})
+	require.Error(t, err)
+
+	all, err := qs.Reporters(ctx, &types.QueryReportersRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Reporters, 2)
+}
+
+func TestReportCommitReveal(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(20)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	// rounds of 5 commit and 5 reveal blocks: heights 20-24 commit, 25-29 reveal
+	params := types.DefaultParams()
+	params.MinReporterBond = math.NewInt(100)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	var reporters []string
+	for _, name := range []string{"alice", "bob"} {
+		addr := sdk.AccAddress(name)
+		f.bankKeeper.balances[string(addr)] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+		reporter, err := f.addressCodec.BytesToString(addr)
+		require.NoError(t, err)
+		reporters = append(reporters, reporter)
+		_, err = ms.RegisterReporter(ctx, &types.MsgRegisterReporter{Reporter: reporter, Bond: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)})
+		require.NoError(t, err)
+	}
+	alice, bob := reporters[0], reporters[1]
+
+	match := setupMarketMatch(t, f, 80)
+	mapReporterIDs(t, f, match)
+	newReport := func(reporter string) types.MatchReport {
+		return types.MatchReport{Reporter: reporter, MatchId: int64(match.ID), LeagueId: 1, HomeId: 1, AwayId: 2, HomeScore: 3, AwayScore: 1, Started: true, Finished: true}
+	}
+	commit := func(ctx sdk.Context, reporter string, salt []byte) error {
+		hash, err := types.ReportCommitHash(newReport(reporter), salt)
+		require.NoError(t, err)
+		_, err = ms.CommitReport(ctx, &types.MsgCommitReport{Reporter: reporter, MatchId: int64(match.ID), Hash: hash})
+		return err
+	}
+	reveal := func(ctx sdk.Context, reporter string, salt []byte) error {
+		_, err := ms.RevealReport(ctx, &types.MsgRevealReport{Reporter: reporter, Report: newReport(reporter), Salt: salt})
+		return err
+	}
+
+	_, err := ms.SubmitMatchReport(ctx, &types.MsgSubmitMatchReport{Reporter: alice, Report: newReport(alice)})
+	require.ErrorIs(t, err, types.ErrInvalidMatchReport)
+	_, err = ms.CommitReport(ctx, &types.MsgCommitReport{Reporter: alice, MatchId: int64(match.ID), Hash: []byte("short")})
+	require.ErrorIs(t, err, types.ErrInvalidReportCommit)
+
+	require.NoError(t, commit(ctx, alice, []byte("alice salt")))
+	require.NoError(t, commit(ctx.WithBlockHeight(24), bob, []byte("bob salt")))
+	require.ErrorIs(t, reveal(ctx.WithBlockHeight(24), alice, []byte("alice salt")), types.ErrInvalidReportCommit)
+
+	revealCtx := ctx.WithBlockHeight(25)
+	round, err := qs.ReportRound(revealCtx, &types.QueryReportRoundRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryReportRoundResponse{Round: 2, Revealing: true, CommitEndHeight: 24, RevealEndHeight: 29}, round)
+
+	require.ErrorIs(t, commit(revealCtx, alice, []byte("alice salt")), types.ErrInvalidReportCommit)
+	require.ErrorIs(t, reveal(revealCtx, alice, []byte("wrong salt")), types.ErrInvalidReportCommit)
+	// the commit of bob can not be revealed by alice
+	require.ErrorIs(t, reveal(revealCtx, alice, []byte("bob salt")), types.ErrInvalidReportCommit)
+	require.NoError(t, reveal(revealCtx, alice, []byte("alice salt")))
+
+	reports, err := qs.MatchReports(revealCtx, &types.QueryMatchReportsRequest{MatchId: int64(match.ID)})
+	require.NoError(t, err)
+	require.Len(t, reports.Reports, 1)
+	require.Equal(t, int64(25), reports.Reports[0].Height)
+
+	// bob did not reveal by the end of the round
+	require.NoError(t, f.keeper.ExpireReportCommits(ctx.WithBlockHeight(28)))
+	require.NoError(t, f.keeper.ExpireReportCommits(ctx.WithBlockHeight(29)))
+	require.ErrorIs(t, reveal(ctx.WithBlockHeight(29), bob, []byte("bob salt")), types.ErrInvalidReportCommit)
+
+	get := func(reporter string) types.Reporter {
+		res, err := qs.Reporter(ctx, &types.QueryReporterRequest{Address: reporter})
+		require.NoError(t, err)
+		return res.Reporter
+	}
+	require.Equal(t, uint64(1), get(bob).MissedReveals)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 99), get(bob).Bond)
+	require.Equal(t, uint64(0), get(alice).MissedReveals)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), get(alice).Bond)
+}
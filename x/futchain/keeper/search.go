@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"cosmossdk.io/collections"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// teamNames returns the names of a team indexed by the name search.
+func teamNames(team *datasource.Team) []string {
+	if team == nil {
+		return nil
+	}
+	return []string{team.Name, team.LongName}
+}
+
+// leagueNames returns the names of a league indexed by the name search.
+func leagueNames(league *datasource.League) []string {
+	if league == nil {
+		return nil
+	}
+	return []string{league.Name}
+}
+
+// indexNames replaces the name search terms of an item, from its old names to its new names.
+func indexNames(ctx context.Context, index collections.KeySet[collections.Pair[string, int64]], id int64, oldNames, newNames []string) error {
+	for _, term := range types.NameSearchTerms(oldNames...) {
+		if err := index.Remove(ctx, collections.Join(term, id)); err != nil {
+			return err
+		}
+	}
+	for _, term := range types.NameSearchTerms(newNames...) {
+		if err := index.Set(ctx, collections.Join(term, id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// searchNames returns the ids of the items with a search term starting with the normalized query, in the
+// order of their first matching term, so the exact matches come first. The limit is the default one if zero,
+// and at most types.MaxSearchLimit.
+func searchNames(ctx context.Context, index collections.KeySet[collections.Pair[string, int64]], query string, limit uint32) ([]int64, error) {
+	prefix := types.NormalizeName(query)
+	if prefix == "" {
+		return nil, types.ErrInvalidSearch.Wrapf("query %q has no letters nor digits", query)
+	}
+	switch {
+	case limit == 0:
+		limit = types.DefaultSearchLimit
+	case limit > types.MaxSearchLimit:
+		limit = types.MaxSearchLimit
+	}
+
+	iter, err := index.Iterate(ctx, new(collections.Range[collections.Pair[string, int64]]).
+		StartInclusive(collections.Join(prefix, int64(math.MinInt64))))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var ids []int64
+	seen := map[int64]bool{}
+	for ; iter.Valid() && len(ids) < int(limit); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		// the terms starting with the prefix are contiguous
+		if !strings.HasPrefix(key.K1(), prefix) {
+			break
+		}
+		if id := key.K2(); !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// SearchTeams returns the teams with a word of their name or long name starting with a query, ignoring
+// case and diacritics, see searchNames.
+func (k *Keeper) SearchTeams(ctx context.Context, query string, limit uint32) ([]datasource.Team, error) {
+	ids, err := searchNames(ctx, k.TeamNames, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	teams := make([]datasource.Team, len(ids))
+	for i, id := range ids {
+		team, err := k.GetTeam(ctx, int(id))
+		if err != nil {
+			return nil, err
+		}
+		teams[i] = *team
+	}
+	return teams, nil
+}
+
+// SearchLeagues returns the leagues with a word of their name starting with a query, ignoring case and
+// diacritics, see searchNames.
+func (k *Keeper) SearchLeagues(ctx context.Context, query string, limit uint32) ([]datasource.League, error) {
+	ids, err := searchNames(ctx, k.LeagueNames, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	leagues := make([]datasource.League, len(ids))
+	for i, id := range ids {
+		league, err := k.GetLeague(ctx, int(id))
+		if err != nil {
+			return nil, err
+		}
+		leagues[i] = *league
+	}
+	return leagues, nil
+}
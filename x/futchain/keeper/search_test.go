@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestNormalizeName(t *testing.T) {
+	for name, normalized := range map[string]string{
+		"Fenerbahçe S.K.":     "fenerbahce s k",
+		"İstanbul Başakşehir": "istanbul basaksehir",
+		"Bayern München":      "bayern munchen",
+		"Malmö FF":            "malmo ff",
+		"  Łódź  ":            "lodz",
+		"Brighton & Hove":     "brighton hove",
+	} {
+		require.Equal(t, normalized, types.NormalizeName(name), name)
+	}
+	require.Equal(t, []string{"real madrid", "madrid", "real madrid cf", "madrid cf", "cf"}, types.NameSearchTerms("Real Madrid", "Real Madrid CF"))
+}
+
+func TestSearch(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	for _, team := range []datasource.Team{
+		{ID: 1, Name: "Fenerbahçe", LongName: "Fenerbahçe SK"},
+		{ID: 2, Name: "Galatasaray"},
+		{ID: 3, Name: "Real Madrid", LongName: "Real Madrid CF"},
+		{ID: 4, Name: "Atlético Madrid"},
+		{ID: 5, Name: "Fener Ladies"},
+	} {
+		_, err := f.keeper.SaveTeamIfNotExists(f.ctx, team)
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.keeper.SetLeague(f.ctx, datasource.League{ID: 71, Name: "Süper Lig"}))
+	require.NoError(t, f.keeper.SetLeague(f.ctx, datasource.League{ID: 47, Name: "Premier League"}))
+
+	search := func(query string, limit uint32) []int64 {
+		t.Helper()
+		res, err := qs.SearchTeams(f.ctx, &types.QuerySearchTeamsRequest{Query: query, Limit: limit})
+		require.NoError(t, err)
+		var ids []int64
+		for _, team := range res.Teams {
+			ids = append(ids, team.Id)
+		}
+		return ids
+	}
+	require.Equal(t, []int64{1}, search("FENERBAHCE", 0))
+	// the exact and shorter names first
+	require.Equal(t, []int64{5, 1}, search("fener", 0))
+	require.Equal(t, []int64{5}, search("fener", 1))
+	require.Equal(t, []int64{3, 4}, search("madrid", 0))
+	require.Equal(t, []int64{3}, search("madrid cf", 0))
+	require.Equal(t, []int64{4}, search("Atletico", 0))
+	require.Empty(t, search("barcelona", 0))
+
+	res, err := qs.SearchTeams(f.ctx, &types.QuerySearchTeamsRequest{Query: "Fenerbahçe"})
+	require.NoError(t, err)
+	require.Equal(t, []types.Team{{Id: 1, Name: "Fenerbahçe", LongName: "Fenerbahçe SK"}}, res.Teams)
+
+	_, err = qs.SearchTeams(f.ctx, &types.QuerySearchTeamsRequest{Query: " - "})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a renamed team is searched by its new name only
+	require.NoError(t, f.keeper.SetTeam(f.ctx, datasource.Team{ID: 2, Name: "Galatasaray SK"}))
+	require.Equal(t, []int64{2}, search("galatasaray sk", 0))
+	require.NoError(t, f.keeper.SetTeam(f.ctx, datasource.Team{ID: 1, Name: "Fenerbahce Istanbul"}))
+	require.Equal(t, []int64{1}, search("istanbul", 0))
+	require.Empty(t, search("fenerbahce sk", 0))
+
+	leagues, err := qs.SearchLeagues(f.ctx, &types.QuerySearchLeaguesRequest{Query: "super lig"})
+	require.NoError(t, err)
+	require.Equal(t, []types.League{{Id: 71, Name: "Süper Lig"}}, leagues.Leagues)
+	leagues, err = qs.SearchLeagues(f.ctx, &types.QuerySearchLeaguesRequest{Query: "league"})
+	require.NoError(t, err)
+	require.Len(t, leagues.Leagues, 1)
+	require.Equal(t, int64(47), leagues.Leagues[0].Id)
+}
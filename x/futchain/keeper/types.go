@@ -1,6 +1,13 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 var (
 	TeamKey   = []byte("team")
@@ -27,3 +34,8 @@ func (k *Keeper) LeagueKey(id int) []byte {
 func (k *Keeper) MatchKeyUnfinished(id int) []byte {
 	return append(MatchKeyUnfinishedPrefix, sdk.Uint64ToBigEndian(uint64(id))...)
 }
+
+// datasourceStore returns the store of the ingested items under a key prefix, keyed by their big endian id.
+func (k *Keeper) datasourceStore(ctx context.Context, keyPrefix []byte) storetypes.KVStore {
+	return prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+}
@@ -10,7 +10,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: types.Query_serviceDesc.ServiceName,
+			Service:              types.Query_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true, // adds the service commands to the custom match-with-proof command
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "Params",
@@ -43,6 +44,140 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query Unfinished Matches",
 				},
 
+				{
+					RpcMethod:      "Market",
+					Use:            "market [id]",
+					Short:          "Query a prediction market",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "MatchMarkets",
+					Use:            "match-markets [match-id]",
+					Short:          "Query the prediction markets of a match",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}},
+				},
+				{
+					RpcMethod:      "MarketStakes",
+					Use:            "market-stakes [market-id]",
+					Short:          "Query the stakes of a prediction market",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}},
+				},
+				{
+					RpcMethod:      "OutcomeTokens",
+					Use:            "outcome-tokens [match-id]",
+					Short:          "Query the outcome share tokens of a match",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}},
+				},
+
+				{
+					RpcMethod:      "Correction",
+					Use:            "correction [id]",
+					Short:          "Query a data-council correction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Corrections",
+					Use:       "corrections",
+					Short:     "Query the data-council corrections",
+				},
+				{
+					RpcMethod:      "ValidatorOracleInfo",
+					Use:            "validator-oracle-info [validator-address]",
+					Short:          "Query the missed and deviating oracle report counters of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod:      "ValidatorOracleReports",
+					Use:            "validator-oracle-reports [validator-address]",
+					Short:          "Query the oracle reports of a validator on matches that are not finalized yet",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod:      "OracleRewards",
+					Use:            "oracle-rewards [validator-address]",
+					Short:          "Query the claimable oracle rewards of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod:      "Reporter",
+					Use:            "reporter [address]",
+					Short:          "Query a data reporter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Reporters",
+					Use:       "reporters",
+					Short:     "Query the data reporters",
+				},
+				{
+					RpcMethod:      "MatchReports",
+					Use:            "match-reports [match-id]",
+					Short:          "Query the reporter reports on a match that is not finalized yet",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}},
+				},
+				{
+					RpcMethod: "ReportRound",
+					Use:       "report-round",
+					Short:     "Query the current commit-reveal round of the reporters",
+				},
+				{
+					RpcMethod:      "MatchAttestation",
+					Use:            "match-attestation [match-id]",
+					Short:          "Query the provider attestation of a match, with the provider key to verify it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}},
+				},
+				{
+					RpcMethod:      "MatchResultRecord",
+					Use:            "match-result [match-id]",
+					Short:          "Query the result record of a match with its store key, see match-with-proof for its proof",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}},
+				},
+				{
+					RpcMethod: "Leagues",
+					Use:       "leagues",
+					Short:     "Query the ingested leagues",
+				},
+				{
+					RpcMethod: "Teams",
+					Use:       "teams",
+					Short:     "Query the ingested teams",
+				},
+				{
+					RpcMethod:      "SearchTeams",
+					Use:            "search-teams [query]",
+					Short:          "Search the teams by name, ignoring case and diacritics",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "query"}},
+				},
+				{
+					RpcMethod:      "SearchLeagues",
+					Use:            "search-leagues [query]",
+					Short:          "Search the leagues by name, ignoring case and diacritics",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "query"}},
+				},
+				{
+					RpcMethod:      "CanonicalID",
+					Use:            "canonical-id [entity-type] [provider] [external-id]",
+					Short:          "Show the canonical id of a team, league or match by its id at a data provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "entity_type"}, {ProtoField: "provider"}, {ProtoField: "external_id"}},
+				},
+				{
+					RpcMethod:      "ExternalIDs",
+					Use:            "external-ids [entity-type] [canonical-id]",
+					Short:          "Show the ids of a team, league or match at the data providers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "entity_type"}, {ProtoField: "canonical_id"}},
+				},
+				{
+					RpcMethod:      "IDMappings",
+					Use:            "id-mappings [entity-type]",
+					Short:          "List the id mappings of the teams, leagues or matches, optionally of a --provider",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "entity_type"}},
+				},
+				{
+					// the matches command is a custom command in client/cli, printing a table, JSON or CSV
+					RpcMethod: "Matches",
+					Skip:      true,
+				},
+
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -54,6 +189,134 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod:      "CreateMarket",
+					Use:            "create-market [match-id] [market-type]",
+					Short:          "Create a prediction market on a match",
+					Long:           "Create a prediction market on a match. Market types: MARKET_TYPE_MATCH_RESULT, MARKET_TYPE_OVER_UNDER (use --line in half goals, e.g. 5 for 2.5 goals), MARKET_TYPE_CORRECT_SCORE",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}, {ProtoField: "market_type"}},
+				},
+				{
+					RpcMethod:      "PlaceStake",
+					Use:            "place-stake [market-id] [outcome] [amount]",
+					Short:          "Stake coins on an outcome of a prediction market",
+					Long:           "Stake coins on an outcome of a prediction market. Outcomes: 0 home/1 draw/2 away for match result, 0 under/1 over for over/under, home*100+away for correct score",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "market_id"}, {ProtoField: "outcome"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "MintOutcomeShares",
+					Use:            "mint-outcome-shares [match-id] [collateral]",
+					Short:          "Lock collateral and mint a complete set of outcome shares of a match",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}, {ProtoField: "collateral"}},
+				},
+				{
+					RpcMethod:      "BurnOutcomeShares",
+					Use:            "burn-outcome-shares [match-id] [amount]",
+					Short:          "Burn complete sets of outcome shares of a match and unlock the collateral",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "RedeemOutcomeShares",
+					Use:            "redeem-outcome-shares [match-id]",
+					Short:          "Redeem the winning outcome shares of a finalized match for collateral",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}},
+				},
+				{
+					RpcMethod:      "OverrideMatch",
+					Use:            "override-match [match-id] [home-score] [away-score]",
+					Short:          "Correct the score and status of a match (authority or data council)",
+					Long:           "Correct the score and status of a match. Use --started, --finished and --cancelled for the status and --reason to record why. Finished or cancelled results are finalized immediately.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}, {ProtoField: "home_score"}, {ProtoField: "away_score"}},
+				},
+				{
+					RpcMethod:      "VoidMatch",
+					Use:            "void-match [match-id]",
+					Short:          "Cancel a match and refund its markets (authority or data council)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}},
+				},
+				{
+					RpcMethod:      "UpsertTeam",
+					Use:            "upsert-team [id] [name]",
+					Short:          "Create or replace a team (authority or data council)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "UpsertLeague",
+					Use:            "upsert-league [id] [name]",
+					Short:          "Create or replace a league (authority or data council)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "MergeEntities",
+					Use:            "merge-entities [entity-type] [duplicate-id] [canonical-id]",
+					Short:          "Merge a duplicate team, league or match into its canonical entity (authority)",
+					Long:           "Merge a duplicate team, league or match into its canonical entity. The ids of the duplicate at the data providers are mapped to the canonical id. A merged match that is not finalized yet is voided, which refunds its markets.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "entity_type"}, {ProtoField: "duplicate_id"}, {ProtoField: "canonical_id"}},
+				},
+				{
+					RpcMethod:      "MapExternalID",
+					Use:            "map-external-id [entity-type] [provider] [external-id] [canonical-id]",
+					Short:          "Map the id of a team, league or match at a data provider to its canonical id (authority)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "entity_type"}, {ProtoField: "provider"}, {ProtoField: "external_id"}, {ProtoField: "canonical_id"}},
+				},
+				{
+					RpcMethod: "ProposeCorrection",
+					Use:       "propose-correction",
+					Short:     "Propose a data correction as a data-council member",
+					Long:      "Propose a data correction as a data-council member. --msg is the JSON of a MsgOverrideMatch, MsgVoidMatch, MsgUpsertTeam or MsgUpsertLeague with its @type, signed by the module authority.",
+				},
+				{
+					RpcMethod:      "VoteCorrection",
+					Use:            "vote-correction [correction-id]",
+					Short:          "Approve a pending data correction as a data-council member",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "correction_id"}},
+				},
+				{
+					RpcMethod:      "SubmitOracleReport",
+					Use:            "submit-oracle-report [validator-address] [match-id] [home-score] [away-score]",
+					Short:          "Report the result of a match as a bonded validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "match_id"}, {ProtoField: "home_score"}, {ProtoField: "away_score"}},
+				},
+				{
+					RpcMethod:      "ClaimOracleRewards",
+					Use:            "claim-oracle-rewards [validator-address]",
+					Short:          "Claim the oracle rewards of a validator to its operator account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod:      "RegisterReporter",
+					Use:            "register-reporter [bond]",
+					Short:          "Bond tokens to register as a data reporter, or add to the bond",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "bond"}},
+				},
+				{
+					RpcMethod: "UnbondReporter",
+					Use:       "unbond-reporter",
+					Short:     "Start unbonding the bond of a data reporter",
+				},
+				{
+					RpcMethod: "SubmitMatchReport",
+					Use:       "submit-match-report",
+					Short:     "Report the state of a match as a data reporter, given as JSON with --report",
+				},
+				{
+					RpcMethod:      "CommitReport",
+					Use:            "commit-report [match-id] [hash]",
+					Short:          "Commit to the hash of a match report in the commit phase of a round",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "match_id"}, {ProtoField: "hash"}},
+				},
+				{
+					RpcMethod:      "RevealReport",
+					Use:            "reveal-report [salt]",
+					Short:          "Reveal a committed match report, given as JSON with --report, in the reveal phase of its round",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "salt"}},
+				},
+				{
+					RpcMethod:      "FundReporterRewards",
+					Use:            "fund-reporter-rewards [amount]",
+					Short:          "Add tokens to the pool the reporter rewards are paid from",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -34,8 +34,13 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper     types.AuthKeeper
+	BankKeeper     types.BankKeeper
+	StakingKeeper  types.StakingKeeper
+	SlashingKeeper types.SlashingKeeper
+	Erc20Keeper    types.Erc20Keeper   `optional:"true"`
+	EVMKeeper      types.EVMKeeper     `optional:"true"`
+	ChannelKeeper  types.ChannelKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -57,6 +62,12 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.BankKeeper,
+		in.StakingKeeper,
+		in.SlashingKeeper,
+		in.Erc20Keeper,
+		in.EVMKeeper,
+		in.ChannelKeeper,
 		keeper.DatasourceConfig{
 			ApiURL:  in.Config.ApiUrl,
 			Headers: in.Config.Headers,
@@ -0,0 +1,26 @@
+package futchain
+
+import (
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// matchState returns the state of a match carried by the typed match events.
+func matchState(m datasource.Match) types.MatchState {
+	return types.MatchState{
+		Id:        int64(m.ID),
+		LeagueId:  int64(m.LeagueID),
+		Time:      m.Time,
+		HomeId:    int64(m.Home.ID),
+		HomeName:  m.Home.Name,
+		AwayId:    int64(m.Away.ID),
+		AwayName:  m.Away.Name,
+		HomeScore: int64(m.Home.Score),
+		AwayScore: int64(m.Away.Score),
+		Started:   m.Status.Started,
+		Ongoing:   m.Status.Ongoing,
+		Finished:  m.Status.Finished,
+		Cancelled: m.Status.Cancelled,
+		LiveTime:  m.Status.LiveTime.Long,
+	}
+}
@@ -0,0 +1,23 @@
+package futchain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestMatchEvents(t *testing.T) {
+	// the event priorities mirror the comparison priorities of the data source
+	for pri := datasource.PriorityNoChanges; pri <= datasource.PriorityScore; pri++ {
+		name := "MATCH_UPDATE_PRIORITY_" + strings.ToUpper(strings.TrimPrefix(pri.EventName(), "match_"))
+		require.Equal(t, name, types.MatchUpdatePriority(pri).String())
+	}
+
+	m := datasource.Match{ID: 7, LeagueID: 3, Home: datasource.Team{ID: 1, Name: "Home", Score: 2}, Away: datasource.Team{ID: 2, Name: "Away"}}
+	m.Status.Started, m.Status.LiveTime.Long = true, "51:35"
+	require.Equal(t, types.MatchState{Id: 7, LeagueId: 3, HomeId: 1, HomeName: "Home", AwayId: 2, AwayName: "Away", HomeScore: 2, Started: true, LiveTime: "51:35"}, matchState(m))
+}
@@ -1,19 +1,26 @@
 package futchain
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 
 	_ "embed"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/raifpy/futchain/x/futchain/keeper"
 	futchaintypes "github.com/raifpy/futchain/x/futchain/types"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/x/vm/statedb"
 )
 
@@ -21,63 +28,135 @@ var (
 	_ vm.PrecompiledContract = (*FutchainEvmBridge)(nil)
 )
 
+// The base gas costs of the methods, charged before they run. The store reads and writes of a method are
+// metered on top of its base cost, with the KV gas config of the SDK.
+const (
+	// GasGetItem is the base cost of the methods reading a single item.
+	GasGetItem = 3_000
+	// GasListItems is the base cost of the methods iterating the store, the searches and the unfinished matches.
+	GasListItems = 10_000
+	// GasSubscribe is the base cost of subscribe and unsubscribe.
+	GasSubscribe = 30_000
+	// GasCreateMarket is the base cost of createMarket.
+	GasCreateMarket = 30_000
+	// GasPlaceStake is the base cost of placeStake.
+	GasPlaceStake = 30_000
+	// GasOutcomeShares is the base cost of the outcome share methods, which may register the outcome tokens.
+	GasOutcomeShares = 50_000
+)
+
 type FutchainEvmBridge struct {
+	cmn.Precompile
 	keeper *keeper.Keeper
-	abi    abi.ABI
 }
 
 func NewFutchainEvmBridge(keeper *keeper.Keeper) (*FutchainEvmBridge, error) {
-	return &FutchainEvmBridge{keeper: keeper, abi: keeper.ABI}, nil
+	f := &FutchainEvmBridge{
+		Precompile: cmn.Precompile{
+			ABI:                  keeper.ABI,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+		},
+		keeper: keeper,
+	}
+	f.SetAddress(common.HexToAddress(futchaintypes.FutchainPrecompileAddress))
+	return f, nil
 }
 
-func (f *FutchainEvmBridge) Address() common.Address {
-	return common.HexToAddress(futchaintypes.FutchainPrecompileAddress)
-}
+// RequiredGas returns the base gas cost of the called method.
+func (f *FutchainEvmBridge) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method, err := f.MethodById(input[:4])
+	if err != nil {
+		// the call fails in Run
+		return 0
+	}
 
-func (f *FutchainEvmBridge) RequiredGas(_ []byte) uint64 {
-	//return 10000 // Set a reasonable gas cost for your precompile
+	switch method.Name {
+	case "getMatch", "getLeague", "getTeam", "getMatchFinality", "getMatchAttestation", "getMarket", "getOutcomeToken":
+		return GasGetItem
+	case "searchTeams", "searchLeagues", "getUnfinishedMatches":
+		return GasListItems
+	case "subscribe", "unsubscribe":
+		return GasSubscribe
+	case "createMarket":
+		return GasCreateMarket
+	case "placeStake":
+		return GasPlaceStake
+	case "mintOutcomeShares", "burnOutcomeShares", "redeemOutcomeShares":
+		return GasOutcomeShares
+	}
 	return 0
 }
 
-func (f *FutchainEvmBridge) Run(evm *vm.EVM, contract *vm.Contract, ronly bool) ([]byte, error) {
-	if len(contract.Input) < 4 {
-		return nil, vm.ErrExecutionReverted
-	}
-
-	method, err := f.abi.MethodById(contract.Input[:4])
-	if err != nil {
-		fmt.Printf("Method lookup error: %v\n", err)
-		return nil, err
-	}
+// IsTransaction reports whether a method writes to the state.
+func (f *FutchainEvmBridge) IsTransaction(method *abi.Method) bool {
+	return !method.IsConstant()
+}
 
-	// Parse input arguments
-	argsBz := contract.Input[4:]
-	args, err := method.Inputs.Unpack(argsBz)
+// Run runs a method in a cache context whose writes are journaled by the stateDB, so they are reverted
+// together with the calling evm transaction. The store gas the method uses is charged to the contract.
+func (f *FutchainEvmBridge) Run(evm *vm.EVM, contract *vm.Contract, ronly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := f.RunSetup(evm, contract, ronly, f.IsTransaction)
 	if err != nil {
-		fmt.Printf("[evm] Input unpacking error: %v\n", err)
 		return nil, err
 	}
 
-	// Get the proper SDK context from StateDB
-	stateDB, ok := evm.StateDB.(*statedb.StateDB)
-	if !ok {
-		return nil, fmt.Errorf("invalid StateDB type")
-	}
-
-	ctx := stateDB.GetContext()
+	// an out of gas panic of the store is returned as an out of gas error
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
 	switch method.Name {
 	case "getMatch":
-		return f.handleGetMatch(ctx, method, args)
+		bz, err = f.handleGetMatch(ctx, method, args)
 	case "getLeague":
-		return f.handleGetLeague(ctx, method, args)
+		bz, err = f.handleGetLeague(ctx, method, args)
 	case "getTeam":
-		return f.handleGetTeam(ctx, method, args)
+		bz, err = f.handleGetTeam(ctx, method, args)
+	case "searchTeams":
+		bz, err = f.handleSearchTeams(ctx, method, args)
+	case "searchLeagues":
+		bz, err = f.handleSearchLeagues(ctx, method, args)
 	case "getUnfinishedMatches":
-		return f.handleGetUnfinishedMatches(ctx, method, args)
+		bz, err = f.handleGetUnfinishedMatches(ctx, method, args)
+	case "getMatchFinality":
+		bz, err = f.handleGetMatchFinality(ctx, method, args)
+	case "getMatchAttestation":
+		bz, err = f.handleGetMatchAttestation(ctx, method, args)
+	case "subscribe":
+		bz, err = f.handleSubscribe(ctx, stateDB, contract, method, args)
+	case "unsubscribe":
+		bz, err = f.handleUnsubscribe(ctx, stateDB, contract, method, args)
+	case "createMarket":
+		bz, err = f.handleCreateMarket(ctx, contract, method, args)
+	case "placeStake":
+		bz, err = f.handlePlaceStake(ctx, stateDB, contract, method, args)
+	case "getMarket":
+		bz, err = f.handleGetMarket(ctx, method, args)
+	case "getOutcomeToken":
+		bz, err = f.handleGetOutcomeToken(method, args)
+	case "mintOutcomeShares", "burnOutcomeShares", "redeemOutcomeShares":
+		bz, err = f.handleOutcomeShares(ctx, stateDB, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
 
-	return nil, fmt.Errorf("method %s not implemented", method.Name)
+	// missing items and invalid searches revert with a reason, so callers can tell them from failures
+	revert := errors.Is(err, collections.ErrNotFound) || errors.Is(err, futchaintypes.ErrInvalidSearch)
+	if err != nil && !revert {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+	if !contract.UseGas(cost, nil, tracing.GasChangeCallPrecompiledContract) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	if revert {
+		return cmn.ReturnRevertError(evm, err)
+	}
+	return bz, nil
 }
 
 // handleGetMatch handles the getMatch function call
@@ -188,6 +267,79 @@ func (f *FutchainEvmBridge) handleGetTeam(ctx sdk.Context, method *abi.Method, a
 	return method.Outputs.Pack(teamData)
 }
 
+// teamData is the TeamData struct of the base contract.
+type teamData struct {
+	Id   *big.Int
+	Name string
+}
+
+// leagueData is the LeagueData struct of the base contract.
+type leagueData struct {
+	Id        *big.Int
+	Name      string
+	GroupName string
+}
+
+// searchArgs returns the query and limit arguments of the search function calls.
+func searchArgs(method *abi.Method, args []interface{}) (string, uint32, error) {
+	if len(args) != 2 {
+		return "", 0, fmt.Errorf("invalid number of arguments for %s", method.Name)
+	}
+
+	query, ok := args[0].(string)
+	if !ok {
+		return "", 0, fmt.Errorf("invalid query type")
+	}
+	limit, ok := args[1].(*big.Int)
+	if !ok {
+		return "", 0, fmt.Errorf("invalid limit type")
+	}
+	if !limit.IsUint64() || limit.Uint64() > futchaintypes.MaxSearchLimit {
+		return query, futchaintypes.MaxSearchLimit, nil
+	}
+	return query, uint32(limit.Uint64()), nil
+}
+
+// handleSearchTeams handles the searchTeams function call
+func (f *FutchainEvmBridge) handleSearchTeams(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	query, limit, err := searchArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := f.keeper.SearchTeams(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search teams: %w", err)
+	}
+
+	teamsData := make([]teamData, len(teams))
+	for i, team := range teams {
+		teamsData[i] = teamData{Id: big.NewInt(int64(team.ID)), Name: team.Name}
+	}
+
+	return method.Outputs.Pack(teamsData)
+}
+
+// handleSearchLeagues handles the searchLeagues function call
+func (f *FutchainEvmBridge) handleSearchLeagues(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	query, limit, err := searchArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	leagues, err := f.keeper.SearchLeagues(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search leagues: %w", err)
+	}
+
+	leaguesData := make([]leagueData, len(leagues))
+	for i, league := range leagues {
+		leaguesData[i] = leagueData{Id: big.NewInt(int64(league.ID)), Name: league.Name, GroupName: league.GroupName}
+	}
+
+	return method.Outputs.Pack(leaguesData)
+}
+
 // handleGetUnfinishedMatches handles the getUnfinishedMatches function call
 func (f *FutchainEvmBridge) handleGetUnfinishedMatches(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
 	if len(args) != 0 {
@@ -207,3 +359,286 @@ func (f *FutchainEvmBridge) handleGetUnfinishedMatches(ctx sdk.Context, method *
 
 	return method.Outputs.Pack(bigIntIds)
 }
+
+// handleGetMatchFinality handles the getMatchFinality function call
+func (f *FutchainEvmBridge) handleGetMatchFinality(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid number of arguments for getMatchFinality")
+	}
+
+	matchId, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid matchId type")
+	}
+
+	state, height, err := f.keeper.GetMatchFinality(ctx, matchId.Int64())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match finality: %w", err)
+	}
+
+	return method.Outputs.Pack(state, big.NewInt(height))
+}
+
+// handleGetMatchAttestation handles the getMatchAttestation function call
+func (f *FutchainEvmBridge) handleGetMatchAttestation(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid number of arguments for getMatchAttestation")
+	}
+
+	matchId, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid matchId type")
+	}
+
+	attestation, provider, err := f.keeper.GetMatchAttestation(ctx, matchId.Int64())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match attestation: %w", err)
+	}
+
+	return method.Outputs.Pack(attestation.Provider, uint8(provider.KeyType), provider.PubKey, attestation.Payload, attestation.Signature)
+}
+
+// handleSubscribe handles the subscribe function call.
+// The caller prepays the callback with the call value, which is moved from the precompile into the module
+// account through the bank keeper, so the evm balances are synced from the bank events afterwards.
+func (f *FutchainEvmBridge) handleSubscribe(ctx sdk.Context, stateDB *statedb.StateDB, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("invalid number of arguments for subscribe")
+	}
+
+	matchId, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid matchId type")
+	}
+
+	gasLimit, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid callbackGasLimit type")
+	}
+
+	balanceHandler := cmn.NewBalanceHandler()
+	balanceHandler.BeforeBalanceChange(ctx)
+
+	if err := f.keeper.Subscribe(ctx, int(matchId.Int64()), contract.Caller(), gasLimit, f.Address().Bytes(), math.NewIntFromBigInt(contract.Value().ToBig())); err != nil {
+		return nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	if err := balanceHandler.AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("match_subscribed", sdk.NewAttribute("id", matchId.String()), sdk.NewAttribute("subscriber", contract.Caller().Hex()), sdk.NewAttribute("gas_limit", strconv.FormatUint(gasLimit, 10))))
+
+	return method.Outputs.Pack()
+}
+
+// handleUnsubscribe handles the unsubscribe function call.
+// The callback fee is refunded from the module account to the caller, so the evm balances are synced
+// from the bank events afterwards.
+func (f *FutchainEvmBridge) handleUnsubscribe(ctx sdk.Context, stateDB *statedb.StateDB, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid number of arguments for unsubscribe")
+	}
+
+	matchId, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid matchId type")
+	}
+
+	balanceHandler := cmn.NewBalanceHandler()
+	balanceHandler.BeforeBalanceChange(ctx)
+
+	if err := f.keeper.Unsubscribe(ctx, int(matchId.Int64()), contract.Caller()); err != nil {
+		return nil, fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+
+	if err := balanceHandler.AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent("match_unsubscribed", sdk.NewAttribute("id", matchId.String()), sdk.NewAttribute("subscriber", contract.Caller().Hex())))
+
+	return method.Outputs.Pack()
+}
+
+// handleCreateMarket handles the createMarket function call
+func (f *FutchainEvmBridge) handleCreateMarket(ctx sdk.Context, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("invalid number of arguments for createMarket")
+	}
+
+	matchId, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid matchId type")
+	}
+
+	marketType, ok := args[1].(uint8)
+	if !ok {
+		return nil, fmt.Errorf("invalid marketType type")
+	}
+
+	line, ok := args[2].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid line type")
+	}
+
+	id, err := f.keeper.CreateMarket(ctx, contract.Caller().Bytes(), matchId.Int64(), futchaintypes.MarketType(marketType), line)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create market: %w", err)
+	}
+
+	return method.Outputs.Pack(new(big.Int).SetUint64(id))
+}
+
+// handlePlaceStake handles the placeStake function call.
+// The stake is pulled from the caller through the bank keeper, so the evm balances are
+// synced from the bank events afterwards.
+func (f *FutchainEvmBridge) handlePlaceStake(ctx sdk.Context, stateDB *statedb.StateDB, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("invalid number of arguments for placeStake")
+	}
+
+	marketId, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid marketId type")
+	}
+
+	outcome, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid outcome type")
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount type")
+	}
+
+	market, err := f.keeper.Markets.Get(ctx, marketId.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get market: %w", err)
+	}
+
+	balanceHandler := cmn.NewBalanceHandler()
+	balanceHandler.BeforeBalanceChange(ctx)
+
+	if err := f.keeper.PlaceStake(ctx, contract.Caller().Bytes(), marketId.Uint64(), outcome, sdk.NewCoin(market.TotalStaked.Denom, math.NewIntFromBigInt(amount))); err != nil {
+		return nil, fmt.Errorf("failed to place stake: %w", err)
+	}
+
+	if err := balanceHandler.AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack()
+}
+
+// handleGetMarket handles the getMarket function call
+func (f *FutchainEvmBridge) handleGetMarket(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("invalid number of arguments for getMarket")
+	}
+
+	marketId, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid marketId type")
+	}
+
+	market, err := f.keeper.Markets.Get(ctx, marketId.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get market: %w", err)
+	}
+
+	// Create the struct tuple for MarketData
+	marketData := struct {
+		Id             *big.Int
+		MatchId        *big.Int
+		MarketType     uint8
+		Line           uint64
+		Status         uint8
+		WinningOutcome uint64
+		TotalStaked    *big.Int
+	}{
+		Id:             new(big.Int).SetUint64(market.Id),
+		MatchId:        big.NewInt(market.MatchId),
+		MarketType:     uint8(market.MarketType),
+		Line:           market.Line,
+		Status:         uint8(market.Status),
+		WinningOutcome: market.WinningOutcome,
+		TotalStaked:    market.TotalStaked.Amount.BigInt(),
+	}
+
+	return method.Outputs.Pack(marketData)
+}
+
+// handleGetOutcomeToken handles the getOutcomeToken function call
+func (f *FutchainEvmBridge) handleGetOutcomeToken(method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("invalid number of arguments for getOutcomeToken")
+	}
+
+	matchId, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid matchId type")
+	}
+
+	outcome, ok := args[1].(uint8)
+	if !ok || uint64(outcome) > futchaintypes.OutcomeAway {
+		return nil, fmt.Errorf("invalid outcome")
+	}
+
+	return method.Outputs.Pack(futchaintypes.OutcomeTokenAddress(futchaintypes.OutcomeDenom(matchId.Int64(), uint64(outcome))))
+}
+
+// handleOutcomeShares handles the mintOutcomeShares, burnOutcomeShares and redeemOutcomeShares function calls.
+// The collateral moves through the bank keeper, so the evm balances are synced from the bank events afterwards.
+func (f *FutchainEvmBridge) handleOutcomeShares(ctx sdk.Context, stateDB *statedb.StateDB, contract *vm.Contract, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("invalid number of arguments for %s", method.Name)
+	}
+
+	matchId, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("invalid matchId type")
+	}
+
+	var amount *big.Int
+	if method.Name != "redeemOutcomeShares" {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("invalid number of arguments for %s", method.Name)
+		}
+		if amount, ok = args[1].(*big.Int); !ok {
+			return nil, fmt.Errorf("invalid amount type")
+		}
+	}
+
+	balanceHandler := cmn.NewBalanceHandler()
+	balanceHandler.BeforeBalanceChange(ctx)
+
+	var output []interface{}
+	switch method.Name {
+	case "mintOutcomeShares":
+		collateral, err := f.keeper.GetOutcomeCollateral(ctx, matchId.Int64())
+		if err != nil {
+			return nil, err
+		}
+		if err := f.keeper.MintOutcomeShares(ctx, contract.Caller().Bytes(), matchId.Int64(), sdk.NewCoin(collateral.Denom, math.NewIntFromBigInt(amount))); err != nil {
+			return nil, fmt.Errorf("failed to mint outcome shares: %w", err)
+		}
+	case "burnOutcomeShares":
+		if err := f.keeper.BurnOutcomeShares(ctx, contract.Caller().Bytes(), matchId.Int64(), math.NewIntFromBigInt(amount)); err != nil {
+			return nil, fmt.Errorf("failed to burn outcome shares: %w", err)
+		}
+	case "redeemOutcomeShares":
+		payout, err := f.keeper.RedeemOutcomeShares(ctx, contract.Caller().Bytes(), matchId.Int64())
+		if err != nil {
+			return nil, fmt.Errorf("failed to redeem outcome shares: %w", err)
+		}
+		output = append(output, payout.Amount.BigInt())
+	}
+
+	if err := balanceHandler.AfterBalanceChange(ctx, stateDB); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(output...)
+}
@@ -0,0 +1,33 @@
+package futchain
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+)
+
+func TestRequiredGas(t *testing.T) {
+	file, err := os.Open("../contracts/abi.json")
+	require.NoError(t, err)
+	defer file.Close()
+	parsed, err := abi.JSON(file)
+	require.NoError(t, err)
+
+	bridge, err := NewFutchainEvmBridge(&keeper.Keeper{ABI: parsed})
+	require.NoError(t, err)
+
+	// every method has a base cost, the transactions one above the reads
+	for _, method := range parsed.Methods {
+		gas := bridge.RequiredGas(method.ID)
+		require.NotZero(t, gas, method.Name)
+		if bridge.IsTransaction(&method) {
+			require.Greater(t, gas, uint64(GasGetItem), method.Name)
+		}
+	}
+	require.Zero(t, bridge.RequiredGas([]byte{1, 2}))
+	require.Zero(t, bridge.RequiredGas([]byte{1, 2, 3, 4}))
+}
@@ -0,0 +1,162 @@
+package futchain
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/raifpy/futchain/x/futchain/keeper"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the futchain IBC application. Counterparty chains open unordered channels to the
+// futchain port and send query and subscribe packets, and receive the results of the subscribed matches
+// when they finalize.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates the futchain IBC application.
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+// validateChannel checks the port and ordering of a futchain channel.
+func validateChannel(order channeltypes.Order, portID string) error {
+	if portID != types.PortID {
+		return errorsmod.Wrapf(types.ErrInvalidChannel, "invalid port %s, expected %s", portID, types.PortID)
+	}
+	if order != channeltypes.UNORDERED {
+		return errorsmod.Wrapf(types.ErrInvalidChannel, "invalid ordering %s, expected %s", order, channeltypes.UNORDERED)
+	}
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := validateChannel(order, portID); err != nil {
+		return "", err
+	}
+	if version != "" && version != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidChannel, "invalid version %s, expected %s", version, types.Version)
+	}
+	return types.Version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := validateChannel(order, portID); err != nil {
+		return "", err
+	}
+	if counterpartyVersion != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidChannel, "invalid counterparty version %s, expected %s", counterpartyVersion, types.Version)
+	}
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return errorsmod.Wrapf(types.ErrInvalidChannel, "invalid counterparty version %s, expected %s", counterpartyVersion, types.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface. Futchain channels can not be closed by users.
+func (im IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return errorsmod.Wrap(types.ErrInvalidChannel, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.keeper.RemoveChannelSubscriptions(ctx, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. Query and subscribe packets are acknowledged with the
+// current result of their match.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	result, err := im.keeper.OnRecvFutchainPacket(ctx, packet)
+	if err != nil {
+		ctx.Logger().Debug("failed to handle futchain packet", "error", err, "channel", packet.GetDestChannel(), "sequence", packet.GetSequence())
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement(result)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. Results are delivered once, so a failed
+// acknowledgement is only reported.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "cannot unmarshal acknowledgement: %s", err)
+	}
+
+	if !ack.Success() {
+		ctx.Logger().Error("match result packet failed on the counterparty", "error", ack.GetError(), "channel", packet.GetSourceChannel(), "sequence", packet.GetSequence())
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent("ibc_match_result_acknowledged",
+		sdk.NewAttribute("channel", packet.GetSourceChannel()),
+		sdk.NewAttribute("sequence", fmt.Sprint(packet.GetSequence())),
+		sdk.NewAttribute("success", fmt.Sprint(ack.Success())),
+	))
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	ctx.Logger().Error("match result packet timed out", "channel", packet.GetSourceChannel(), "sequence", packet.GetSequence())
+	ctx.EventManager().EmitEvent(sdk.NewEvent("ibc_match_result_timeout",
+		sdk.NewAttribute("channel", packet.GetSourceChannel()),
+		sdk.NewAttribute("sequence", fmt.Sprint(packet.GetSequence())),
+	))
+	return nil
+}
@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"strconv"
+	"math/big"
 
 	"cosmossdk.io/core/appmodule"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -14,8 +14,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"github.com/raifpy/futchain/x/futchain/client/cli"
 	"github.com/raifpy/futchain/x/futchain/keeper"
 	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
 	"github.com/raifpy/futchain/x/futchain/types"
@@ -71,6 +73,12 @@ func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtim
 	}
 }
 
+// GetQueryCmd returns the custom query commands of the module, which autocli extends with the commands of
+// the query service.
+func (AppModule) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 // RegisterInterfaces registers a module's interface types and their concrete implementations as proto.Message.
 func (AppModule) RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registrar)
@@ -81,6 +89,14 @@ func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(registrar, keeper.NewQueryServerImpl(am.keeper))
 
+	// the module manager registers the services with its configurator, which also registers the migrations
+	if cfg, ok := registrar.(module.Configurator); ok {
+		m := keeper.NewMigrator(&am.keeper)
+		if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+			return fmt.Errorf("failed to register the x/%s migration from version 1: %w", types.ModuleName, err)
+		}
+	}
+
 	return nil
 }
 
@@ -131,7 +147,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -146,6 +162,19 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 		return err
 	}
 
+	// the reports of the bonded reporters are aggregated every block. They were mapped to the canonical ids
+	// when they were stored
+	reported, err := am.keeper.AggregateMatchReports(ctx)
+	if err != nil {
+		ctx.Logger().Error("failed to aggregate match reports", "error", err)
+	} else {
+		am.ingest(goCtx, reported)
+	}
+
+	if params.ReporterOnlyIngestion {
+		return nil
+	}
+
 	if ctx.BlockHeight()%params.FetchModulo != 0 {
 		ctx.Logger().Debug("block height is not divisible by the fetch modulo. skipping fetch", "block height", ctx.BlockHeight(), "fetch modulo", params.FetchModulo)
 		return nil // we don't fetch data if the block height is not divisible by the fetch modulo
@@ -159,6 +188,30 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 
 	}
 
+	result, err = am.keeper.FilterAttestedMatches(ctx, result)
+	if err != nil {
+		ctx.Logger().Error("failed to verify provider attestations", "error", err)
+		return nil
+	}
+
+	// the source ids are mapped to the canonical ids all at once or not at all
+	cacheCtx, write := ctx.CacheContext()
+	result, err = am.keeper.CanonicalizeIDs(cacheCtx, types.SourceProvider, result)
+	if err != nil {
+		ctx.Logger().Error("failed to map the source ids to canonical ids", "error", err)
+		return nil
+	}
+	write()
+
+	am.ingest(goCtx, result)
+	return nil
+}
+
+// ingest saves the new leagues, teams and matches, and applies the match updates of the data source or the
+// aggregated reporter reports.
+func (am AppModule) ingest(goCtx context.Context, result []datasource.League) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
 	for _, l := range result {
 
 		saved, err := am.keeper.SaveLeagueIfNotExists(goCtx, l)
@@ -170,8 +223,12 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 		if saved {
 			// event that we have detected a new league
 			ctx.Logger().Info("detected a new league", "league", l.Name, "id", l.ID, "group", l.GroupName, "event", "new_league")
-			ctx.EventManager().EmitEvent(sdk.NewEvent("new_league", sdk.NewAttribute("league", l.Name), sdk.NewAttribute("id", strconv.Itoa(l.ID))))
-			//TODO: set TypedEvent
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventNewLeague{Id: int64(l.ID), Name: l.Name, GroupName: l.GroupName}); err != nil {
+				ctx.Logger().Error("failed to emit event", "error", err, "event", "new_league", "league", l.ID)
+			}
+			if err := am.keeper.EmitEvmLog(ctx, types.EvmEventNewLeague, big.NewInt(int64(l.ID)), l.Name); err != nil {
+				ctx.Logger().Error("failed to emit evm log", "error", err, "event", types.EvmEventNewLeague, "league", l.ID)
+			}
 		}
 		for _, m := range l.Matches {
 			// save teams if not exists
@@ -191,15 +248,26 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 			}
 
 			if saved {
+				if err := am.keeper.SetMatchAttestation(goCtx, m); err != nil {
+					ctx.Logger().Error("failed to save match attestation to the store", "error", err, "match", m.ID)
+				}
+
 				ctx.Logger().Info("detected a new match", "match", m.ID, "event", "new_match")
-				ctx.EventManager().EmitEvent(sdk.NewEvent("new_match", sdk.NewAttribute("id", strconv.Itoa(m.ID)), sdk.NewAttribute("league_id", strconv.Itoa(m.LeagueID)), sdk.NewAttribute("match", m.Home.Name+"/"+m.Away.Name), sdk.NewAttribute("home_id", strconv.Itoa(m.Home.ID)), sdk.NewAttribute("away_id", strconv.Itoa(m.Away.ID)), sdk.NewAttribute("event", "new_match")))
+				if err := ctx.EventManager().EmitTypedEvent(&types.EventNewMatch{Match: matchState(m)}); err != nil {
+					ctx.Logger().Error("failed to emit event", "error", err, "event", "new_match", "match", m.ID)
+				}
+				if err := am.keeper.EmitEvmLog(ctx, types.EvmEventNewMatch, big.NewInt(int64(m.ID)), big.NewInt(int64(m.LeagueID)), big.NewInt(int64(m.Home.ID)), big.NewInt(int64(m.Away.ID))); err != nil {
+					ctx.Logger().Error("failed to emit evm log", "error", err, "event", types.EvmEventNewMatch, "match", m.ID)
+				}
 
-				if !m.Status.Finished {
+				if !m.Status.Finished && !m.Status.Cancelled {
 					// new match, and not finished. let's save it.
 					err := am.keeper.SaveUnfinishedMatch(goCtx, m)
 					if err != nil {
 						ctx.Logger().Error("failed to save unfinished match to the store", "error", err, "match", m.ID, "league_id", m.LeagueID, "home_id", m.Home.ID, "away_id", m.Away.ID)
 					}
+				} else if err := am.keeper.StartFinalityWindow(goCtx, int64(m.ID)); err != nil {
+					ctx.Logger().Error("failed to start match finality window", "error", err, "match", m.ID)
 				}
 
 			} else {
@@ -213,29 +281,96 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 
 				if pri := m.Compare(oldmatch); pri != datasource.PriorityNoChanges {
 
+					if finalized, err := am.keeper.IsMatchFinalized(goCtx, int64(m.ID)); err != nil {
+						ctx.Logger().Error("failed to get match finality", "error", err, "match", m.ID)
+						continue
+					} else if finalized {
+						// finalized results are settlement-grade, late source updates are ignored
+						ctx.Logger().Info("ignoring update of a finalized match", "match", m.ID)
+						continue
+					}
+
 					err := am.keeper.SetMatch(goCtx, m)
 					if err != nil {
 						ctx.Logger().Error("failed to set match to the store", "error", err, "match", m.ID, "league_id", m.LeagueID, "home_id", m.Home.ID, "away_id", m.Away.ID)
 						continue
 					}
+					if err := am.keeper.SetMatchAttestation(goCtx, m); err != nil {
+						ctx.Logger().Error("failed to set match attestation to the store", "error", err, "match", m.ID)
+					}
 
-					if (!oldmatch.Status.Finished && m.Status.Finished) || (!oldmatch.Status.Cancelled && m.Status.Cancelled) {
+					wasOver, isOver := oldmatch.Status.Finished || oldmatch.Status.Cancelled, m.Status.Finished || m.Status.Cancelled
+					switch {
+					case !wasOver && isOver:
 						// match has finished now. remove it from unfinished matches
 						err := am.keeper.DeleteUnfinishedMatch(goCtx, m.ID)
 						if err != nil {
 							ctx.Logger().Error("failed to delete unfinished match from the store", "error", err, "match", m.ID, "league_id", m.LeagueID, "home_id", m.Home.ID, "away_id", m.Away.ID)
 						}
+						// the result is final once it stays unchanged for the dispute window
+						if err := am.keeper.StartFinalityWindow(goCtx, int64(m.ID)); err != nil {
+							ctx.Logger().Error("failed to start match finality window", "error", err, "match", m.ID)
+						}
 						// event emit that match has finished
 						ctx.Logger().Info("match has finished", "match", m.ID, "event", "match_finished")
-						ctx.EventManager().EmitEvent(sdk.NewEvent("match_finished", sdk.NewAttribute("id", strconv.Itoa(m.ID))))
+						if err := ctx.EventManager().EmitTypedEvent(&types.EventMatchFinished{
+							MatchId:   int64(m.ID),
+							HomeScore: int64(m.Home.Score),
+							AwayScore: int64(m.Away.Score),
+							Cancelled: m.Status.Cancelled,
+						}); err != nil {
+							ctx.Logger().Error("failed to emit event", "error", err, "event", "match_finished", "match", m.ID)
+						}
+						if err := am.keeper.EmitEvmLog(ctx, types.EvmEventMatchFinished, big.NewInt(int64(m.ID)), big.NewInt(int64(m.Home.Score)), big.NewInt(int64(m.Away.Score)), m.Status.Cancelled); err != nil {
+							ctx.Logger().Error("failed to emit evm log", "error", err, "event", types.EvmEventMatchFinished, "match", m.ID)
+						}
+
+					case wasOver && !isOver:
+						// the source reopened the match within the dispute window
+						if err := am.keeper.ReopenMatch(goCtx, int64(m.ID)); err != nil {
+							ctx.Logger().Error("failed to reopen match", "error", err, "match", m.ID)
+						}
+						if err := am.keeper.SaveUnfinishedMatch(goCtx, m); err != nil {
+							ctx.Logger().Error("failed to save unfinished match to the store", "error", err, "match", m.ID, "league_id", m.LeagueID, "home_id", m.Home.ID, "away_id", m.Away.ID)
+						}
+						ctx.Logger().Info("match has been reopened", "match", m.ID, "event", "match_reopened")
+						if err := ctx.EventManager().EmitTypedEvent(&types.EventMatchReopened{MatchId: int64(m.ID)}); err != nil {
+							ctx.Logger().Error("failed to emit event", "error", err, "event", "match_reopened", "match", m.ID)
+						}
+
+					case wasOver && isOver && (oldmatch.Home.Score != m.Home.Score || oldmatch.Away.Score != m.Away.Score || oldmatch.Status.Cancelled != m.Status.Cancelled):
+						// the source revised the result within the dispute window. restart it
+						if err := am.keeper.StartFinalityWindow(goCtx, int64(m.ID)); err != nil {
+							ctx.Logger().Error("failed to start match finality window", "error", err, "match", m.ID)
+						}
+						ctx.Logger().Info("match result has been revised", "match", m.ID, "event", "match_result_revised")
+						if err := ctx.EventManager().EmitTypedEvent(&types.EventMatchResultRevised{
+							MatchId:   int64(m.ID),
+							HomeScore: int64(m.Home.Score),
+							AwayScore: int64(m.Away.Score),
+							Cancelled: m.Status.Cancelled,
+						}); err != nil {
+							ctx.Logger().Error("failed to emit event", "error", err, "event", "match_result_revised", "match", m.ID)
+						}
 					}
 
 					// match has changed.
 					if pri >= datasource.MinimumEventPriority {
 
-						// we will emit an event pri.EventName()
 						ctx.Logger().Info("match has changed", "match", m.ID, "event", pri.EventName())
-						ctx.EventManager().EmitEvent(sdk.NewEvent(pri.EventName(), sdk.NewAttribute("id", strconv.Itoa(m.ID)), sdk.NewAttribute("league_id", strconv.Itoa(m.LeagueID)), sdk.NewAttribute("match", m.Home.Name+"/"+m.Away.Name), sdk.NewAttribute("home_id", strconv.Itoa(m.Home.ID)), sdk.NewAttribute("away_id", strconv.Itoa(m.Away.ID)), sdk.NewAttribute("event", pri.EventName())))
+						if err := ctx.EventManager().EmitTypedEvent(&types.EventMatchUpdated{
+							Priority: types.MatchUpdatePriority(pri),
+							Old:      matchState(*oldmatch),
+							New:      matchState(m),
+						}); err != nil {
+							ctx.Logger().Error("failed to emit event", "error", err, "event", pri.EventName(), "match", m.ID)
+						}
+					}
+
+					if pri == datasource.PriorityScore {
+						if err := am.keeper.EmitEvmLog(ctx, types.EvmEventMatchScoreChanged, big.NewInt(int64(m.ID)), big.NewInt(int64(m.Home.Score)), big.NewInt(int64(m.Away.Score))); err != nil {
+							ctx.Logger().Error("failed to emit evm log", "error", err, "event", types.EvmEventMatchScoreChanged, "match", m.ID)
+						}
 					}
 				} else {
 					ctx.Logger().Debug("match has no changes", "match", m.ID, "league_id", m.LeagueID, "home_id", m.Home.ID, "away_id", m.Away.ID)
@@ -245,12 +380,41 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 		}
 
 	}
-
-	return nil
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
-// The end block implementation is optional.
-func (am AppModule) EndBlock(_ context.Context) error {
+// It expires the stale data corrections, finalizes the match results past their dispute window, retries the
+// failed market settlements, delivers the callbacks of the finalized matches, distributes the oracle rewards at the end of an epoch, penalizes
+// the unrevealed report commits and returns the bonds of the unbonded reporters.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := am.keeper.ExpireCorrections(ctx); err != nil {
+		ctx.Logger().Error("failed to expire corrections", "error", err)
+	}
+
+	if err := am.keeper.FinalizeMatches(ctx); err != nil {
+		ctx.Logger().Error("failed to finalize matches", "error", err)
+	}
+
+	if err := am.keeper.RetryMarketSettlements(ctx); err != nil {
+		ctx.Logger().Error("failed to retry market settlements", "error", err)
+	}
+
+	if err := am.keeper.DeliverMatchCallbacks(ctx); err != nil {
+		ctx.Logger().Error("failed to deliver match callbacks", "error", err)
+	}
+
+	if err := am.keeper.DistributeOracleRewards(ctx); err != nil {
+		ctx.Logger().Error("failed to distribute oracle rewards", "error", err)
+	}
+
+	if err := am.keeper.ExpireReportCommits(ctx); err != nil {
+		ctx.Logger().Error("failed to expire report commits", "error", err)
+	}
+
+	if err := am.keeper.ReleaseReporterBonds(ctx); err != nil {
+		ctx.Logger().Error("failed to release reporter bonds", "error", err)
+	}
 	return nil
 }
@@ -0,0 +1,125 @@
+package proof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	ics23 "github.com/cosmos/ics23/go"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// InnerOp is a step of an existence proof: the hash of the child is sha256(Prefix || child || Suffix).
+type InnerOp struct {
+	Prefix []byte `json:"prefix"`
+	Suffix []byte `json:"suffix"`
+}
+
+// ExistenceProof is the compact form of an ICS-23 existence proof, with the sha256 hash and varint length
+// operations of both the IAVL and the simple merkle specs implied. It mirrors the ExistenceProof struct of
+// the FutchainProofVerifier solidity library.
+type ExistenceProof struct {
+	Key        []byte    `json:"key"`
+	Value      []byte    `json:"value"`
+	LeafPrefix []byte    `json:"leaf_prefix"`
+	Path       []InnerOp `json:"path"`
+}
+
+// EVMProof is the pair of existence proofs of a key of the module store: the IAVL proof of the key up to the
+// store root, and the simple merkle proof of the store root up to the app hash.
+type EVMProof struct {
+	IAVL  ExistenceProof `json:"iavl"`
+	Store ExistenceProof `json:"store"`
+}
+
+// Root computes the root hash of the proof.
+func (p ExistenceProof) Root() []byte {
+	valueHash := sha256.Sum256(p.Value)
+
+	leaf := append([]byte{}, p.LeafPrefix...)
+	leaf = binary.AppendUvarint(leaf, uint64(len(p.Key)))
+	leaf = append(leaf, p.Key...)
+	leaf = binary.AppendUvarint(leaf, uint64(len(valueHash)))
+	leaf = append(leaf, valueHash[:]...)
+	hash := sha256.Sum256(leaf)
+
+	for _, op := range p.Path {
+		preimage := append(append(append([]byte{}, op.Prefix...), hash[:]...), op.Suffix...)
+		hash = sha256.Sum256(preimage)
+	}
+	return hash[:]
+}
+
+// Verify checks the proofs of a key of the module store against an app hash, the way the
+// FutchainProofVerifier solidity library does.
+func (p EVMProof) Verify(appHash []byte) error {
+	if !bytes.Equal(p.Store.Key, []byte(types.StoreKey)) {
+		return fmt.Errorf("store proof is for store %q, not %q", p.Store.Key, types.StoreKey)
+	}
+	if !bytes.Equal(p.Store.Value, p.IAVL.Root()) {
+		return fmt.Errorf("store proof does not commit to the root of the iavl proof")
+	}
+	if !bytes.Equal(p.Store.Root(), appHash) {
+		return fmt.Errorf("store proof does not commit to the app hash")
+	}
+	return nil
+}
+
+// EVMProof converts the proof of the match result into its compact form.
+func (m MatchWithProof) EVMProof() (EVMProof, error) {
+	return NewEVMProof(m.Proof)
+}
+
+// NewEVMProof converts the ICS-23 proof operations of a key of the module store, as returned by
+// QueryKeyWithProof, into their compact form.
+func NewEVMProof(proof *cmtcrypto.ProofOps) (EVMProof, error) {
+	if proof == nil || len(proof.Ops) != 2 {
+		return EVMProof{}, fmt.Errorf("expected an iavl and a store proof operation")
+	}
+
+	iavl, err := newExistenceProof(proof.Ops[0], storetypes.ProofOpIAVLCommitment)
+	if err != nil {
+		return EVMProof{}, err
+	}
+	store, err := newExistenceProof(proof.Ops[1], storetypes.ProofOpSimpleMerkleCommitment)
+	if err != nil {
+		return EVMProof{}, err
+	}
+	return EVMProof{IAVL: iavl, Store: store}, nil
+}
+
+func newExistenceProof(op cmtcrypto.ProofOp, typ string) (ExistenceProof, error) {
+	if op.Type != typ {
+		return ExistenceProof{}, fmt.Errorf("expected a %s proof operation, got %s", typ, op.Type)
+	}
+
+	var commitment ics23.CommitmentProof
+	if err := commitment.Unmarshal(op.Data); err != nil {
+		return ExistenceProof{}, err
+	}
+	exist := commitment.GetExist()
+	if exist == nil {
+		return ExistenceProof{}, fmt.Errorf("%s proof operation is not an existence proof", typ)
+	}
+
+	leaf := exist.Leaf
+	if leaf == nil || leaf.Hash != ics23.HashOp_SHA256 || leaf.PrehashKey != ics23.HashOp_NO_HASH ||
+		leaf.PrehashValue != ics23.HashOp_SHA256 || leaf.Length != ics23.LengthOp_VAR_PROTO {
+		return ExistenceProof{}, fmt.Errorf("unsupported leaf operation in the %s proof", typ)
+	}
+
+	path := make([]InnerOp, len(exist.Path))
+	for i, inner := range exist.Path {
+		if inner.Hash != ics23.HashOp_SHA256 {
+			return ExistenceProof{}, fmt.Errorf("unsupported inner operation in the %s proof", typ)
+		}
+		path[i] = InnerOp{Prefix: inner.Prefix, Suffix: inner.Suffix}
+	}
+
+	return ExistenceProof{Key: exist.Key, Value: exist.Value, LeafPrefix: leaf.Prefix, Path: path}, nil
+}
@@ -0,0 +1,125 @@
+// Package proof queries the result records of matches with their ICS-23 proofs, and verifies them against
+// the app hash of a FutChain block. A relayer posts the compact form of the proofs, see EVMProof, to the
+// FutchainProofVerifier solidity library to prove a match result on another chain.
+package proof
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// MatchWithProof is the result record of a match with its proof against the app hash committed for the
+// state at Height. That app hash is in the header of the next block, Height+1.
+type MatchWithProof struct {
+	MatchID uint64              `json:"match_id"`
+	Height  int64               `json:"height"`
+	Key     []byte              `json:"key"`
+	Value   []byte              `json:"value"`
+	Result  types.MatchResult   `json:"result"`
+	Proof   *cmtcrypto.ProofOps `json:"proof"`
+}
+
+// QueryKeyWithProof queries the value of a key of the module store at a height, zero for the latest, with
+// its proof from the IAVL tree of the store up to the app hash.
+func QueryKeyWithProof(clientCtx client.Context, key []byte, height int64) (abci.ResponseQuery, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   types.MatchResultProofPath,
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return abci.ResponseQuery{}, err
+	}
+	if res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+		return abci.ResponseQuery{}, fmt.Errorf("no proof returned for key %X at height %d", key, res.Height)
+	}
+	return res, nil
+}
+
+// QueryMatchWithProof queries the result record of a match at a height, zero for the latest, with its proof.
+func QueryMatchWithProof(clientCtx client.Context, matchID uint64, height int64) (MatchWithProof, error) {
+	res, err := QueryKeyWithProof(clientCtx, types.MatchResultKey(matchID), height)
+	if err != nil {
+		return MatchWithProof{}, err
+	}
+	if len(res.Value) == 0 {
+		return MatchWithProof{}, fmt.Errorf("match %d has no result at height %d", matchID, res.Height)
+	}
+
+	result, err := types.ParseMatchResult(res.Value)
+	if err != nil {
+		return MatchWithProof{}, err
+	}
+	return MatchWithProof{
+		MatchID: matchID,
+		Height:  res.Height,
+		Key:     res.Key,
+		Value:   res.Value,
+		Result:  result,
+		Proof:   res.ProofOps,
+	}, nil
+}
+
+// QueryAppHash returns the app hash committed for the state at a height, from the header of the next block.
+func QueryAppHash(ctx context.Context, clientCtx client.Context, height int64) ([]byte, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	next := height + 1
+	commit, err := node.Commit(ctx, &next)
+	if err != nil {
+		return nil, fmt.Errorf("app hash of height %d is not committed yet: %w", height, err)
+	}
+	return commit.AppHash, nil
+}
+
+// QueryLatestProvableHeight returns the latest height whose app hash is committed.
+func QueryLatestProvableHeight(ctx context.Context, clientCtx client.Context) (int64, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	status, err := node.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight - 1, nil
+}
+
+// Verify verifies the proof of the match result against an app hash.
+func (m MatchWithProof) Verify(appHash []byte) error {
+	if !bytes.Equal(m.Key, types.MatchResultKey(m.MatchID)) {
+		return fmt.Errorf("key %X is not the result key of match %d", m.Key, m.MatchID)
+	}
+	result, err := types.ParseMatchResult(m.Value)
+	if err != nil {
+		return err
+	}
+	if result != m.Result || result.MatchID != m.MatchID {
+		return fmt.Errorf("value does not encode the result of match %d", m.MatchID)
+	}
+	return VerifyKey(appHash, m.Key, m.Value, m.Proof)
+}
+
+// VerifyKey verifies the proof of the value of a key of the module store against an app hash.
+func VerifyKey(appHash, key, value []byte, proof *cmtcrypto.ProofOps) error {
+	if proof == nil {
+		return fmt.Errorf("empty proof")
+	}
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL)
+	return rootmulti.DefaultProofRuntime().VerifyValue(proof, appHash, keyPath.String(), value)
+}
@@ -0,0 +1,65 @@
+package proof_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/raifpy/futchain/x/futchain/proof"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func TestMatchWithProof(t *testing.T) {
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	store.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	for _, name := range []string{"acc", "bank", "staking"} {
+		store.MountStoreWithDB(storetypes.NewKVStoreKey(name), storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, store.LoadLatestVersion())
+
+	result := types.MatchResult{MatchID: 42, HomeScore: 2, AwayScore: 1, Started: true, Finished: true, Finalized: true}
+	kv := store.GetKVStore(key)
+	kv.Set(types.MatchResultKey(result.MatchID), result.Bytes())
+	for id := uint64(1); id < 20; id++ {
+		kv.Set(types.MatchResultKey(id), types.MatchResult{MatchID: id}.Bytes())
+	}
+	commit := store.Commit()
+
+	res, err := store.Query(&storetypes.RequestQuery{
+		Path:   "/" + types.StoreKey + "/key",
+		Data:   types.MatchResultKey(result.MatchID),
+		Height: commit.Version,
+		Prove:  true,
+	})
+	require.NoError(t, err)
+
+	parsed, err := types.ParseMatchResult(res.Value)
+	require.NoError(t, err)
+	require.Equal(t, result, parsed)
+
+	m := proof.MatchWithProof{MatchID: result.MatchID, Height: res.Height, Key: res.Key, Value: res.Value, Result: parsed, Proof: res.ProofOps}
+	require.NoError(t, m.Verify(commit.Hash))
+	require.Error(t, m.Verify([]byte("another app hash")))
+
+	tampered := m
+	tampered.Result.HomeScore = 3
+	tampered.Value = tampered.Result.Bytes()
+	require.Error(t, tampered.Verify(commit.Hash))
+
+	// the compact proofs for the solidity verifier commit to the same app hash
+	evmProof, err := m.EVMProof()
+	require.NoError(t, err)
+	require.Equal(t, res.Key, evmProof.IAVL.Key)
+	require.Equal(t, res.Value, evmProof.IAVL.Value)
+	require.NoError(t, evmProof.Verify(commit.Hash))
+
+	evmProof.IAVL.Value = tampered.Value
+	require.Error(t, evmProof.Verify(commit.Hash))
+}
@@ -0,0 +1,53 @@
+package stream
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+var _ types.StreamServer = streamServer{}
+
+type streamServer struct {
+	hub *Hub
+}
+
+// NewStreamServer returns an implementation of the StreamServer interface watching the hub.
+func NewStreamServer(hub *Hub) types.StreamServer {
+	return streamServer{hub: hub}
+}
+
+func (s streamServer) WatchMatches(req *types.WatchMatchesRequest, srv types.Stream_WatchMatchesServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := validateFilter(req); err != nil {
+		return err
+	}
+
+	sub := s.hub.Subscribe(*req)
+	defer sub.Close()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return status.FromContextError(srv.Context().Err()).Err()
+		case update, ok := <-sub.Updates():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watcher fell behind")
+			}
+			if err := srv.Send(&update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// validateFilter checks the priority threshold of a watch filter.
+func validateFilter(filter *types.WatchMatchesRequest) error {
+	if _, ok := types.MatchUpdatePriority_name[int32(filter.MinPriority)]; !ok {
+		return status.Error(codes.InvalidArgument, "invalid min priority")
+	}
+	return nil
+}
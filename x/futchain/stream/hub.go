@@ -0,0 +1,155 @@
+package stream
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/keeper/datasource"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// WatcherBuffer is the number of match updates buffered for a watcher. A watcher falling further behind is
+// dropped.
+const WatcherBuffer = 256
+
+var _ storetypes.ABCIListener = (*Hub)(nil)
+
+// Hub fans the match updates of the committed blocks out to the watchers. It is registered as an ABCI
+// listener of the app: the match events of a block are collected when it is finalized, and sent once it
+// is committed.
+type Hub struct {
+	mu       sync.Mutex
+	watchers map[*Subscription]struct{}
+	pending  []types.WatchMatchesResponse
+}
+
+// NewHub creates a hub without watchers.
+func NewHub() *Hub {
+	return &Hub{watchers: map[*Subscription]struct{}{}}
+}
+
+// Subscription is a watcher of the hub.
+type Subscription struct {
+	hub     *Hub
+	filter  types.WatchMatchesRequest
+	updates chan types.WatchMatchesResponse
+}
+
+// Subscribe adds a watcher of the match updates passing the filter.
+func (h *Hub) Subscribe(filter types.WatchMatchesRequest) *Subscription {
+	sub := &Subscription{hub: h, filter: filter, updates: make(chan types.WatchMatchesResponse, WatcherBuffer)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.watchers[sub] = struct{}{}
+	return sub
+}
+
+// Updates returns the match updates of the watcher. It is closed when the watcher is closed, or dropped for
+// falling behind.
+func (s *Subscription) Updates() <-chan types.WatchMatchesResponse {
+	return s.updates
+}
+
+// Close removes the watcher from its hub.
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
+
+// remove removes a watcher and closes its updates. The hub lock must be held.
+func (h *Hub) remove(sub *Subscription) {
+	if _, ok := h.watchers[sub]; ok {
+		delete(h.watchers, sub)
+		close(sub.updates)
+	}
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface. It collects the match events of the block.
+func (h *Hub) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	events := slices.Clone(res.Events)
+	for _, tx := range res.TxResults {
+		events = append(events, tx.Events...)
+	}
+
+	var updates []types.WatchMatchesResponse
+	for _, event := range events {
+		update, ok, err := matchUpdate(req.Height, event)
+		if err != nil {
+			return err
+		}
+		if ok {
+			updates = append(updates, update)
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.pending = updates
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface. It sends the match updates of the committed block to
+// the watchers.
+func (h *Hub) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, update := range h.pending {
+		for sub := range h.watchers {
+			if !Matches(&sub.filter, update) {
+				continue
+			}
+			select {
+			case sub.updates <- update:
+			default:
+				h.remove(sub)
+			}
+		}
+	}
+	h.pending = nil
+	return nil
+}
+
+// matchUpdate returns the match update carried by a new or updated match event.
+func matchUpdate(height int64, event abci.Event) (types.WatchMatchesResponse, bool, error) {
+	if event.Type != proto.MessageName(&types.EventNewMatch{}) && event.Type != proto.MessageName(&types.EventMatchUpdated{}) {
+		return types.WatchMatchesResponse{}, false, nil
+	}
+
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return types.WatchMatchesResponse{}, false, err
+	}
+	switch e := msg.(type) {
+	case *types.EventNewMatch:
+		return types.WatchMatchesResponse{Height: height, New: true, Match: e.Match}, true, nil
+	case *types.EventMatchUpdated:
+		return types.WatchMatchesResponse{Height: height, Priority: e.Priority, Match: e.New}, true, nil
+	}
+	return types.WatchMatchesResponse{}, false, nil
+}
+
+// Matches reports whether a match update passes a watch filter.
+func Matches(filter *types.WatchMatchesRequest, update types.WatchMatchesResponse) bool {
+	match := update.Match
+	if len(filter.LeagueIds) > 0 && !slices.Contains(filter.LeagueIds, match.LeagueId) {
+		return false
+	}
+	if len(filter.TeamIds) > 0 && !slices.Contains(filter.TeamIds, match.HomeId) && !slices.Contains(filter.TeamIds, match.AwayId) {
+		return false
+	}
+	if len(filter.MatchIds) > 0 && !slices.Contains(filter.MatchIds, match.Id) {
+		return false
+	}
+	// the update priorities mirror the data source priorities, ranked by the data source
+	return update.New || datasource.ComparePriority(update.Priority) >= datasource.ComparePriority(filter.MinPriority)
+}
@@ -0,0 +1,153 @@
+package stream_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/raifpy/futchain/x/futchain/stream"
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+func typedEvent(t *testing.T, msg proto.Message) abci.Event {
+	t.Helper()
+	event, err := sdk.TypedEventToEvent(msg)
+	require.NoError(t, err)
+	return abci.Event(event)
+}
+
+// commitBlock runs a block carrying the events through the hub.
+func commitBlock(t *testing.T, hub *stream.Hub, height int64, events ...abci.Event) {
+	t.Helper()
+	events = append(events, abci.Event{Type: "match_finalized"})
+	require.NoError(t, hub.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{Events: events}))
+	require.NoError(t, hub.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestHub(t *testing.T) {
+	hub := stream.NewHub()
+
+	match := types.MatchState{Id: 10, LeagueId: 47, HomeId: 1, AwayId: 2, HomeScore: 1}
+	other := types.MatchState{Id: 11, LeagueId: 48, HomeId: 3, AwayId: 1}
+
+	all := hub.Subscribe(types.WatchMatchesRequest{})
+	league := hub.Subscribe(types.WatchMatchesRequest{LeagueIds: []int64{47}})
+	team := hub.Subscribe(types.WatchMatchesRequest{TeamIds: []int64{1}, MinPriority: types.MATCH_UPDATE_PRIORITY_SCORE})
+	defer all.Close()
+	defer league.Close()
+	defer team.Close()
+
+	// the updates are only sent once the block commits
+	require.NoError(t, hub.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: 5}, abci.ResponseFinalizeBlock{
+		Events: []abci.Event{typedEvent(t, &types.EventNewMatch{Match: other})},
+	}))
+	require.Empty(t, all.Updates())
+	require.NoError(t, hub.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+
+	commitBlock(t, hub, 6,
+		typedEvent(t, &types.EventMatchUpdated{Priority: types.MATCH_UPDATE_PRIORITY_LIVE_TIME, New: match}),
+		typedEvent(t, &types.EventMatchUpdated{Priority: types.MATCH_UPDATE_PRIORITY_SCORE, New: other}),
+	)
+
+	require.Equal(t, types.WatchMatchesResponse{Height: 5, New: true, Match: other}, <-all.Updates())
+	require.Equal(t, types.WatchMatchesResponse{Height: 6, Priority: types.MATCH_UPDATE_PRIORITY_LIVE_TIME, Match: match}, <-all.Updates())
+	require.Equal(t, int64(11), (<-all.Updates()).Match.Id)
+	require.Empty(t, all.Updates())
+
+	require.Equal(t, int64(10), (<-league.Updates()).Match.Id)
+	require.Empty(t, league.Updates())
+
+	// new matches are sent whatever the priority threshold
+	require.True(t, (<-team.Updates()).New)
+	require.Equal(t, types.MATCH_UPDATE_PRIORITY_SCORE, (<-team.Updates()).Priority)
+	require.Empty(t, team.Updates())
+
+	// a closed watcher gets no more updates
+	league.Close()
+	commitBlock(t, hub, 7, typedEvent(t, &types.EventNewMatch{Match: match}))
+	_, ok := <-league.Updates()
+	require.False(t, ok)
+	require.Len(t, all.Updates(), 1)
+}
+
+func TestHubDropsSlowWatchers(t *testing.T) {
+	hub := stream.NewHub()
+	sub := hub.Subscribe(types.WatchMatchesRequest{})
+
+	for height := int64(1); height <= stream.WatcherBuffer+1; height++ {
+		commitBlock(t, hub, height, typedEvent(t, &types.EventNewMatch{Match: types.MatchState{Id: height}}))
+	}
+
+	var received int
+	for range sub.Updates() {
+		received++
+	}
+	require.Equal(t, stream.WatcherBuffer, received)
+	sub.Close()
+}
+
+// readMessages reads the text messages of a WebSocket connection until it closes.
+func readMessages(conn *websocket.Conn) <-chan string {
+	messages := make(chan string, 16)
+	go func() {
+		defer close(messages)
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			messages <- string(message)
+		}
+	}()
+	return messages
+}
+
+func TestWebSocket(t *testing.T) {
+	hub := stream.NewHub()
+	router := mux.NewRouter()
+	stream.RegisterWebSocketRoute(router, hub, false)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + stream.WebSocketRoute
+
+	_, resp, err := websocket.DefaultDialer.Dial(url+"?min_priority=unknown", nil)
+	require.Error(t, err)
+	require.Equal(t, 400, resp.StatusCode)
+
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?match_id=10,12&min_priority=match_update_priority_score", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	messages := readMessages(conn)
+
+	// the watcher subscribes once the connection is upgraded, until then the blocks are missed
+	var message string
+	require.Eventually(t, func() bool {
+		commitBlock(t, hub, 1,
+			typedEvent(t, &types.EventMatchUpdated{Priority: types.MATCH_UPDATE_PRIORITY_STARTED, New: types.MatchState{Id: 10}}),
+			typedEvent(t, &types.EventMatchUpdated{Priority: types.MATCH_UPDATE_PRIORITY_SCORE, New: types.MatchState{Id: 11}}),
+			typedEvent(t, &types.EventMatchUpdated{Priority: types.MATCH_UPDATE_PRIORITY_SCORE, New: types.MatchState{Id: 12, HomeScore: 1, HomeName: "Home"}}),
+		)
+		select {
+		case message = <-messages:
+			return true
+		case <-time.After(10 * time.Millisecond):
+			return false
+		}
+	}, time.Second, time.Millisecond)
+
+	require.Contains(t, message, `"height":"1"`)
+	require.Contains(t, message, `"priority":"MATCH_UPDATE_PRIORITY_SCORE"`)
+	require.Contains(t, message, `"id":"12"`)
+	require.Contains(t, message, `"home_name":"Home"`)
+}
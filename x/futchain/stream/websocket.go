@@ -0,0 +1,148 @@
+package stream
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/raifpy/futchain/x/futchain/types"
+)
+
+// WebSocketRoute is the API server route of the match updates WebSocket. The filter is given by the
+// repeated league_id, team_id and match_id query parameters and the min_priority parameter, e.g.
+// ?league_id=47&min_priority=MATCH_UPDATE_PRIORITY_SCORE.
+const WebSocketRoute = "/raifpy/futchain/futchain/v1/matches/watch"
+
+const (
+	// wsWriteTimeout bounds the write of a message to a WebSocket client.
+	wsWriteTimeout = 10 * time.Second
+	// wsPingPeriod is the period of the pings keeping idle WebSocket connections open.
+	wsPingPeriod = 30 * time.Second
+)
+
+// RegisterWebSocketRoute registers the match updates WebSocket on the API server router. Cross-origin
+// clients are only accepted if allowAllOrigins is set.
+func RegisterWebSocketRoute(router *mux.Router, hub *Hub, allowAllOrigins bool) {
+	upgrader := websocket.Upgrader{}
+	if allowAllOrigins {
+		upgrader.CheckOrigin = func(*http.Request) bool { return true }
+	}
+	router.Handle(WebSocketRoute, webSocketHandler{hub: hub, upgrader: upgrader}).Methods(http.MethodGet)
+}
+
+type webSocketHandler struct {
+	hub      *Hub
+	upgrader websocket.Upgrader
+}
+
+// ServeHTTP sends the match updates passing the filter of the request as JSON text messages, until the
+// client closes the connection.
+func (h webSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied
+		return
+	}
+	defer conn.Close()
+
+	sub := h.hub.Subscribe(filter)
+	defer sub.Close()
+
+	// the client only sends control messages, read until it goes away
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ping := time.NewTicker(wsPingPeriod)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				return
+			}
+
+		case update, ok := <-sub.Updates():
+			if !ok {
+				_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "watcher fell behind"), time.Now().Add(wsWriteTimeout))
+				return
+			}
+			bz, err := codec.ProtoMarshalJSON(&update, nil)
+			if err != nil {
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := conn.WriteMessage(websocket.TextMessage, bz); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// parseFilter parses the watch filter of a WebSocket request.
+func parseFilter(r *http.Request) (types.WatchMatchesRequest, error) {
+	params := r.URL.Query()
+
+	var (
+		filter types.WatchMatchesRequest
+		err    error
+	)
+	if filter.LeagueIds, err = parseIDs(params["league_id"]); err != nil {
+		return filter, fmt.Errorf("invalid league_id: %w", err)
+	}
+	if filter.TeamIds, err = parseIDs(params["team_id"]); err != nil {
+		return filter, fmt.Errorf("invalid team_id: %w", err)
+	}
+	if filter.MatchIds, err = parseIDs(params["match_id"]); err != nil {
+		return filter, fmt.Errorf("invalid match_id: %w", err)
+	}
+
+	if priority := params.Get("min_priority"); priority != "" {
+		if value, ok := types.MatchUpdatePriority_value[strings.ToUpper(priority)]; ok {
+			filter.MinPriority = types.MatchUpdatePriority(value)
+		} else if value, err := strconv.ParseInt(priority, 10, 32); err == nil && types.MatchUpdatePriority_name[int32(value)] != "" {
+			filter.MinPriority = types.MatchUpdatePriority(value)
+		} else {
+			return filter, fmt.Errorf("invalid min_priority %q", priority)
+		}
+	}
+	return filter, nil
+}
+
+// parseIDs parses ids given as repeated or comma separated query parameters.
+func parseIDs(values []string) ([]int64, error) {
+	var ids []int64
+	for _, value := range values {
+		for _, s := range strings.Split(value, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
@@ -9,6 +9,27 @@ import (
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgCreateMarket{},
+		&MsgPlaceStake{},
+		&MsgMintOutcomeShares{},
+		&MsgBurnOutcomeShares{},
+		&MsgRedeemOutcomeShares{},
+		&MsgOverrideMatch{},
+		&MsgVoidMatch{},
+		&MsgUpsertTeam{},
+		&MsgUpsertLeague{},
+		&MsgMergeEntities{},
+		&MsgMapExternalID{},
+		&MsgProposeCorrection{},
+		&MsgVoteCorrection{},
+		&MsgSubmitOracleReport{},
+		&MsgClaimOracleRewards{},
+		&MsgRegisterReporter{},
+		&MsgUnbondReporter{},
+		&MsgSubmitMatchReport{},
+		&MsgCommitReport{},
+		&MsgRevealReport{},
+		&MsgFundReporterRewards{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = Correction{}
+	_ codectypes.UnpackInterfacesMessage = MsgProposeCorrection{}
+)
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (c Correction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(c.Msg, &msg)
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (m MsgProposeCorrection) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+// CorrectionAuthority returns the authority of a message the data council can propose, or an error
+// if the message is not a data correction.
+func CorrectionAuthority(msg sdk.Msg) (string, error) {
+	switch msg := msg.(type) {
+	case *MsgOverrideMatch:
+		return msg.Authority, nil
+	case *MsgVoidMatch:
+		return msg.Authority, nil
+	case *MsgUpsertTeam:
+		return msg.Authority, nil
+	case *MsgUpsertLeague:
+		return msg.Authority, nil
+	default:
+		return "", errorsmod.Wrapf(ErrInvalidCorrection, "%s is not a data correction", sdk.MsgTypeURL(msg))
+	}
+}
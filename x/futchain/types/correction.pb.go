@@ -0,0 +1,714 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: futchain/futchain/v1/correction.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CorrectionStatus defines the lifecycle of a data-council correction.
+type CorrectionStatus int32
+
+const (
+	CORRECTION_STATUS_UNSPECIFIED CorrectionStatus = 0
+	// CORRECTION_STATUS_PENDING collects approvals until the threshold or the
+	// expiry height is reached.
+	CORRECTION_STATUS_PENDING CorrectionStatus = 1
+	// CORRECTION_STATUS_APPLIED reached the threshold and its message was
+	// executed.
+	CORRECTION_STATUS_APPLIED CorrectionStatus = 2
+	// CORRECTION_STATUS_FAILED reached the threshold but its message failed.
+	CORRECTION_STATUS_FAILED CorrectionStatus = 3
+	// CORRECTION_STATUS_EXPIRED did not reach the threshold in time.
+	CORRECTION_STATUS_EXPIRED CorrectionStatus = 4
+)
+
+var CorrectionStatus_name = map[int32]string{
+	0: "CORRECTION_STATUS_UNSPECIFIED",
+	1: "CORRECTION_STATUS_PENDING",
+	2: "CORRECTION_STATUS_APPLIED",
+	3: "CORRECTION_STATUS_FAILED",
+	4: "CORRECTION_STATUS_EXPIRED",
+}
+
+var CorrectionStatus_value = map[string]int32{
+	"CORRECTION_STATUS_UNSPECIFIED": 0,
+	"CORRECTION_STATUS_PENDING":     1,
+	"CORRECTION_STATUS_APPLIED":     2,
+	"CORRECTION_STATUS_FAILED":      3,
+	"CORRECTION_STATUS_EXPIRED":     4,
+}
+
+func (x CorrectionStatus) String() string {
+	return proto.EnumName(CorrectionStatus_name, int32(x))
+}
+
+func (CorrectionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4ae115520de5ca79, []int{0}
+}
+
+// Correction is a data correction proposed by a data-council member. It is
+// applied once threshold members approved it.
+type Correction struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// msg is a MsgOverrideMatch, MsgVoidMatch, MsgUpsertTeam or MsgUpsertLeague
+	// whose authority is the module authority.
+	Msg *any.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// approvals are the members that approved the correction, the proposer
+	// included.
+	Approvals []string `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// expiry_height is the height the correction expires at if it is still
+	// pending.
+	ExpiryHeight int64            `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	Status       CorrectionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=futchain.futchain.v1.CorrectionStatus" json:"status,omitempty"`
+	// reason is recorded for auditing.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// error is the execution error of a failed correction.
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *Correction) Reset()         { *m = Correction{} }
+func (m *Correction) String() string { return proto.CompactTextString(m) }
+func (*Correction) ProtoMessage()    {}
+func (*Correction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ae115520de5ca79, []int{0}
+}
+func (m *Correction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Correction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Correction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Correction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Correction.Merge(m, src)
+}
+func (m *Correction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Correction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Correction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Correction proto.InternalMessageInfo
+
+func (m *Correction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Correction) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *Correction) GetMsg() *any.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *Correction) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *Correction) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *Correction) GetStatus() CorrectionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CORRECTION_STATUS_UNSPECIFIED
+}
+
+func (m *Correction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Correction) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("futchain.futchain.v1.CorrectionStatus", CorrectionStatus_name, CorrectionStatus_value)
+	proto.RegisterType((*Correction)(nil), "futchain.futchain.v1.Correction")
+}
+
+func init() {
+	proto.RegisterFile("futchain/futchain/v1/correction.proto", fileDescriptor_4ae115520de5ca79)
+}
+
+var fileDescriptor_4ae115520de5ca79 = []byte{
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xdf, 0x6a, 0xd3, 0x50,
+	0x1c, 0xee, 0x69, 0xbb, 0xba, 0x1d, 0x75, 0x94, 0x43, 0xd1, 0xb3, 0xea, 0x42, 0x54, 0x94, 0xa0,
+	0xec, 0x84, 0x4e, 0xf1, 0x52, 0x48, 0xd3, 0x4c, 0x03, 0xb3, 0x0b, 0x49, 0x07, 0xe2, 0x4d, 0x49,
+	0xd2, 0xd3, 0x34, 0xb0, 0xe6, 0x84, 0x73, 0x4e, 0xcb, 0xf2, 0x06, 0x5e, 0xfa, 0x0e, 0xbe, 0xc2,
+	0xf0, 0x19, 0xc4, 0xab, 0xe1, 0x95, 0x97, 0xd2, 0xbe, 0x88, 0x34, 0xc9, 0x5a, 0x70, 0xc5, 0xbb,
+	0xef, 0xef, 0xf9, 0xe0, 0x97, 0xc0, 0xe7, 0xe3, 0x99, 0x0c, 0x27, 0x7e, 0x9c, 0xe8, 0x6b, 0x30,
+	0xef, 0xe8, 0x21, 0xe3, 0x9c, 0x86, 0x32, 0x66, 0x09, 0x49, 0x39, 0x93, 0x0c, 0xb5, 0x6e, 0x5c,
+	0xb2, 0x06, 0xf3, 0x4e, 0xfb, 0x20, 0x64, 0x62, 0xca, 0xc4, 0x30, 0xcf, 0xe8, 0x05, 0x29, 0x0a,
+	0xed, 0x56, 0xc4, 0x22, 0x56, 0xe8, 0x2b, 0x54, 0xaa, 0x07, 0x11, 0x63, 0xd1, 0x05, 0xd5, 0x73,
+	0x16, 0xcc, 0xc6, 0xba, 0x9f, 0x64, 0x85, 0xf5, 0x74, 0x51, 0x85, 0xd0, 0x5c, 0xcf, 0xa2, 0x7d,
+	0x58, 0x8d, 0x47, 0x18, 0xa8, 0x40, 0xab, 0xbb, 0xd5, 0x78, 0x84, 0xde, 0xc0, 0xdd, 0x94, 0xb3,
+	0x94, 0x09, 0xca, 0x71, 0x55, 0x05, 0xda, 0x5e, 0x17, 0xff, 0xba, 0x3a, 0x6a, 0x95, 0x9b, 0xc6,
+	0x68, 0xc4, 0xa9, 0x10, 0x9e, 0xe4, 0x71, 0x12, 0xb9, 0xeb, 0x24, 0x32, 0x61, 0x6d, 0x2a, 0x22,
+	0x5c, 0x53, 0x81, 0x76, 0xf7, 0xb8, 0x45, 0x8a, 0x75, 0x72, 0xb3, 0x4e, 0x8c, 0x24, 0xeb, 0x3e,
+	0xfa, 0x79, 0x75, 0xf4, 0xb0, 0x7c, 0x26, 0xf0, 0x05, 0x25, 0xf3, 0x4e, 0x40, 0xa5, 0xdf, 0x21,
+	0x1f, 0x45, 0xe4, 0xae, 0xda, 0xe8, 0x2d, 0xdc, 0xf3, 0xd3, 0x94, 0xb3, 0xb9, 0x7f, 0x21, 0x70,
+	0x5d, 0xad, 0xfd, 0x77, 0x7b, 0x13, 0x45, 0xcf, 0xe0, 0x7d, 0x7a, 0x99, 0xc6, 0x3c, 0x1b, 0x4e,
+	0x68, 0x1c, 0x4d, 0x24, 0xde, 0x51, 0x81, 0x56, 0x73, 0xef, 0x15, 0xe2, 0x87, 0x5c, 0x43, 0xef,
+	0x60, 0x43, 0x48, 0x5f, 0xce, 0x04, 0x6e, 0xa8, 0x40, 0xdb, 0x3f, 0x7e, 0x41, 0xb6, 0x5d, 0x9a,
+	0x6c, 0x2e, 0xe3, 0xe5, 0x69, 0xb7, 0x6c, 0xa1, 0x07, 0xb0, 0xc1, 0xa9, 0x2f, 0x58, 0x82, 0xef,
+	0xac, 0xae, 0xe2, 0x96, 0x0c, 0xb5, 0xe0, 0x0e, 0xe5, 0x9c, 0x71, 0xbc, 0x9b, 0xcb, 0x05, 0x79,
+	0xf9, 0x1d, 0xc0, 0xe6, 0xbf, 0x4f, 0xa1, 0x27, 0xf0, 0xd0, 0x3c, 0x73, 0x5d, 0xcb, 0x1c, 0xd8,
+	0x67, 0xfd, 0xa1, 0x37, 0x30, 0x06, 0xe7, 0xde, 0xf0, 0xbc, 0xef, 0x39, 0x96, 0x69, 0x9f, 0xd8,
+	0x56, 0xaf, 0x59, 0x41, 0x87, 0xf0, 0xe0, 0x76, 0xc4, 0xb1, 0xfa, 0x3d, 0xbb, 0xff, 0xbe, 0x09,
+	0xb6, 0xdb, 0x86, 0xe3, 0x9c, 0xae, 0xda, 0x55, 0xf4, 0x18, 0xe2, 0xdb, 0xf6, 0x89, 0x61, 0x9f,
+	0x5a, 0xbd, 0x66, 0x6d, 0x7b, 0xd9, 0xfa, 0xe4, 0xd8, 0xae, 0xd5, 0x6b, 0xd6, 0xdb, 0xf5, 0x2f,
+	0xdf, 0x94, 0x4a, 0xd7, 0xfa, 0xb1, 0x50, 0xc0, 0xf5, 0x42, 0x01, 0x7f, 0x16, 0x0a, 0xf8, 0xba,
+	0x54, 0x2a, 0xd7, 0x4b, 0xa5, 0xf2, 0x7b, 0xa9, 0x54, 0x3e, 0xbf, 0x8a, 0x62, 0x39, 0x99, 0x05,
+	0x24, 0x64, 0x53, 0x9d, 0xfb, 0xf1, 0x38, 0xcd, 0x36, 0x7f, 0xf2, 0xe5, 0x06, 0xca, 0x2c, 0xa5,
+	0x22, 0x68, 0xe4, 0x9f, 0xfe, 0xf5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0xed, 0xe7, 0x70,
+	0xf6, 0x02, 0x00, 0x00,
+}
+
+func (m *Correction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Correction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Correction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCorrection(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCorrection(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintCorrection(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintCorrection(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintCorrection(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCorrection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintCorrection(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCorrection(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCorrection(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCorrection(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Correction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCorrection(uint64(m.Id))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovCorrection(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovCorrection(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovCorrection(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovCorrection(uint64(m.ExpiryHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovCorrection(uint64(m.Status))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCorrection(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCorrection(uint64(l))
+	}
+	return n
+}
+
+func sovCorrection(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCorrection(x uint64) (n int) {
+	return sovCorrection(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Correction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCorrection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Correction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Correction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &any.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CorrectionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCorrection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCorrection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCorrection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCorrection(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCorrection
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCorrection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCorrection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCorrection
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCorrection
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCorrection
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCorrection        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCorrection          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCorrection = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// SourceProvider is the provider the ids of the fetched data source belong to.
+	SourceProvider = "fotmob"
+	// ReporterProvider is the provider the ids of the match reports belong to. They are mapped to the
+	// canonical ids when the reports are stored.
+	ReporterProvider = "reporter"
+
+	// MaxCanonicalID is the max canonical id, the stored entities encoding their ids as int32.
+	MaxCanonicalID = math.MaxInt32
+
+	// MaxExternalIDLength is the max length of a provider name or an external id.
+	MaxExternalIDLength = 128
+)
+
+// ValidateEntityType returns an error unless the entity type is a team, a league or a match.
+func ValidateEntityType(entityType EntityType) error {
+	switch entityType {
+	case ENTITY_TYPE_TEAM, ENTITY_TYPE_LEAGUE, ENTITY_TYPE_MATCH:
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidIDMapping, "invalid entity type %s", entityType)
+	}
+}
+
+// ValidateExternalID returns an error unless the provider and the external id are set and short enough.
+func ValidateExternalID(provider, externalID string) error {
+	if provider == "" || externalID == "" {
+		return errorsmod.Wrap(ErrInvalidIDMapping, "provider and external id are required")
+	}
+	if len(provider) > MaxExternalIDLength || len(externalID) > MaxExternalIDLength {
+		return errorsmod.Wrapf(ErrInvalidIDMapping, "provider and external id must be at most %d bytes", MaxExternalIDLength)
+	}
+	return nil
+}
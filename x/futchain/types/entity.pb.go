@@ -0,0 +1,483 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: futchain/futchain/v1/entity.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EntityType is the type of an entity with a canonical id.
+type EntityType int32
+
+const (
+	ENTITY_TYPE_UNSPECIFIED EntityType = 0
+	ENTITY_TYPE_TEAM        EntityType = 1
+	ENTITY_TYPE_LEAGUE      EntityType = 2
+	ENTITY_TYPE_MATCH       EntityType = 3
+)
+
+var EntityType_name = map[int32]string{
+	0: "ENTITY_TYPE_UNSPECIFIED",
+	1: "ENTITY_TYPE_TEAM",
+	2: "ENTITY_TYPE_LEAGUE",
+	3: "ENTITY_TYPE_MATCH",
+}
+
+var EntityType_value = map[string]int32{
+	"ENTITY_TYPE_UNSPECIFIED": 0,
+	"ENTITY_TYPE_TEAM":        1,
+	"ENTITY_TYPE_LEAGUE":      2,
+	"ENTITY_TYPE_MATCH":       3,
+}
+
+func (x EntityType) String() string {
+	return proto.EnumName(EntityType_name, int32(x))
+}
+
+func (EntityType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_106f1e9066e33082, []int{0}
+}
+
+// IDMapping maps the id of an entity at a data provider to its canonical id,
+// the id the entity is stored, queried and settled with on the chain.
+type IDMapping struct {
+	EntityType EntityType `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=futchain.futchain.v1.EntityType" json:"entity_type,omitempty"`
+	Provider   string     `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// external_id is the id of the entity at the provider.
+	ExternalId  string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	CanonicalId int64  `protobuf:"varint,4,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+}
+
+func (m *IDMapping) Reset()         { *m = IDMapping{} }
+func (m *IDMapping) String() string { return proto.CompactTextString(m) }
+func (*IDMapping) ProtoMessage()    {}
+func (*IDMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_106f1e9066e33082, []int{0}
+}
+func (m *IDMapping) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IDMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IDMapping.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IDMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDMapping.Merge(m, src)
+}
+func (m *IDMapping) XXX_Size() int {
+	return m.Size()
+}
+func (m *IDMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IDMapping proto.InternalMessageInfo
+
+func (m *IDMapping) GetEntityType() EntityType {
+	if m != nil {
+		return m.EntityType
+	}
+	return ENTITY_TYPE_UNSPECIFIED
+}
+
+func (m *IDMapping) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *IDMapping) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *IDMapping) GetCanonicalId() int64 {
+	if m != nil {
+		return m.CanonicalId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("futchain.futchain.v1.EntityType", EntityType_name, EntityType_value)
+	proto.RegisterType((*IDMapping)(nil), "futchain.futchain.v1.IDMapping")
+}
+
+func init() { proto.RegisterFile("futchain/futchain/v1/entity.proto", fileDescriptor_106f1e9066e33082) }
+
+var fileDescriptor_106f1e9066e33082 = []byte{
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4e, 0xc2, 0x40,
+	0x14, 0x86, 0x3b, 0x40, 0x8c, 0x3c, 0x8c, 0xa9, 0x13, 0x54, 0x82, 0x49, 0x2d, 0xae, 0x88, 0x26,
+	0x6d, 0xd0, 0x13, 0x54, 0x18, 0xb5, 0x89, 0x10, 0x82, 0x65, 0x81, 0x9b, 0xa6, 0xb4, 0x43, 0x99,
+	0x44, 0x3b, 0x93, 0x3a, 0x10, 0x7a, 0x03, 0x97, 0xde, 0xc1, 0xbd, 0xe7, 0x70, 0xc9, 0xd2, 0xa5,
+	0x81, 0x8b, 0x18, 0x4a, 0x28, 0x2c, 0xdc, 0x7d, 0xf3, 0xff, 0x5f, 0xde, 0x24, 0xef, 0x41, 0x6d,
+	0x34, 0x91, 0xfe, 0xd8, 0x63, 0x91, 0x99, 0xc1, 0xb4, 0x61, 0xd2, 0x48, 0x32, 0x99, 0x18, 0x22,
+	0xe6, 0x92, 0xe3, 0xf2, 0xa6, 0x31, 0x32, 0x98, 0x36, 0xaa, 0xe5, 0x90, 0x87, 0x3c, 0x15, 0xcc,
+	0x15, 0xad, 0xdd, 0x8b, 0x2f, 0x04, 0x45, 0xbb, 0xd5, 0xf6, 0x84, 0x60, 0x51, 0x88, 0x2d, 0x28,
+	0xad, 0x27, 0xb9, 0x32, 0x11, 0xb4, 0x82, 0x74, 0x54, 0x3f, 0xbc, 0xd6, 0x8d, 0xff, 0xe6, 0x19,
+	0x24, 0x15, 0x9d, 0x44, 0xd0, 0x1e, 0xd0, 0x8c, 0x71, 0x15, 0xf6, 0x45, 0xcc, 0xa7, 0x2c, 0xa0,
+	0x71, 0x25, 0xa7, 0xa3, 0x7a, 0xb1, 0x97, 0xbd, 0xf1, 0x39, 0x94, 0xe8, 0x4c, 0xd2, 0x38, 0xf2,
+	0x5e, 0x5c, 0x16, 0x54, 0xf2, 0x69, 0x0d, 0x9b, 0xc8, 0x0e, 0x70, 0x0d, 0x0e, 0x7c, 0x2f, 0xe2,
+	0x11, 0xf3, 0xd7, 0x46, 0x41, 0x47, 0xf5, 0x7c, 0xaf, 0x94, 0x65, 0x76, 0x70, 0x29, 0x01, 0xb6,
+	0x3f, 0xe3, 0x33, 0x38, 0x25, 0x1d, 0xc7, 0x76, 0x06, 0xae, 0x33, 0xe8, 0x12, 0xb7, 0xdf, 0x79,
+	0xea, 0x92, 0xa6, 0x7d, 0x67, 0x93, 0x96, 0xaa, 0xe0, 0x32, 0xa8, 0xbb, 0xa5, 0x43, 0xac, 0xb6,
+	0x8a, 0xf0, 0x09, 0xe0, 0xdd, 0xf4, 0x91, 0x58, 0xf7, 0x7d, 0xa2, 0xe6, 0xf0, 0x31, 0x1c, 0xed,
+	0xe6, 0x6d, 0xcb, 0x69, 0x3e, 0xa8, 0xf9, 0x6a, 0xe1, 0xfd, 0x53, 0x53, 0x6e, 0xc9, 0xf7, 0x42,
+	0x43, 0xf3, 0x85, 0x86, 0x7e, 0x17, 0x1a, 0xfa, 0x58, 0x6a, 0xca, 0x7c, 0xa9, 0x29, 0x3f, 0x4b,
+	0x4d, 0x79, 0xbe, 0x0a, 0x99, 0x1c, 0x4f, 0x86, 0x86, 0xcf, 0x5f, 0xcd, 0xd8, 0x63, 0x23, 0x91,
+	0x6c, 0x0f, 0x33, 0xdb, 0xe2, 0x6a, 0x9f, 0x6f, 0xc3, 0xbd, 0x74, 0xe9, 0x37, 0x7f, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0x01, 0xaa, 0x36, 0xe2, 0xc5, 0x01, 0x00, 0x00,
+}
+
+func (m *IDMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IDMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IDMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanonicalId != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.CanonicalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EntityType != 0 {
+		i = encodeVarintEntity(dAtA, i, uint64(m.EntityType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEntity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEntity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IDMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntityType != 0 {
+		n += 1 + sovEntity(uint64(m.EntityType))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
+	if m.CanonicalId != 0 {
+		n += 1 + sovEntity(uint64(m.CanonicalId))
+	}
+	return n
+}
+
+func sovEntity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEntity(x uint64) (n int) {
+	return sovEntity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IDMapping) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDMapping: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+			}
+			m.EntityType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntityType |= EntityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalId", wireType)
+			}
+			m.CanonicalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanonicalId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEntity(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEntity
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEntity
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEntity
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEntity
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEntity        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEntity          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEntity = fmt.Errorf("proto: unexpected end of group")
+)
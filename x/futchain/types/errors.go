@@ -8,5 +8,20 @@ import (
 
 // x/futchain module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner       = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSubscription = errors.Register(ModuleName, 1101, "invalid match subscription")
+	ErrInvalidMarket       = errors.Register(ModuleName, 1102, "invalid market")
+	ErrMarketClosed        = errors.Register(ModuleName, 1103, "market is closed")
+	ErrInvalidStake        = errors.Register(ModuleName, 1104, "invalid stake")
+	ErrInvalidOutcomeShare = errors.Register(ModuleName, 1105, "invalid outcome share")
+	ErrInvalidCorrection   = errors.Register(ModuleName, 1106, "invalid data correction")
+	ErrInvalidOracleReport = errors.Register(ModuleName, 1107, "invalid oracle report")
+	ErrNoOracleRewards     = errors.Register(ModuleName, 1108, "no oracle rewards")
+	ErrInvalidReporter     = errors.Register(ModuleName, 1109, "invalid reporter")
+	ErrInvalidMatchReport  = errors.Register(ModuleName, 1110, "invalid match report")
+	ErrInvalidReportCommit = errors.Register(ModuleName, 1111, "invalid report commit")
+	ErrInvalidPacket       = errors.Register(ModuleName, 1112, "invalid futchain packet")
+	ErrInvalidChannel      = errors.Register(ModuleName, 1113, "invalid futchain channel")
+	ErrInvalidSearch       = errors.Register(ModuleName, 1114, "invalid name search")
+	ErrInvalidIDMapping    = errors.Register(ModuleName, 1115, "invalid id mapping")
 )
@@ -0,0 +1,3652 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: futchain/futchain/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MatchUpdatePriority is the most important change of a match update, in
+// increasing order of importance.
+type MatchUpdatePriority int32
+
+const (
+	MATCH_UPDATE_PRIORITY_NO_CHANGES    MatchUpdatePriority = 0
+	MATCH_UPDATE_PRIORITY_LIVE_TIME     MatchUpdatePriority = 1
+	MATCH_UPDATE_PRIORITY_PERIOD_LENGTH MatchUpdatePriority = 2
+	MATCH_UPDATE_PRIORITY_STATUS        MatchUpdatePriority = 3
+	MATCH_UPDATE_PRIORITY_ONGOING       MatchUpdatePriority = 4
+	MATCH_UPDATE_PRIORITY_FINISHED      MatchUpdatePriority = 5
+	MATCH_UPDATE_PRIORITY_STARTED       MatchUpdatePriority = 6
+	MATCH_UPDATE_PRIORITY_CANCELLED     MatchUpdatePriority = 7
+	MATCH_UPDATE_PRIORITY_SCORE         MatchUpdatePriority = 8
+)
+
+var MatchUpdatePriority_name = map[int32]string{
+	0: "MATCH_UPDATE_PRIORITY_NO_CHANGES",
+	1: "MATCH_UPDATE_PRIORITY_LIVE_TIME",
+	2: "MATCH_UPDATE_PRIORITY_PERIOD_LENGTH",
+	3: "MATCH_UPDATE_PRIORITY_STATUS",
+	4: "MATCH_UPDATE_PRIORITY_ONGOING",
+	5: "MATCH_UPDATE_PRIORITY_FINISHED",
+	6: "MATCH_UPDATE_PRIORITY_STARTED",
+	7: "MATCH_UPDATE_PRIORITY_CANCELLED",
+	8: "MATCH_UPDATE_PRIORITY_SCORE",
+}
+
+var MatchUpdatePriority_value = map[string]int32{
+	"MATCH_UPDATE_PRIORITY_NO_CHANGES":    0,
+	"MATCH_UPDATE_PRIORITY_LIVE_TIME":     1,
+	"MATCH_UPDATE_PRIORITY_PERIOD_LENGTH": 2,
+	"MATCH_UPDATE_PRIORITY_STATUS":        3,
+	"MATCH_UPDATE_PRIORITY_ONGOING":       4,
+	"MATCH_UPDATE_PRIORITY_FINISHED":      5,
+	"MATCH_UPDATE_PRIORITY_STARTED":       6,
+	"MATCH_UPDATE_PRIORITY_CANCELLED":     7,
+	"MATCH_UPDATE_PRIORITY_SCORE":         8,
+}
+
+func (x MatchUpdatePriority) String() string {
+	return proto.EnumName(MatchUpdatePriority_name, int32(x))
+}
+
+func (MatchUpdatePriority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{0}
+}
+
+// MatchState is the state of a match carried by the match events.
+type MatchState struct {
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LeagueId  int64  `protobuf:"varint,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	Time      string `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	HomeId    int64  `protobuf:"varint,4,opt,name=home_id,json=homeId,proto3" json:"home_id,omitempty"`
+	HomeName  string `protobuf:"bytes,5,opt,name=home_name,json=homeName,proto3" json:"home_name,omitempty"`
+	AwayId    int64  `protobuf:"varint,6,opt,name=away_id,json=awayId,proto3" json:"away_id,omitempty"`
+	AwayName  string `protobuf:"bytes,7,opt,name=away_name,json=awayName,proto3" json:"away_name,omitempty"`
+	HomeScore int64  `protobuf:"varint,8,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore int64  `protobuf:"varint,9,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Started   bool   `protobuf:"varint,10,opt,name=started,proto3" json:"started,omitempty"`
+	Ongoing   bool   `protobuf:"varint,11,opt,name=ongoing,proto3" json:"ongoing,omitempty"`
+	Finished  bool   `protobuf:"varint,12,opt,name=finished,proto3" json:"finished,omitempty"`
+	Cancelled bool   `protobuf:"varint,13,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// live_time is the elapsed match time, e.g. "51:35".
+	LiveTime string `protobuf:"bytes,14,opt,name=live_time,json=liveTime,proto3" json:"live_time,omitempty"`
+}
+
+func (m *MatchState) Reset()         { *m = MatchState{} }
+func (m *MatchState) String() string { return proto.CompactTextString(m) }
+func (*MatchState) ProtoMessage()    {}
+func (*MatchState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{0}
+}
+func (m *MatchState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatchState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MatchState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MatchState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchState.Merge(m, src)
+}
+func (m *MatchState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatchState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchState proto.InternalMessageInfo
+
+func (m *MatchState) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MatchState) GetLeagueId() int64 {
+	if m != nil {
+		return m.LeagueId
+	}
+	return 0
+}
+
+func (m *MatchState) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *MatchState) GetHomeId() int64 {
+	if m != nil {
+		return m.HomeId
+	}
+	return 0
+}
+
+func (m *MatchState) GetHomeName() string {
+	if m != nil {
+		return m.HomeName
+	}
+	return ""
+}
+
+func (m *MatchState) GetAwayId() int64 {
+	if m != nil {
+		return m.AwayId
+	}
+	return 0
+}
+
+func (m *MatchState) GetAwayName() string {
+	if m != nil {
+		return m.AwayName
+	}
+	return ""
+}
+
+func (m *MatchState) GetHomeScore() int64 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *MatchState) GetAwayScore() int64 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *MatchState) GetStarted() bool {
+	if m != nil {
+		return m.Started
+	}
+	return false
+}
+
+func (m *MatchState) GetOngoing() bool {
+	if m != nil {
+		return m.Ongoing
+	}
+	return false
+}
+
+func (m *MatchState) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *MatchState) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *MatchState) GetLiveTime() string {
+	if m != nil {
+		return m.LiveTime
+	}
+	return ""
+}
+
+// EventNewLeague is emitted when a league is ingested for the first time.
+type EventNewLeague struct {
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	GroupName string `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+}
+
+func (m *EventNewLeague) Reset()         { *m = EventNewLeague{} }
+func (m *EventNewLeague) String() string { return proto.CompactTextString(m) }
+func (*EventNewLeague) ProtoMessage()    {}
+func (*EventNewLeague) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{1}
+}
+func (m *EventNewLeague) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNewLeague) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNewLeague.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNewLeague) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewLeague.Merge(m, src)
+}
+func (m *EventNewLeague) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNewLeague) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewLeague.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNewLeague proto.InternalMessageInfo
+
+func (m *EventNewLeague) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventNewLeague) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNewLeague) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+// EventNewMatch is emitted when a match is ingested for the first time.
+type EventNewMatch struct {
+	Match MatchState `protobuf:"bytes,1,opt,name=match,proto3" json:"match"`
+}
+
+func (m *EventNewMatch) Reset()         { *m = EventNewMatch{} }
+func (m *EventNewMatch) String() string { return proto.CompactTextString(m) }
+func (*EventNewMatch) ProtoMessage()    {}
+func (*EventNewMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{2}
+}
+func (m *EventNewMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNewMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNewMatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNewMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNewMatch.Merge(m, src)
+}
+func (m *EventNewMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNewMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNewMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNewMatch proto.InternalMessageInfo
+
+func (m *EventNewMatch) GetMatch() MatchState {
+	if m != nil {
+		return m.Match
+	}
+	return MatchState{}
+}
+
+// EventMatchUpdated is emitted when the ingested data of a match changes,
+// from the period length priority up.
+type EventMatchUpdated struct {
+	Priority MatchUpdatePriority `protobuf:"varint,1,opt,name=priority,proto3,enum=futchain.futchain.v1.MatchUpdatePriority" json:"priority,omitempty"`
+	Old      MatchState          `protobuf:"bytes,2,opt,name=old,proto3" json:"old"`
+	New      MatchState          `protobuf:"bytes,3,opt,name=new,proto3" json:"new"`
+}
+
+func (m *EventMatchUpdated) Reset()         { *m = EventMatchUpdated{} }
+func (m *EventMatchUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMatchUpdated) ProtoMessage()    {}
+func (*EventMatchUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{3}
+}
+func (m *EventMatchUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMatchUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMatchUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMatchUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMatchUpdated.Merge(m, src)
+}
+func (m *EventMatchUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMatchUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMatchUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMatchUpdated proto.InternalMessageInfo
+
+func (m *EventMatchUpdated) GetPriority() MatchUpdatePriority {
+	if m != nil {
+		return m.Priority
+	}
+	return MATCH_UPDATE_PRIORITY_NO_CHANGES
+}
+
+func (m *EventMatchUpdated) GetOld() MatchState {
+	if m != nil {
+		return m.Old
+	}
+	return MatchState{}
+}
+
+func (m *EventMatchUpdated) GetNew() MatchState {
+	if m != nil {
+		return m.New
+	}
+	return MatchState{}
+}
+
+// EventMatchFinished is emitted when a match finishes or is cancelled. Its
+// result is final once it stays unchanged for the dispute window.
+type EventMatchFinished struct {
+	MatchId   int64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	HomeScore int64 `protobuf:"varint,2,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore int64 `protobuf:"varint,3,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Cancelled bool  `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (m *EventMatchFinished) Reset()         { *m = EventMatchFinished{} }
+func (m *EventMatchFinished) String() string { return proto.CompactTextString(m) }
+func (*EventMatchFinished) ProtoMessage()    {}
+func (*EventMatchFinished) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{4}
+}
+func (m *EventMatchFinished) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMatchFinished) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMatchFinished.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMatchFinished) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMatchFinished.Merge(m, src)
+}
+func (m *EventMatchFinished) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMatchFinished) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMatchFinished.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMatchFinished proto.InternalMessageInfo
+
+func (m *EventMatchFinished) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *EventMatchFinished) GetHomeScore() int64 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *EventMatchFinished) GetAwayScore() int64 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *EventMatchFinished) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+// EventMatchReopened is emitted when the data provider reopens a finished
+// match within its dispute window.
+type EventMatchReopened struct {
+	MatchId int64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+}
+
+func (m *EventMatchReopened) Reset()         { *m = EventMatchReopened{} }
+func (m *EventMatchReopened) String() string { return proto.CompactTextString(m) }
+func (*EventMatchReopened) ProtoMessage()    {}
+func (*EventMatchReopened) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{5}
+}
+func (m *EventMatchReopened) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMatchReopened) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMatchReopened.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMatchReopened) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMatchReopened.Merge(m, src)
+}
+func (m *EventMatchReopened) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMatchReopened) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMatchReopened.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMatchReopened proto.InternalMessageInfo
+
+func (m *EventMatchReopened) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+// EventMatchResultRevised is emitted when the data provider revises the
+// result of a finished match within its dispute window, restarting it, or
+// when a correction revises the result of a finalized match.
+type EventMatchResultRevised struct {
+	MatchId   int64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	HomeScore int64 `protobuf:"varint,2,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore int64 `protobuf:"varint,3,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Cancelled bool  `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (m *EventMatchResultRevised) Reset()         { *m = EventMatchResultRevised{} }
+func (m *EventMatchResultRevised) String() string { return proto.CompactTextString(m) }
+func (*EventMatchResultRevised) ProtoMessage()    {}
+func (*EventMatchResultRevised) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{6}
+}
+func (m *EventMatchResultRevised) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMatchResultRevised) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMatchResultRevised.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMatchResultRevised) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMatchResultRevised.Merge(m, src)
+}
+func (m *EventMatchResultRevised) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMatchResultRevised) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMatchResultRevised.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMatchResultRevised proto.InternalMessageInfo
+
+func (m *EventMatchResultRevised) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *EventMatchResultRevised) GetHomeScore() int64 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *EventMatchResultRevised) GetAwayScore() int64 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *EventMatchResultRevised) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+// EventMatchFinalized is emitted when the result of a match is final.
+type EventMatchFinalized struct {
+	MatchId   int64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	HomeScore int64 `protobuf:"varint,2,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore int64 `protobuf:"varint,3,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Cancelled bool  `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (m *EventMatchFinalized) Reset()         { *m = EventMatchFinalized{} }
+func (m *EventMatchFinalized) String() string { return proto.CompactTextString(m) }
+func (*EventMatchFinalized) ProtoMessage()    {}
+func (*EventMatchFinalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{7}
+}
+func (m *EventMatchFinalized) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMatchFinalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMatchFinalized.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMatchFinalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMatchFinalized.Merge(m, src)
+}
+func (m *EventMatchFinalized) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMatchFinalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMatchFinalized.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMatchFinalized proto.InternalMessageInfo
+
+func (m *EventMatchFinalized) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *EventMatchFinalized) GetHomeScore() int64 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *EventMatchFinalized) GetAwayScore() int64 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *EventMatchFinalized) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+// EventMatchCallback is emitted for each onMatchResult callback delivered to
+// a subscriber contract.
+type EventMatchCallback struct {
+	MatchId int64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// subscriber is the hex address of the subscriber contract.
+	Subscriber string `protobuf:"bytes,2,opt,name=subscriber,proto3" json:"subscriber,omitempty"`
+	GasUsed    uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Success    bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the reason the callback failed, empty on success.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventMatchCallback) Reset()         { *m = EventMatchCallback{} }
+func (m *EventMatchCallback) String() string { return proto.CompactTextString(m) }
+func (*EventMatchCallback) ProtoMessage()    {}
+func (*EventMatchCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{8}
+}
+func (m *EventMatchCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMatchCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMatchCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMatchCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMatchCallback.Merge(m, src)
+}
+func (m *EventMatchCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMatchCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMatchCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMatchCallback proto.InternalMessageInfo
+
+func (m *EventMatchCallback) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *EventMatchCallback) GetSubscriber() string {
+	if m != nil {
+		return m.Subscriber
+	}
+	return ""
+}
+
+func (m *EventMatchCallback) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EventMatchCallback) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *EventMatchCallback) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// EventEntitiesMerged is emitted when the authority merges a duplicate team,
+// league or match into its canonical entity.
+type EventEntitiesMerged struct {
+	EntityType  EntityType `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=futchain.futchain.v1.EntityType" json:"entity_type,omitempty"`
+	DuplicateId int64      `protobuf:"varint,2,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+	CanonicalId int64      `protobuf:"varint,3,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+	Authority   string     `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+	Reason      string     `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventEntitiesMerged) Reset()         { *m = EventEntitiesMerged{} }
+func (m *EventEntitiesMerged) String() string { return proto.CompactTextString(m) }
+func (*EventEntitiesMerged) ProtoMessage()    {}
+func (*EventEntitiesMerged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{9}
+}
+func (m *EventEntitiesMerged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEntitiesMerged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEntitiesMerged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEntitiesMerged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEntitiesMerged.Merge(m, src)
+}
+func (m *EventEntitiesMerged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEntitiesMerged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEntitiesMerged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEntitiesMerged proto.InternalMessageInfo
+
+func (m *EventEntitiesMerged) GetEntityType() EntityType {
+	if m != nil {
+		return m.EntityType
+	}
+	return ENTITY_TYPE_UNSPECIFIED
+}
+
+func (m *EventEntitiesMerged) GetDuplicateId() int64 {
+	if m != nil {
+		return m.DuplicateId
+	}
+	return 0
+}
+
+func (m *EventEntitiesMerged) GetCanonicalId() int64 {
+	if m != nil {
+		return m.CanonicalId
+	}
+	return 0
+}
+
+func (m *EventEntitiesMerged) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *EventEntitiesMerged) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventExternalIDMapped is emitted when the authority maps the id of a team,
+// league or match at a data provider to its canonical id.
+type EventExternalIDMapped struct {
+	EntityType  EntityType `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=futchain.futchain.v1.EntityType" json:"entity_type,omitempty"`
+	Provider    string     `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalId  string     `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	CanonicalId int64      `protobuf:"varint,4,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+	Authority   string     `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	Reason      string     `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventExternalIDMapped) Reset()         { *m = EventExternalIDMapped{} }
+func (m *EventExternalIDMapped) String() string { return proto.CompactTextString(m) }
+func (*EventExternalIDMapped) ProtoMessage()    {}
+func (*EventExternalIDMapped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a63f9da18e123046, []int{10}
+}
+func (m *EventExternalIDMapped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExternalIDMapped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExternalIDMapped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExternalIDMapped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExternalIDMapped.Merge(m, src)
+}
+func (m *EventExternalIDMapped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExternalIDMapped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExternalIDMapped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExternalIDMapped proto.InternalMessageInfo
+
+func (m *EventExternalIDMapped) GetEntityType() EntityType {
+	if m != nil {
+		return m.EntityType
+	}
+	return ENTITY_TYPE_UNSPECIFIED
+}
+
+func (m *EventExternalIDMapped) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *EventExternalIDMapped) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventExternalIDMapped) GetCanonicalId() int64 {
+	if m != nil {
+		return m.CanonicalId
+	}
+	return 0
+}
+
+func (m *EventExternalIDMapped) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *EventExternalIDMapped) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("futchain.futchain.v1.MatchUpdatePriority", MatchUpdatePriority_name, MatchUpdatePriority_value)
+	proto.RegisterType((*MatchState)(nil), "futchain.futchain.v1.MatchState")
+	proto.RegisterType((*EventNewLeague)(nil), "futchain.futchain.v1.EventNewLeague")
+	proto.RegisterType((*EventNewMatch)(nil), "futchain.futchain.v1.EventNewMatch")
+	proto.RegisterType((*EventMatchUpdated)(nil), "futchain.futchain.v1.EventMatchUpdated")
+	proto.RegisterType((*EventMatchFinished)(nil), "futchain.futchain.v1.EventMatchFinished")
+	proto.RegisterType((*EventMatchReopened)(nil), "futchain.futchain.v1.EventMatchReopened")
+	proto.RegisterType((*EventMatchResultRevised)(nil), "futchain.futchain.v1.EventMatchResultRevised")
+	proto.RegisterType((*EventMatchFinalized)(nil), "futchain.futchain.v1.EventMatchFinalized")
+	proto.RegisterType((*EventMatchCallback)(nil), "futchain.futchain.v1.EventMatchCallback")
+	proto.RegisterType((*EventEntitiesMerged)(nil), "futchain.futchain.v1.EventEntitiesMerged")
+	proto.RegisterType((*EventExternalIDMapped)(nil), "futchain.futchain.v1.EventExternalIDMapped")
+}
+
+func init() { proto.RegisterFile("futchain/futchain/v1/events.proto", fileDescriptor_a63f9da18e123046) }
+
+var fileDescriptor_a63f9da18e123046 = []byte{
+	// 965 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x54,
+	0x10, 0x8f, 0x13, 0x6f, 0xfe, 0x4c, 0xda, 0x55, 0x78, 0x2d, 0xd4, 0x6c, 0xdb, 0x6c, 0xd6, 0x45,
+	0x62, 0x01, 0x69, 0xa3, 0x96, 0x0b, 0x07, 0x2e, 0x69, 0xe2, 0xee, 0x5a, 0xca, 0x9f, 0x95, 0xe3,
+	0x45, 0x82, 0x8b, 0xf5, 0x62, 0xbf, 0x75, 0x9e, 0x70, 0x6c, 0xcb, 0x7f, 0xb2, 0x0d, 0x47, 0x4e,
+	0x48, 0x70, 0xe8, 0x99, 0x2b, 0x5f, 0xa6, 0x27, 0xd4, 0x03, 0x07, 0x4e, 0x08, 0x76, 0xbf, 0x00,
+	0x1f, 0x01, 0xbd, 0xf7, 0x1c, 0x27, 0xbb, 0x24, 0x0b, 0x48, 0x48, 0xbd, 0xcd, 0xfc, 0x66, 0x7e,
+	0x93, 0xf7, 0x9b, 0x19, 0x8f, 0x02, 0x07, 0xe7, 0x69, 0x62, 0x4f, 0x31, 0xf5, 0xdb, 0xb9, 0x31,
+	0x7f, 0xda, 0x26, 0x73, 0xe2, 0x27, 0xf1, 0x51, 0x18, 0x05, 0x49, 0x80, 0xee, 0x2f, 0x23, 0x47,
+	0xb9, 0x31, 0x7f, 0xba, 0xb7, 0x85, 0xe8, 0x27, 0x34, 0x59, 0x08, 0xe2, 0xde, 0x7d, 0x37, 0x70,
+	0x03, 0x6e, 0xb6, 0x99, 0x25, 0x50, 0xf5, 0xdb, 0x12, 0xc0, 0x00, 0x27, 0xf6, 0x74, 0x9c, 0xe0,
+	0x84, 0xa0, 0x5d, 0x28, 0x52, 0x47, 0x91, 0x5a, 0xd2, 0x61, 0xc9, 0x28, 0x52, 0x07, 0x3d, 0x84,
+	0x9a, 0x47, 0xb0, 0x9b, 0x12, 0x8b, 0x3a, 0x4a, 0x91, 0xc3, 0x55, 0x01, 0xe8, 0x0e, 0x42, 0x20,
+	0x27, 0x74, 0x46, 0x94, 0x52, 0x4b, 0x3a, 0xac, 0x19, 0xdc, 0x46, 0x0f, 0xa0, 0x32, 0x0d, 0x66,
+	0x3c, 0x5d, 0xe6, 0xe9, 0x65, 0xe6, 0xea, 0xbc, 0x12, 0x0f, 0xf8, 0x78, 0x46, 0x94, 0x1d, 0xce,
+	0xa8, 0x32, 0x60, 0x88, 0x05, 0x0b, 0x5f, 0xe0, 0x05, 0x63, 0x95, 0x05, 0x8b, 0xb9, 0x82, 0xc5,
+	0x03, 0x9c, 0x55, 0x11, 0x2c, 0x06, 0x70, 0xd6, 0x63, 0x00, 0x5e, 0x32, 0xb6, 0x83, 0x88, 0x28,
+	0x55, 0x4e, 0xe4, 0x3f, 0x32, 0x66, 0x00, 0x0b, 0x73, 0xae, 0x08, 0xd7, 0x44, 0x98, 0x21, 0x22,
+	0xac, 0x40, 0x25, 0x4e, 0x70, 0x94, 0x10, 0x47, 0x81, 0x96, 0x74, 0x58, 0x35, 0x96, 0x2e, 0x8b,
+	0x04, 0xbe, 0x1b, 0x50, 0xdf, 0x55, 0xea, 0x22, 0x92, 0xb9, 0x68, 0x0f, 0xaa, 0xe7, 0xd4, 0xa7,
+	0xf1, 0x94, 0x38, 0xca, 0x1d, 0x1e, 0xca, 0x7d, 0xf4, 0x08, 0x6a, 0x36, 0xf6, 0x6d, 0xe2, 0x79,
+	0xc4, 0x51, 0xee, 0xf2, 0xe0, 0x0a, 0xe0, 0x8d, 0xa4, 0x73, 0x62, 0xf1, 0x86, 0xed, 0x0a, 0x21,
+	0x0c, 0x30, 0xe9, 0x8c, 0xa8, 0x63, 0xd8, 0xd5, 0xd8, 0x8c, 0x87, 0xe4, 0xa2, 0xcf, 0x9b, 0xfb,
+	0xb7, 0x39, 0x20, 0x90, 0x79, 0x0b, 0x8a, 0xa2, 0xd5, 0x7e, 0x26, 0xdf, 0x8d, 0x82, 0x34, 0x14,
+	0xcd, 0x11, 0x43, 0xa8, 0x71, 0x84, 0x75, 0x47, 0x1d, 0xc0, 0xdd, 0x65, 0x51, 0x3e, 0x60, 0xf4,
+	0x39, 0xec, 0xcc, 0x98, 0xc1, 0xcb, 0xd6, 0x9f, 0xb5, 0x8e, 0x36, 0x6d, 0xd2, 0xd1, 0x6a, 0x19,
+	0x9e, 0xcb, 0xaf, 0x7f, 0xdb, 0x2f, 0x18, 0x82, 0xa4, 0xfe, 0x2c, 0xc1, 0x3b, 0xbc, 0x1e, 0x4f,
+	0x38, 0x0b, 0x1d, 0xcc, 0x5a, 0xa5, 0x41, 0x35, 0x8c, 0x68, 0x10, 0xd1, 0x64, 0xc1, 0xcb, 0xee,
+	0x3e, 0xfb, 0xe8, 0x96, 0xb2, 0x82, 0x75, 0x9a, 0x11, 0x8c, 0x9c, 0x8a, 0x3e, 0x83, 0x52, 0xe0,
+	0x89, 0x05, 0xfb, 0xf7, 0x0f, 0x63, 0x14, 0xc6, 0xf4, 0xc9, 0x05, 0x57, 0xff, 0x1f, 0x98, 0x3e,
+	0xb9, 0x50, 0xbf, 0x97, 0x00, 0xad, 0x04, 0xbd, 0x58, 0x8e, 0xf1, 0x7d, 0xa8, 0x72, 0xc1, 0x56,
+	0xde, 0xff, 0x0a, 0xf7, 0x75, 0xe7, 0xc6, 0xbe, 0x15, 0x6f, 0xdf, 0xb7, 0xd2, 0xcd, 0x7d, 0xbb,
+	0xb6, 0x1f, 0xf2, 0x8d, 0xfd, 0x50, 0xdb, 0xeb, 0x8f, 0x31, 0x48, 0x10, 0x12, 0xff, 0xd6, 0xc7,
+	0xa8, 0xaf, 0x24, 0x78, 0xb0, 0xce, 0x88, 0x53, 0x2f, 0x31, 0xc8, 0x9c, 0xc6, 0x6f, 0x4f, 0xc3,
+	0x0f, 0x12, 0xdc, 0xbb, 0xd6, 0x51, 0xec, 0xd1, 0x6f, 0xde, 0xde, 0x73, 0x7e, 0xbc, 0x36, 0xe0,
+	0x2e, 0xf6, 0xbc, 0x09, 0xb6, 0xbf, 0xbe, 0xed, 0x35, 0x4d, 0x80, 0x38, 0x9d, 0xc4, 0x76, 0x44,
+	0x27, 0x24, 0xca, 0xbe, 0xb5, 0x35, 0x84, 0x51, 0x5d, 0x1c, 0x5b, 0x69, 0x4c, 0x1c, 0xfe, 0x18,
+	0xd9, 0xa8, 0xb8, 0x38, 0x3e, 0x8b, 0xc5, 0xcd, 0x88, 0x53, 0xdb, 0x26, 0x71, 0x9c, 0x3d, 0x64,
+	0xe9, 0xa2, 0xfb, 0xb0, 0x43, 0xa2, 0x28, 0x88, 0xb2, 0xa3, 0x27, 0x1c, 0xf5, 0x97, 0x65, 0xaf,
+	0x34, 0x76, 0xa3, 0x29, 0x89, 0x07, 0x24, 0x72, 0x89, 0x83, 0x3a, 0x50, 0x17, 0x57, 0xdb, 0x4a,
+	0x16, 0x21, 0xc9, 0xbe, 0xa9, 0x2d, 0x7b, 0xcd, 0xa9, 0x0b, 0x73, 0x11, 0x12, 0x03, 0x48, 0x6e,
+	0xa3, 0x03, 0xb8, 0xe3, 0xa4, 0xa1, 0x47, 0x6d, 0x9c, 0xac, 0x9d, 0xed, 0x7a, 0x8e, 0xe9, 0x0e,
+	0x4b, 0xb1, 0xb1, 0x1f, 0xf8, 0xd4, 0xc6, 0x1e, 0x4b, 0x11, 0x9d, 0xad, 0xe7, 0x98, 0xce, 0xcf,
+	0x19, 0x4e, 0x93, 0xa9, 0xf8, 0xb4, 0x65, 0x71, 0x5c, 0x72, 0x00, 0xbd, 0x07, 0xe5, 0x88, 0xe0,
+	0x38, 0xf0, 0x33, 0x55, 0x99, 0xa7, 0xfe, 0x29, 0xc1, 0xbb, 0x42, 0xd6, 0xcb, 0x84, 0x44, 0x3e,
+	0xf6, 0xf4, 0xde, 0x00, 0x87, 0xe1, 0xff, 0x23, 0x6c, 0x8f, 0x1d, 0x9b, 0x60, 0x4e, 0x9d, 0x7c,
+	0x38, 0xb9, 0x8f, 0xf6, 0xa1, 0x4e, 0xb2, 0x9f, 0x5c, 0x0a, 0xaa, 0x19, 0xb0, 0x84, 0x36, 0x48,
+	0x96, 0xff, 0x41, 0xf2, 0xce, 0x76, 0xc9, 0xe5, 0x75, 0xc9, 0x1f, 0xff, 0x51, 0x84, 0x7b, 0x1b,
+	0xae, 0x1b, 0xfa, 0x00, 0x5a, 0x83, 0x8e, 0xd9, 0x3d, 0xb1, 0xce, 0x4e, 0x7b, 0x1d, 0x53, 0xb3,
+	0x4e, 0x0d, 0x7d, 0x64, 0xe8, 0xe6, 0x97, 0xd6, 0x70, 0x64, 0x75, 0x4f, 0x3a, 0xc3, 0x63, 0x6d,
+	0xdc, 0x28, 0xa0, 0x27, 0xb0, 0xbf, 0x39, 0xab, 0xaf, 0x7f, 0xa1, 0x59, 0xa6, 0x3e, 0xd0, 0x1a,
+	0x12, 0xfa, 0x10, 0x9e, 0x6c, 0x4e, 0x3a, 0xd5, 0x0c, 0x7d, 0xd4, 0xb3, 0xfa, 0xda, 0xf0, 0xd8,
+	0x3c, 0x69, 0x14, 0x51, 0x0b, 0x1e, 0x6d, 0x4e, 0x1c, 0x9b, 0x1d, 0xf3, 0x6c, 0xdc, 0x28, 0xa1,
+	0x03, 0x78, 0xbc, 0x39, 0x63, 0x34, 0x3c, 0x1e, 0xe9, 0xc3, 0xe3, 0x86, 0x8c, 0x54, 0x68, 0x6e,
+	0x4e, 0x79, 0xa1, 0x0f, 0xf5, 0xf1, 0x89, 0xd6, 0x6b, 0xec, 0x6c, 0x2f, 0x33, 0x36, 0x3b, 0x86,
+	0xa9, 0xf5, 0x1a, 0xe5, 0xed, 0xca, 0xba, 0x9d, 0x61, 0x57, 0xeb, 0xf7, 0xb5, 0x5e, 0xa3, 0x82,
+	0xf6, 0xe1, 0xe1, 0x96, 0x3a, 0xdd, 0x91, 0xa1, 0x35, 0xaa, 0x7b, 0xf2, 0x77, 0x3f, 0x35, 0x0b,
+	0xcf, 0xb5, 0xd7, 0x97, 0x4d, 0xe9, 0xcd, 0x65, 0x53, 0xfa, 0xfd, 0xb2, 0x29, 0xbd, 0xba, 0x6a,
+	0x16, 0xde, 0x5c, 0x35, 0x0b, 0xbf, 0x5e, 0x35, 0x0b, 0x5f, 0x7d, 0xe2, 0xd2, 0x64, 0x9a, 0x4e,
+	0x8e, 0xec, 0x60, 0xd6, 0x8e, 0x30, 0x3d, 0x0f, 0x17, 0xab, 0x7f, 0x40, 0x2f, 0x57, 0x26, 0xdb,
+	0xb9, 0x78, 0x52, 0xe6, 0xff, 0x79, 0x3e, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xce, 0x3e,
+	0xbd, 0x67, 0x09, 0x00, 0x00,
+}
+
+func (m *MatchState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatchState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MatchState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiveTime) > 0 {
+		i -= len(m.LiveTime)
+		copy(dAtA[i:], m.LiveTime)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.LiveTime)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Ongoing {
+		i--
+		if m.Ongoing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AwayName) > 0 {
+		i -= len(m.AwayName)
+		copy(dAtA[i:], m.AwayName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AwayName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AwayId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AwayId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.HomeName) > 0 {
+		i -= len(m.HomeName)
+		copy(dAtA[i:], m.HomeName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.HomeName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HomeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HomeId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LeagueId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LeagueId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNewLeague) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewLeague) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewLeague) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNewMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNewMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNewMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMatchUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMatchUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMatchUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.New.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Old.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Priority != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMatchFinished) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMatchFinished) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMatchFinished) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMatchReopened) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMatchReopened) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMatchReopened) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMatchResultRevised) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMatchResultRevised) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMatchResultRevised) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMatchFinalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMatchFinalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMatchFinalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMatchCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMatchCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMatchCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Subscriber) > 0 {
+		i -= len(m.Subscriber)
+		copy(dAtA[i:], m.Subscriber)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Subscriber)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEntitiesMerged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEntitiesMerged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEntitiesMerged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CanonicalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CanonicalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DuplicateId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DuplicateId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EntityType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EntityType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExternalIDMapped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExternalIDMapped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExternalIDMapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CanonicalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CanonicalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EntityType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EntityType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MatchState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	if m.LeagueId != 0 {
+		n += 1 + sovEvents(uint64(m.LeagueId))
+	}
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.HomeId != 0 {
+		n += 1 + sovEvents(uint64(m.HomeId))
+	}
+	l = len(m.HomeName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.AwayId != 0 {
+		n += 1 + sovEvents(uint64(m.AwayId))
+	}
+	l = len(m.AwayName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.HomeScore != 0 {
+		n += 1 + sovEvents(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovEvents(uint64(m.AwayScore))
+	}
+	if m.Started {
+		n += 2
+	}
+	if m.Ongoing {
+		n += 2
+	}
+	if m.Finished {
+		n += 2
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	l = len(m.LiveTime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNewLeague) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNewMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Match.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventMatchUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 1 + sovEvents(uint64(m.Priority))
+	}
+	l = m.Old.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.New.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventMatchFinished) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovEvents(uint64(m.MatchId))
+	}
+	if m.HomeScore != 0 {
+		n += 1 + sovEvents(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovEvents(uint64(m.AwayScore))
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventMatchReopened) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovEvents(uint64(m.MatchId))
+	}
+	return n
+}
+
+func (m *EventMatchResultRevised) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovEvents(uint64(m.MatchId))
+	}
+	if m.HomeScore != 0 {
+		n += 1 + sovEvents(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovEvents(uint64(m.AwayScore))
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventMatchFinalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovEvents(uint64(m.MatchId))
+	}
+	if m.HomeScore != 0 {
+		n += 1 + sovEvents(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovEvents(uint64(m.AwayScore))
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventMatchCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovEvents(uint64(m.MatchId))
+	}
+	l = len(m.Subscriber)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.GasUsed))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEntitiesMerged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntityType != 0 {
+		n += 1 + sovEvents(uint64(m.EntityType))
+	}
+	if m.DuplicateId != 0 {
+		n += 1 + sovEvents(uint64(m.DuplicateId))
+	}
+	if m.CanonicalId != 0 {
+		n += 1 + sovEvents(uint64(m.CanonicalId))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventExternalIDMapped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntityType != 0 {
+		n += 1 + sovEvents(uint64(m.EntityType))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CanonicalId != 0 {
+		n += 1 + sovEvents(uint64(m.CanonicalId))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MatchState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatchState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatchState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeagueId", wireType)
+			}
+			m.LeagueId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeagueId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeId", wireType)
+			}
+			m.HomeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HomeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayId", wireType)
+			}
+			m.AwayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AwayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
+			}
+			m.HomeScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
+			}
+			m.AwayScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ongoing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ongoing = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finished = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiveTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewLeague) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewLeague: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewLeague: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Match.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMatchUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMatchUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMatchUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= MatchUpdatePriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Old.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.New.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMatchFinished) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMatchFinished: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMatchFinished: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
+			}
+			m.HomeScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
+			}
+			m.AwayScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMatchReopened) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMatchReopened: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMatchReopened: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMatchResultRevised) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMatchResultRevised: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMatchResultRevised: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
+			}
+			m.HomeScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
+			}
+			m.AwayScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMatchFinalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMatchFinalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMatchFinalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
+			}
+			m.HomeScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
+			}
+			m.AwayScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMatchCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMatchCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMatchCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEntitiesMerged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEntitiesMerged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEntitiesMerged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+			}
+			m.EntityType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntityType |= EntityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateId", wireType)
+			}
+			m.DuplicateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DuplicateId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalId", wireType)
+			}
+			m.CanonicalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanonicalId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExternalIDMapped) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExternalIDMapped: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExternalIDMapped: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+			}
+			m.EntityType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntityType |= EntityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalId", wireType)
+			}
+			m.CanonicalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanonicalId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
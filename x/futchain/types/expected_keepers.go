@@ -2,9 +2,19 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -17,9 +27,54 @@ type AuthKeeper interface {
 // BankKeeper defines the expected interface for the Bank module.
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 	// Methods imported from bank should be defined here
 }
 
+// StakingKeeper defines the expected interface for the Staking module.
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+	ValidatorAddressCodec() address.Codec
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error)
+	PowerReduction(ctx context.Context) math.Int
+}
+
+// SlashingKeeper defines the expected interface for the Slashing module.
+type SlashingKeeper interface {
+	Slash(ctx context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64) error
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+}
+
+// Erc20Keeper defines the expected interface for the ERC-20 module.
+type Erc20Keeper interface {
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+	SetToken(ctx sdk.Context, pair erc20types.TokenPair) error
+	EnableDynamicPrecompile(ctx sdk.Context, address common.Address) error
+	DeleteTokenPair(ctx sdk.Context, tokenPair erc20types.TokenPair)
+	DeleteDynamicPrecompile(ctx sdk.Context, precompile common.Address)
+	UnRegisterERC20CodeHash(ctx sdk.Context, erc20Addr common.Address) error
+}
+
+// EVMKeeper defines the expected interface for the EVM module.
+type EVMKeeper interface {
+	ApplyMessage(ctx sdk.Context, msg core.Message, tracer *tracing.Hooks, commit bool, internal bool) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// ChannelKeeper defines the expected interface for the IBC channel keeper, to send the futchain packets.
+type ChannelKeeper interface {
+	SendPacket(ctx sdk.Context, sourcePort string, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -0,0 +1,11 @@
+package types
+
+// Finality states of a match result, as returned by the getMatchFinality precompile method.
+const (
+	// FinalityOpen is a match that is not finished nor cancelled.
+	FinalityOpen uint8 = iota
+	// FinalityPending is a finished or cancelled match whose result may still be revised by the source.
+	FinalityPending
+	// FinalityFinalized is a match whose result stayed unchanged for the finality_blocks param.
+	FinalityFinalized
+)
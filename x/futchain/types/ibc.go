@@ -0,0 +1,14 @@
+package types
+
+import "time"
+
+const (
+	// PortID is the port of the futchain IBC application, which counterparty chains open channels to.
+	PortID = ModuleName
+
+	// Version is the version of the futchain IBC application.
+	Version = "futchain-1"
+
+	// ResultPacketTimeout is how long a match result packet can be relayed to a subscribed chain for.
+	ResultPacketTimeout = 24 * time.Hour
+)
@@ -6,6 +6,15 @@ const (
 	// ModuleName defines the module name
 	ModuleName = "futchain"
 
+	// OracleRewardsPoolName is the module account holding the unclaimed oracle rewards.
+	OracleRewardsPoolName = "futchain_oracle_rewards"
+
+	// ReporterBondsPoolName is the module account holding the bonds of the data reporters.
+	ReporterBondsPoolName = "futchain_reporter_bonds"
+
+	// ReporterRewardsPoolName is the module account holding the funds the reporter rewards are paid from.
+	ReporterRewardsPoolName = "futchain_reporter_rewards"
+
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
@@ -17,3 +26,112 @@ const (
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_futchain")
+
+var (
+	// SubscriptionsKey is the prefix of the match result subscriptions, keyed by (match id, subscriber).
+	SubscriptionsKey = collections.NewPrefix("subscriptions")
+	// SubscriptionFeesKey is the prefix of the callback fees prepaid by the subscribers, keyed by (match id, subscriber).
+	SubscriptionFeesKey = collections.NewPrefix("subscription_fees")
+	// PendingCallbacksKey is the prefix of the matches whose subscribers are waiting for their callback.
+	PendingCallbacksKey = collections.NewPrefix("pending_callbacks")
+
+	// MarketSeqKey is the prefix of the prediction market id sequence.
+	MarketSeqKey = collections.NewPrefix("market_seq")
+	// MarketsKey is the prefix of the prediction markets, keyed by market id.
+	MarketsKey = collections.NewPrefix("markets")
+	// MatchMarketsKey is the prefix of the (match id, market id) index.
+	MatchMarketsKey = collections.NewPrefix("idx_match_markets")
+	// StakesKey is the prefix of the stakes, keyed by (market id, staker, outcome).
+	StakesKey = collections.NewPrefix("stakes")
+	// OutcomeCollateralKey is the prefix of the collateral locked for the outcome shares of a match.
+	OutcomeCollateralKey = collections.NewPrefix("outcome_collateral")
+	// UnsettledMarketsKey is the prefix of the markets whose settlement failed, retried in EndBlock.
+	UnsettledMarketsKey = collections.NewPrefix("unsettled_markets")
+
+	// PendingFinalityKey is the prefix of the finished matches in their dispute window, keyed by match id
+	// to the height of the last result change.
+	PendingFinalityKey = collections.NewPrefix("pending_finality")
+	// FinalizedMatchesKey is the prefix of the finalized matches, keyed by match id to the finality height.
+	FinalizedMatchesKey = collections.NewPrefix("finalized_matches")
+)
+
+var (
+	// CorrectionSeqKey is the prefix of the data-council correction id sequence.
+	CorrectionSeqKey = collections.NewPrefix("correction_seq")
+	// CorrectionsKey is the prefix of the data-council corrections, keyed by correction id.
+	CorrectionsKey = collections.NewPrefix("corrections")
+	// PendingCorrectionsKey is the prefix of the pending corrections, keyed by (expiry height, correction id).
+	PendingCorrectionsKey = collections.NewPrefix("pending_corrections")
+)
+
+var (
+	// OracleReportsKey is the prefix of the validator oracle reports, keyed by (match id, validator).
+	OracleReportsKey = collections.NewPrefix("oracle_reports")
+	// ValidatorOracleReportsKey is the prefix of the (validator, match id) index of the oracle reports.
+	ValidatorOracleReportsKey = collections.NewPrefix("idx_validator_oracle_reports")
+	// ValidatorOracleInfosKey is the prefix of the validator oracle counters, keyed by validator.
+	ValidatorOracleInfosKey = collections.NewPrefix("validator_oracle_infos")
+	// OracleReportWindowKey is the prefix of the report outcomes in the sliding window, keyed by (validator, index).
+	OracleReportWindowKey = collections.NewPrefix("oracle_report_window")
+	// OracleWindowStartKey is the prefix of the height the oracle report window was enabled at.
+	OracleWindowStartKey = collections.NewPrefix("oracle_window_start")
+	// OracleWindowValidatorsKey is the prefix of the validators bonded when the oracle report window was enabled.
+	OracleWindowValidatorsKey = collections.NewPrefix("oracle_window_validators")
+	// TalliedOracleMatchesKey is the prefix of the matches whose oracle reports were tallied.
+	TalliedOracleMatchesKey = collections.NewPrefix("tallied_oracle_matches")
+)
+
+var (
+	// OracleRewardWeightsKey is the prefix of the stake weight each validator accrued with accurate reports
+	// in the current epoch, keyed by validator.
+	OracleRewardWeightsKey = collections.NewPrefix("oracle_reward_weights")
+	// OracleRewardsKey is the prefix of the claimable oracle rewards, keyed by validator.
+	OracleRewardsKey = collections.NewPrefix("oracle_rewards")
+)
+
+var (
+	// ReportersKey is the prefix of the data reporters, keyed by address.
+	ReportersKey = collections.NewPrefix("reporters")
+	// ReporterReportsKey is the prefix of the reporter match reports, keyed by (match id, reporter).
+	ReporterReportsKey = collections.NewPrefix("reporter_reports")
+	// PendingMatchReportsKey is the prefix of the matches with reports not aggregated yet.
+	PendingMatchReportsKey = collections.NewPrefix("pending_match_reports")
+	// UnbondingReportersKey is the prefix of the unbonding reporters, keyed by (unbonding height, reporter).
+	UnbondingReportersKey = collections.NewPrefix("unbonding_reporters")
+	// ReportCommitsKey is the prefix of the report commits, keyed by (round, match id, reporter).
+	ReportCommitsKey = collections.NewPrefix("report_commits")
+	// ReporterRewardsPaidKey is the prefix of the reporter rewards paid in a reward epoch, keyed by epoch.
+	ReporterRewardsPaidKey = collections.NewPrefix("reporter_rewards_paid")
+)
+
+// MatchAttestationsKey is the prefix of the provider attestations of the ingested matches, keyed by match id.
+var MatchAttestationsKey = collections.NewPrefix("attestations")
+
+// MatchResultsKey is the prefix of the match result records, keyed by the big endian match id. Their values
+// are MatchResult encodings, which can be proven against the app hash.
+var MatchResultsKey = collections.NewPrefix("results")
+
+// IBCSubscriptionsKey is the prefix of the IBC channels subscribed to the result of a match, keyed by
+// (match id, channel id).
+var IBCSubscriptionsKey = collections.NewPrefix("ibc_subscriptions")
+
+var (
+	// TeamNamesKey is the prefix of the team name search index, keyed by (name search term, team id).
+	TeamNamesKey = collections.NewPrefix("idx_team_names")
+	// LeagueNamesKey is the prefix of the league name search index, keyed by (name search term, league id).
+	LeagueNamesKey = collections.NewPrefix("idx_league_names")
+)
+
+var (
+	// IDMappingsKey is the prefix of the id mappings, keyed by (entity type, provider, external id) to the
+	// canonical id.
+	IDMappingsKey = collections.NewPrefix("id_mappings")
+	// CanonicalIDMappingsKey is the prefix of the (entity type, canonical id, provider, external id) index of
+	// the id mappings.
+	CanonicalIDMappingsKey = collections.NewPrefix("idx_canonical_id_mappings")
+	// MergedIDsKey is the prefix of the merged duplicates, keyed by (entity type, duplicate id) to the
+	// canonical id they were merged into.
+	MergedIDsKey = collections.NewPrefix("merged_ids")
+	// CanonicalIDSeqKey is the prefix of the sequence of the canonical ids allocated by the chain.
+	CanonicalIDSeqKey = collections.NewPrefix("canonical_id_seq")
+)
@@ -0,0 +1,80 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Outcomes of MARKET_TYPE_MATCH_RESULT markets.
+const (
+	OutcomeHome uint64 = 0
+	OutcomeDraw uint64 = 1
+	OutcomeAway uint64 = 2
+)
+
+// Outcomes of MARKET_TYPE_OVER_UNDER markets.
+const (
+	OutcomeUnder uint64 = 0
+	OutcomeOver  uint64 = 1
+)
+
+// ValidateOverUnderLine checks that an over/under line, in half goals, is a half goal line such as 2.5,
+// so a total never pushes on it.
+func ValidateOverUnderLine(line uint64) error {
+	if line%2 == 0 {
+		return errorsmod.Wrapf(ErrInvalidMarket, "over/under line %d must be an odd number of half goals", line)
+	}
+	return nil
+}
+
+// MaxCorrectScore is the highest score per team a correct score outcome can encode.
+const MaxCorrectScore = 99
+
+// CorrectScoreOutcome encodes a final score as a MARKET_TYPE_CORRECT_SCORE outcome.
+func CorrectScoreOutcome(homeScore, awayScore uint64) uint64 {
+	return homeScore*100 + awayScore
+}
+
+// ValidateOutcome checks that the outcome is a possible result of the market.
+func (m Market) ValidateOutcome(outcome uint64) error {
+	switch m.MarketType {
+	case MARKET_TYPE_MATCH_RESULT:
+		if outcome > OutcomeAway {
+			return errorsmod.Wrapf(ErrInvalidStake, "invalid match result outcome %d", outcome)
+		}
+	case MARKET_TYPE_OVER_UNDER:
+		if outcome > OutcomeOver {
+			return errorsmod.Wrapf(ErrInvalidStake, "invalid over/under outcome %d", outcome)
+		}
+	case MARKET_TYPE_CORRECT_SCORE:
+		if outcome/100 > MaxCorrectScore || outcome%100 > MaxCorrectScore {
+			return errorsmod.Wrapf(ErrInvalidStake, "invalid correct score outcome %d", outcome)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidMarket, "unknown market type %s", m.MarketType)
+	}
+	return nil
+}
+
+// Outcome returns the winning outcome of the market for the given final score.
+func (m Market) Outcome(homeScore, awayScore uint64) (uint64, error) {
+	switch m.MarketType {
+	case MARKET_TYPE_MATCH_RESULT:
+		switch {
+		case homeScore > awayScore:
+			return OutcomeHome, nil
+		case homeScore < awayScore:
+			return OutcomeAway, nil
+		default:
+			return OutcomeDraw, nil
+		}
+	case MARKET_TYPE_OVER_UNDER:
+		if 2*(homeScore+awayScore) > m.Line {
+			return OutcomeOver, nil
+		}
+		return OutcomeUnder, nil
+	case MARKET_TYPE_CORRECT_SCORE:
+		return CorrectScoreOutcome(homeScore, awayScore), nil
+	default:
+		return 0, errorsmod.Wrapf(ErrInvalidMarket, "unknown market type %s", m.MarketType)
+	}
+}
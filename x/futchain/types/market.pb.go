@@ -0,0 +1,1305 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: futchain/futchain/v1/market.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MarketType defines what a prediction market is betting on.
+type MarketType int32
+
+const (
+	MARKET_TYPE_UNSPECIFIED MarketType = 0
+	// MARKET_TYPE_MATCH_RESULT is a 1X2 market.
+	// Outcomes: 0 home win, 1 draw, 2 away win.
+	MARKET_TYPE_MATCH_RESULT MarketType = 1
+	// MARKET_TYPE_OVER_UNDER bets on the total goals against the market line,
+	// a half goal line so a total never equals it.
+	// Outcomes: 0 under (total < line), 1 over (total > line).
+	MARKET_TYPE_OVER_UNDER MarketType = 2
+	// MARKET_TYPE_CORRECT_SCORE bets on the final score.
+	// Outcome: home_score * 100 + away_score.
+	MARKET_TYPE_CORRECT_SCORE MarketType = 3
+)
+
+var MarketType_name = map[int32]string{
+	0: "MARKET_TYPE_UNSPECIFIED",
+	1: "MARKET_TYPE_MATCH_RESULT",
+	2: "MARKET_TYPE_OVER_UNDER",
+	3: "MARKET_TYPE_CORRECT_SCORE",
+}
+
+var MarketType_value = map[string]int32{
+	"MARKET_TYPE_UNSPECIFIED":   0,
+	"MARKET_TYPE_MATCH_RESULT":  1,
+	"MARKET_TYPE_OVER_UNDER":    2,
+	"MARKET_TYPE_CORRECT_SCORE": 3,
+}
+
+func (x MarketType) String() string {
+	return proto.EnumName(MarketType_name, int32(x))
+}
+
+func (MarketType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f91064207c3f361a, []int{0}
+}
+
+// MarketStatus defines the lifecycle of a prediction market.
+type MarketStatus int32
+
+const (
+	MARKET_STATUS_UNSPECIFIED MarketStatus = 0
+	// MARKET_STATUS_OPEN accepts stakes until the match starts.
+	MARKET_STATUS_OPEN MarketStatus = 1
+	// MARKET_STATUS_SETTLED paid the pool out to the winning outcome.
+	MARKET_STATUS_SETTLED MarketStatus = 2
+	// MARKET_STATUS_REFUNDED returned every stake, either because the match was
+	// cancelled or nobody staked on the winning outcome.
+	MARKET_STATUS_REFUNDED MarketStatus = 3
+)
+
+var MarketStatus_name = map[int32]string{
+	0: "MARKET_STATUS_UNSPECIFIED",
+	1: "MARKET_STATUS_OPEN",
+	2: "MARKET_STATUS_SETTLED",
+	3: "MARKET_STATUS_REFUNDED",
+}
+
+var MarketStatus_value = map[string]int32{
+	"MARKET_STATUS_UNSPECIFIED": 0,
+	"MARKET_STATUS_OPEN":        1,
+	"MARKET_STATUS_SETTLED":     2,
+	"MARKET_STATUS_REFUNDED":    3,
+}
+
+func (x MarketStatus) String() string {
+	return proto.EnumName(MarketStatus_name, int32(x))
+}
+
+func (MarketStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f91064207c3f361a, []int{1}
+}
+
+// Market is a parimutuel prediction market on a match.
+type Market struct {
+	Id         uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MatchId    int64      `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	MarketType MarketType `protobuf:"varint,3,opt,name=market_type,json=marketType,proto3,enum=futchain.futchain.v1.MarketType" json:"market_type,omitempty"`
+	// line is the goal line of over/under markets in half goals, e.g. 5 is the
+	// 2.5 goals line; it must be odd. Unused otherwise.
+	Line    uint64       `protobuf:"varint,4,opt,name=line,proto3" json:"line,omitempty"`
+	Creator string       `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status  MarketStatus `protobuf:"varint,6,opt,name=status,proto3,enum=futchain.futchain.v1.MarketStatus" json:"status,omitempty"`
+	// winning_outcome is set once the market is settled.
+	WinningOutcome uint64 `protobuf:"varint,7,opt,name=winning_outcome,json=winningOutcome,proto3" json:"winning_outcome,omitempty"`
+	// total_staked is the pool of the market, in the bond denom.
+	TotalStaked types.Coin `protobuf:"bytes,8,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked"`
+	// deposit is the creation deposit of the creator, returned once the market
+	// is settled or refunded.
+	Deposit types.Coin `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *Market) Reset()         { *m = Market{} }
+func (m *Market) String() string { return proto.CompactTextString(m) }
+func (*Market) ProtoMessage()    {}
+func (*Market) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91064207c3f361a, []int{0}
+}
+func (m *Market) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Market) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Market.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Market) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Market.Merge(m, src)
+}
+func (m *Market) XXX_Size() int {
+	return m.Size()
+}
+func (m *Market) XXX_DiscardUnknown() {
+	xxx_messageInfo_Market.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Market proto.InternalMessageInfo
+
+func (m *Market) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Market) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *Market) GetMarketType() MarketType {
+	if m != nil {
+		return m.MarketType
+	}
+	return MARKET_TYPE_UNSPECIFIED
+}
+
+func (m *Market) GetLine() uint64 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *Market) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Market) GetStatus() MarketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return MARKET_STATUS_UNSPECIFIED
+}
+
+func (m *Market) GetWinningOutcome() uint64 {
+	if m != nil {
+		return m.WinningOutcome
+	}
+	return 0
+}
+
+func (m *Market) GetTotalStaked() types.Coin {
+	if m != nil {
+		return m.TotalStaked
+	}
+	return types.Coin{}
+}
+
+func (m *Market) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+// Stake is the amount a staker has put on an outcome of a market.
+type Stake struct {
+	MarketId uint64     `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Staker   string     `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+	Outcome  uint64     `protobuf:"varint,3,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *Stake) Reset()         { *m = Stake{} }
+func (m *Stake) String() string { return proto.CompactTextString(m) }
+func (*Stake) ProtoMessage()    {}
+func (*Stake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91064207c3f361a, []int{1}
+}
+func (m *Stake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stake.Merge(m, src)
+}
+func (m *Stake) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stake) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stake proto.InternalMessageInfo
+
+func (m *Stake) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *Stake) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
+}
+
+func (m *Stake) GetOutcome() uint64 {
+	if m != nil {
+		return m.Outcome
+	}
+	return 0
+}
+
+func (m *Stake) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// OutcomeToken is the bank denom and ERC-20 representation of a 1X2 outcome
+// share of a match. Outcome shares are minted in complete sets (one share of
+// every outcome) against one unit of collateral each.
+type OutcomeToken struct {
+	// outcome is 0 home win, 1 draw or 2 away win.
+	Outcome      uint64 `protobuf:"varint,1,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Erc20Address string `protobuf:"bytes,3,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+}
+
+func (m *OutcomeToken) Reset()         { *m = OutcomeToken{} }
+func (m *OutcomeToken) String() string { return proto.CompactTextString(m) }
+func (*OutcomeToken) ProtoMessage()    {}
+func (*OutcomeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f91064207c3f361a, []int{2}
+}
+func (m *OutcomeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutcomeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutcomeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutcomeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutcomeToken.Merge(m, src)
+}
+func (m *OutcomeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutcomeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutcomeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutcomeToken proto.InternalMessageInfo
+
+func (m *OutcomeToken) GetOutcome() uint64 {
+	if m != nil {
+		return m.Outcome
+	}
+	return 0
+}
+
+func (m *OutcomeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OutcomeToken) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("futchain.futchain.v1.MarketType", MarketType_name, MarketType_value)
+	proto.RegisterEnum("futchain.futchain.v1.MarketStatus", MarketStatus_name, MarketStatus_value)
+	proto.RegisterType((*Market)(nil), "futchain.futchain.v1.Market")
+	proto.RegisterType((*Stake)(nil), "futchain.futchain.v1.Stake")
+	proto.RegisterType((*OutcomeToken)(nil), "futchain.futchain.v1.OutcomeToken")
+}
+
+func init() { proto.RegisterFile("futchain/futchain/v1/market.proto", fileDescriptor_f91064207c3f361a) }
+
+var fileDescriptor_f91064207c3f361a = []byte{
+	// 681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xb3, 0x49, 0x9a, 0x34, 0xd3, 0x7c, 0xfd, 0xc2, 0x2a, 0x14, 0x27, 0x05, 0x13, 0xc2,
+	0x81, 0xa8, 0x08, 0xbb, 0x09, 0x37, 0x84, 0x90, 0xd2, 0xc4, 0x85, 0x88, 0xb6, 0xa9, 0xd6, 0x0e,
+	0x12, 0x5c, 0x2c, 0xc7, 0xde, 0xa6, 0x56, 0x6a, 0x6f, 0x64, 0x6f, 0x0a, 0xbd, 0x72, 0xea, 0x91,
+	0x77, 0xe0, 0xc2, 0x01, 0x21, 0x0e, 0x3c, 0x44, 0x8f, 0x15, 0x27, 0x4e, 0x08, 0xb5, 0x07, 0x5e,
+	0x03, 0x65, 0xed, 0xa4, 0x29, 0x42, 0x15, 0x5c, 0xa2, 0x99, 0xf9, 0xcf, 0xcc, 0xfe, 0x76, 0xc6,
+	0x59, 0xb8, 0xb3, 0x37, 0xe6, 0xf6, 0xbe, 0xe5, 0xfa, 0xea, 0xcc, 0x38, 0xac, 0xab, 0x9e, 0x15,
+	0x0c, 0x29, 0x57, 0x46, 0x01, 0xe3, 0x0c, 0x17, 0xa7, 0x8a, 0x32, 0x33, 0x0e, 0xeb, 0xe5, 0x6b,
+	0x96, 0xe7, 0xfa, 0x4c, 0x15, 0xbf, 0x51, 0x62, 0x59, 0xb6, 0x59, 0xe8, 0xb1, 0x50, 0xed, 0x5b,
+	0x21, 0x55, 0x0f, 0xeb, 0x7d, 0xca, 0xad, 0xba, 0x6a, 0x33, 0xd7, 0x8f, 0xf5, 0x52, 0xa4, 0x9b,
+	0xc2, 0x53, 0x23, 0x27, 0x96, 0x8a, 0x03, 0x36, 0x60, 0x51, 0x7c, 0x62, 0x45, 0xd1, 0xea, 0xc7,
+	0x14, 0x64, 0xb6, 0x05, 0x0a, 0x5e, 0x86, 0xa4, 0xeb, 0x48, 0xa8, 0x82, 0x6a, 0x69, 0x92, 0x74,
+	0x1d, 0x5c, 0x82, 0x45, 0xcf, 0xe2, 0xf6, 0xbe, 0xe9, 0x3a, 0x52, 0xb2, 0x82, 0x6a, 0x29, 0x92,
+	0x15, 0x7e, 0xc7, 0xc1, 0x4d, 0x58, 0x8a, 0xf8, 0x4d, 0x7e, 0x34, 0xa2, 0x52, 0xaa, 0x82, 0x6a,
+	0xcb, 0x8d, 0x8a, 0xf2, 0xa7, 0x5b, 0x28, 0x51, 0x77, 0xe3, 0x68, 0x44, 0x09, 0x78, 0x33, 0x1b,
+	0x63, 0x48, 0x1f, 0xb8, 0x3e, 0x95, 0xd2, 0xe2, 0x3c, 0x61, 0xe3, 0x06, 0x64, 0xed, 0x80, 0x5a,
+	0x9c, 0x05, 0xd2, 0x42, 0x05, 0xd5, 0x72, 0x1b, 0xd2, 0xd7, 0x2f, 0x0f, 0x8a, 0xf1, 0x2d, 0x9a,
+	0x8e, 0x13, 0xd0, 0x30, 0xd4, 0x79, 0xe0, 0xfa, 0x03, 0x32, 0x4d, 0xc4, 0x8f, 0x20, 0x13, 0x72,
+	0x8b, 0x8f, 0x43, 0x29, 0x23, 0x28, 0xaa, 0x57, 0x51, 0xe8, 0x22, 0x93, 0xc4, 0x15, 0xf8, 0x1e,
+	0xfc, 0xff, 0xda, 0xf5, 0x7d, 0xd7, 0x1f, 0x98, 0x6c, 0xcc, 0x6d, 0xe6, 0x51, 0x29, 0x2b, 0x70,
+	0x96, 0xe3, 0x70, 0x37, 0x8a, 0xe2, 0xa7, 0x90, 0xe7, 0x8c, 0x5b, 0x07, 0x66, 0xc8, 0xad, 0x21,
+	0x75, 0xa4, 0xc5, 0x0a, 0xaa, 0x2d, 0x35, 0x4a, 0x4a, 0x8c, 0x36, 0xd9, 0x86, 0x12, 0x6f, 0x43,
+	0x69, 0x31, 0xd7, 0xdf, 0xc8, 0x9d, 0x7c, 0xbf, 0x9d, 0xf8, 0xf0, 0xf3, 0xf3, 0x1a, 0x22, 0x4b,
+	0xa2, 0x52, 0x17, 0x85, 0xf8, 0x09, 0x64, 0x1d, 0x3a, 0x62, 0xa1, 0xcb, 0xa5, 0xdc, 0x3f, 0xf4,
+	0x98, 0x16, 0x55, 0x3f, 0x21, 0x58, 0x10, 0xad, 0xf0, 0x2a, 0xe4, 0xe2, 0x15, 0xcc, 0x96, 0xb6,
+	0x18, 0x05, 0x3a, 0x0e, 0x5e, 0x17, 0x43, 0x19, 0xd2, 0x40, 0x2c, 0xee, 0xaa, 0x39, 0xc6, 0x79,
+	0x58, 0x82, 0xec, 0x74, 0x04, 0x29, 0xd1, 0x6c, 0xea, 0xe2, 0xc7, 0x90, 0xb1, 0x3c, 0x36, 0xf6,
+	0xb9, 0x58, 0xd5, 0xdf, 0x12, 0xc7, 0x35, 0x55, 0x1b, 0xf2, 0xf1, 0x10, 0x0d, 0x36, 0xa4, 0xfe,
+	0xfc, 0x39, 0xe8, 0xf2, 0x39, 0x45, 0x58, 0x70, 0xa8, 0xcf, 0xbc, 0x08, 0x99, 0x44, 0x0e, 0xbe,
+	0x0b, 0xff, 0xd1, 0xc0, 0x6e, 0xac, 0x9b, 0x56, 0x84, 0x2d, 0xe8, 0x72, 0x24, 0x2f, 0x82, 0xf1,
+	0x55, 0xd6, 0x8e, 0x11, 0xc0, 0xc5, 0x67, 0x86, 0x57, 0xe1, 0xc6, 0x76, 0x93, 0x3c, 0xd7, 0x0c,
+	0xd3, 0x78, 0xb9, 0xab, 0x99, 0xbd, 0x1d, 0x7d, 0x57, 0x6b, 0x75, 0x36, 0x3b, 0x5a, 0xbb, 0x90,
+	0xc0, 0x37, 0x41, 0x9a, 0x17, 0xb7, 0x9b, 0x46, 0xeb, 0x99, 0x49, 0x34, 0xbd, 0xb7, 0x65, 0x14,
+	0x10, 0x2e, 0xc3, 0xca, 0xbc, 0xda, 0x7d, 0xa1, 0x11, 0xb3, 0xb7, 0xd3, 0xd6, 0x48, 0x21, 0x89,
+	0x6f, 0x41, 0x69, 0x5e, 0x6b, 0x75, 0x09, 0xd1, 0x5a, 0x86, 0xa9, 0xb7, 0xba, 0x44, 0x2b, 0xa4,
+	0xca, 0xe9, 0xe3, 0xf7, 0x72, 0x62, 0xed, 0x2d, 0x82, 0xfc, 0xfc, 0xb7, 0x36, 0x57, 0xa5, 0x1b,
+	0x4d, 0xa3, 0xa7, 0xff, 0x86, 0xb3, 0x02, 0xf8, 0xb2, 0xdc, 0xdd, 0xd5, 0x76, 0x0a, 0x08, 0x97,
+	0xe0, 0xfa, 0xe5, 0xb8, 0xae, 0x19, 0xc6, 0x96, 0xd6, 0x2e, 0x24, 0xe7, 0x18, 0x63, 0x89, 0x68,
+	0x9b, 0x13, 0xc6, 0xf6, 0x14, 0x62, 0x43, 0x3b, 0x39, 0x93, 0xd1, 0xe9, 0x99, 0x8c, 0x7e, 0x9c,
+	0xc9, 0xe8, 0xdd, 0xb9, 0x9c, 0x38, 0x3d, 0x97, 0x13, 0xdf, 0xce, 0xe5, 0xc4, 0xab, 0xfb, 0x03,
+	0x97, 0xef, 0x8f, 0xfb, 0x8a, 0xcd, 0x3c, 0x35, 0xb0, 0xdc, 0xbd, 0xd1, 0xd1, 0xc5, 0xa3, 0xf4,
+	0xe6, 0xc2, 0x9c, 0xfc, 0xab, 0xc3, 0x7e, 0x46, 0x3c, 0x11, 0x0f, 0x7f, 0x05, 0x00, 0x00, 0xff,
+	0xff, 0x5c, 0x11, 0xd4, 0x4b, 0xc1, 0x04, 0x00, 0x00,
+}
+
+func (m *Market) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.TotalStaked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.WinningOutcome != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.WinningOutcome))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Status != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Line != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Line))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MarketType != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MarketType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Outcome != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutcomeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutcomeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutcomeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovMarket(uint64(m.MatchId))
+	}
+	if m.MarketType != 0 {
+		n += 1 + sovMarket(uint64(m.MarketType))
+	}
+	if m.Line != 0 {
+		n += 1 + sovMarket(uint64(m.Line))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMarket(uint64(m.Status))
+	}
+	if m.WinningOutcome != 0 {
+		n += 1 + sovMarket(uint64(m.WinningOutcome))
+	}
+	l = m.TotalStaked.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Deposit.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *Stake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovMarket(uint64(m.MarketId))
+	}
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovMarket(uint64(m.Outcome))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *OutcomeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Outcome != 0 {
+		n += 1 + sovMarket(uint64(m.Outcome))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func sovMarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarket(x uint64) (n int) {
+	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketType", wireType)
+			}
+			m.MarketType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketType |= MarketType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
+			}
+			m.Line = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Line |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MarketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningOutcome", wireType)
+			}
+			m.WinningOutcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinningOutcome |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutcomeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutcomeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutcomeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMarket = fmt.Errorf("proto: unexpected end of group")
+)
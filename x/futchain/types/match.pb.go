@@ -0,0 +1,2178 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: futchain/futchain/v1/match.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MatchStatusFilter selects matches by their status.
+type MatchStatusFilter int32
+
+const (
+	// MATCH_STATUS_FILTER_ALL selects all matches.
+	MATCH_STATUS_FILTER_ALL MatchStatusFilter = 0
+	// MATCH_STATUS_FILTER_UPCOMING selects the matches not started yet.
+	MATCH_STATUS_FILTER_UPCOMING MatchStatusFilter = 1
+	// MATCH_STATUS_FILTER_LIVE selects the started matches not over yet.
+	MATCH_STATUS_FILTER_LIVE MatchStatusFilter = 2
+	// MATCH_STATUS_FILTER_FINISHED selects the finished matches.
+	MATCH_STATUS_FILTER_FINISHED MatchStatusFilter = 3
+	// MATCH_STATUS_FILTER_CANCELLED selects the cancelled matches.
+	MATCH_STATUS_FILTER_CANCELLED MatchStatusFilter = 4
+	// MATCH_STATUS_FILTER_FINALIZED selects the matches with a final result.
+	MATCH_STATUS_FILTER_FINALIZED MatchStatusFilter = 5
+)
+
+var MatchStatusFilter_name = map[int32]string{
+	0: "MATCH_STATUS_FILTER_ALL",
+	1: "MATCH_STATUS_FILTER_UPCOMING",
+	2: "MATCH_STATUS_FILTER_LIVE",
+	3: "MATCH_STATUS_FILTER_FINISHED",
+	4: "MATCH_STATUS_FILTER_CANCELLED",
+	5: "MATCH_STATUS_FILTER_FINALIZED",
+}
+
+var MatchStatusFilter_value = map[string]int32{
+	"MATCH_STATUS_FILTER_ALL":       0,
+	"MATCH_STATUS_FILTER_UPCOMING":  1,
+	"MATCH_STATUS_FILTER_LIVE":      2,
+	"MATCH_STATUS_FILTER_FINISHED":  3,
+	"MATCH_STATUS_FILTER_CANCELLED": 4,
+	"MATCH_STATUS_FILTER_FINALIZED": 5,
+}
+
+func (x MatchStatusFilter) String() string {
+	return proto.EnumName(MatchStatusFilter_name, int32(x))
+}
+
+func (MatchStatusFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3c311dde4220f124, []int{0}
+}
+
+// League is an ingested league.
+type League struct {
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	GroupName string `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	IsGroup   bool   `protobuf:"varint,4,opt,name=is_group,json=isGroup,proto3" json:"is_group,omitempty"`
+	// ccode is the country code of the league.
+	Ccode string `protobuf:"bytes,5,opt,name=ccode,proto3" json:"ccode,omitempty"`
+	// primary_id is the id of the parent league of a group.
+	PrimaryId int64 `protobuf:"varint,6,opt,name=primary_id,json=primaryId,proto3" json:"primary_id,omitempty"`
+}
+
+func (m *League) Reset()         { *m = League{} }
+func (m *League) String() string { return proto.CompactTextString(m) }
+func (*League) ProtoMessage()    {}
+func (*League) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c311dde4220f124, []int{0}
+}
+func (m *League) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *League) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_League.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *League) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_League.Merge(m, src)
+}
+func (m *League) XXX_Size() int {
+	return m.Size()
+}
+func (m *League) XXX_DiscardUnknown() {
+	xxx_messageInfo_League.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_League proto.InternalMessageInfo
+
+func (m *League) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *League) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *League) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+func (m *League) GetIsGroup() bool {
+	if m != nil {
+		return m.IsGroup
+	}
+	return false
+}
+
+func (m *League) GetCcode() string {
+	if m != nil {
+		return m.Ccode
+	}
+	return ""
+}
+
+func (m *League) GetPrimaryId() int64 {
+	if m != nil {
+		return m.PrimaryId
+	}
+	return 0
+}
+
+// Team is an ingested team.
+type Team struct {
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LongName string `protobuf:"bytes,3,opt,name=long_name,json=longName,proto3" json:"long_name,omitempty"`
+}
+
+func (m *Team) Reset()         { *m = Team{} }
+func (m *Team) String() string { return proto.CompactTextString(m) }
+func (*Team) ProtoMessage()    {}
+func (*Team) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c311dde4220f124, []int{1}
+}
+func (m *Team) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Team) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Team.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Team) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Team.Merge(m, src)
+}
+func (m *Team) XXX_Size() int {
+	return m.Size()
+}
+func (m *Team) XXX_DiscardUnknown() {
+	xxx_messageInfo_Team.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Team proto.InternalMessageInfo
+
+func (m *Team) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Team) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Team) GetLongName() string {
+	if m != nil {
+		return m.LongName
+	}
+	return ""
+}
+
+// LiveTime is the elapsed time of a match.
+type LiveTime struct {
+	// long is the elapsed time, e.g. "51:35".
+	Long      string `protobuf:"bytes,1,opt,name=long,proto3" json:"long,omitempty"`
+	MaxTime   int32  `protobuf:"varint,2,opt,name=max_time,json=maxTime,proto3" json:"max_time,omitempty"`
+	AddedTime int32  `protobuf:"varint,3,opt,name=added_time,json=addedTime,proto3" json:"added_time,omitempty"`
+}
+
+func (m *LiveTime) Reset()         { *m = LiveTime{} }
+func (m *LiveTime) String() string { return proto.CompactTextString(m) }
+func (*LiveTime) ProtoMessage()    {}
+func (*LiveTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c311dde4220f124, []int{2}
+}
+func (m *LiveTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiveTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiveTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiveTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiveTime.Merge(m, src)
+}
+func (m *LiveTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiveTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiveTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiveTime proto.InternalMessageInfo
+
+func (m *LiveTime) GetLong() string {
+	if m != nil {
+		return m.Long
+	}
+	return ""
+}
+
+func (m *LiveTime) GetMaxTime() int32 {
+	if m != nil {
+		return m.MaxTime
+	}
+	return 0
+}
+
+func (m *LiveTime) GetAddedTime() int32 {
+	if m != nil {
+		return m.AddedTime
+	}
+	return 0
+}
+
+// Status is the status of a match.
+type Status struct {
+	UtcTime      time.Time `protobuf:"bytes,1,opt,name=utc_time,json=utcTime,proto3,stdtime" json:"utc_time"`
+	PeriodLength int32     `protobuf:"varint,2,opt,name=period_length,json=periodLength,proto3" json:"period_length,omitempty"`
+	Started      bool      `protobuf:"varint,3,opt,name=started,proto3" json:"started,omitempty"`
+	Cancelled    bool      `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Finished     bool      `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	Ongoing      bool      `protobuf:"varint,6,opt,name=ongoing,proto3" json:"ongoing,omitempty"`
+	LiveTime     LiveTime  `protobuf:"bytes,7,opt,name=live_time,json=liveTime,proto3" json:"live_time"`
+}
+
+func (m *Status) Reset()         { *m = Status{} }
+func (m *Status) String() string { return proto.CompactTextString(m) }
+func (*Status) ProtoMessage()    {}
+func (*Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c311dde4220f124, []int{3}
+}
+func (m *Status) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Status.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Status.Merge(m, src)
+}
+func (m *Status) XXX_Size() int {
+	return m.Size()
+}
+func (m *Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Status proto.InternalMessageInfo
+
+func (m *Status) GetUtcTime() time.Time {
+	if m != nil {
+		return m.UtcTime
+	}
+	return time.Time{}
+}
+
+func (m *Status) GetPeriodLength() int32 {
+	if m != nil {
+		return m.PeriodLength
+	}
+	return 0
+}
+
+func (m *Status) GetStarted() bool {
+	if m != nil {
+		return m.Started
+	}
+	return false
+}
+
+func (m *Status) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *Status) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *Status) GetOngoing() bool {
+	if m != nil {
+		return m.Ongoing
+	}
+	return false
+}
+
+func (m *Status) GetLiveTime() LiveTime {
+	if m != nil {
+		return m.LiveTime
+	}
+	return LiveTime{}
+}
+
+// Match is an ingested match, with its teams.
+type Match struct {
+	Id              int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LeagueId        int64  `protobuf:"varint,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	Time            string `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Home            Team   `protobuf:"bytes,4,opt,name=home,proto3" json:"home"`
+	Away            Team   `protobuf:"bytes,5,opt,name=away,proto3" json:"away"`
+	HomeScore       int64  `protobuf:"varint,6,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore       int64  `protobuf:"varint,7,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	StatusId        int32  `protobuf:"varint,8,opt,name=status_id,json=statusId,proto3" json:"status_id,omitempty"`
+	TournamentStage string `protobuf:"bytes,9,opt,name=tournament_stage,json=tournamentStage,proto3" json:"tournament_stage,omitempty"`
+	Status          Status `protobuf:"bytes,10,opt,name=status,proto3" json:"status"`
+	// time_ts is the kick-off time in unix milliseconds.
+	TimeTs int64 `protobuf:"varint,11,opt,name=time_ts,json=timeTs,proto3" json:"time_ts,omitempty"`
+	// finalized is true once the result survived the dispute window.
+	Finalized bool `protobuf:"varint,12,opt,name=finalized,proto3" json:"finalized,omitempty"`
+}
+
+func (m *Match) Reset()         { *m = Match{} }
+func (m *Match) String() string { return proto.CompactTextString(m) }
+func (*Match) ProtoMessage()    {}
+func (*Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c311dde4220f124, []int{4}
+}
+func (m *Match) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Match.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Match.Merge(m, src)
+}
+func (m *Match) XXX_Size() int {
+	return m.Size()
+}
+func (m *Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Match proto.InternalMessageInfo
+
+func (m *Match) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Match) GetLeagueId() int64 {
+	if m != nil {
+		return m.LeagueId
+	}
+	return 0
+}
+
+func (m *Match) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *Match) GetHome() Team {
+	if m != nil {
+		return m.Home
+	}
+	return Team{}
+}
+
+func (m *Match) GetAway() Team {
+	if m != nil {
+		return m.Away
+	}
+	return Team{}
+}
+
+func (m *Match) GetHomeScore() int64 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *Match) GetAwayScore() int64 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *Match) GetStatusId() int32 {
+	if m != nil {
+		return m.StatusId
+	}
+	return 0
+}
+
+func (m *Match) GetTournamentStage() string {
+	if m != nil {
+		return m.TournamentStage
+	}
+	return ""
+}
+
+func (m *Match) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status{}
+}
+
+func (m *Match) GetTimeTs() int64 {
+	if m != nil {
+		return m.TimeTs
+	}
+	return 0
+}
+
+func (m *Match) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("futchain.futchain.v1.MatchStatusFilter", MatchStatusFilter_name, MatchStatusFilter_value)
+	proto.RegisterType((*League)(nil), "futchain.futchain.v1.League")
+	proto.RegisterType((*Team)(nil), "futchain.futchain.v1.Team")
+	proto.RegisterType((*LiveTime)(nil), "futchain.futchain.v1.LiveTime")
+	proto.RegisterType((*Status)(nil), "futchain.futchain.v1.Status")
+	proto.RegisterType((*Match)(nil), "futchain.futchain.v1.Match")
+}
+
+func init() { proto.RegisterFile("futchain/futchain/v1/match.proto", fileDescriptor_3c311dde4220f124) }
+
+var fileDescriptor_3c311dde4220f124 = []byte{
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0xf5, 0x49, 0x6e, 0x92, 0x56, 0x59, 0x18, 0x08, 0x2b, 0xdb, 0xb2, 0xaa, 0x5e, 0xdc,
+	0x14, 0x90, 0x90, 0xb6, 0xa7, 0xde, 0x64, 0x5b, 0x76, 0x08, 0xd0, 0x6a, 0x41, 0x31, 0x45, 0x91,
+	0x0b, 0xb1, 0x26, 0x57, 0xd4, 0x02, 0x24, 0x57, 0x20, 0x97, 0x8e, 0xd5, 0x5f, 0xd0, 0x63, 0xee,
+	0x3d, 0xb6, 0x87, 0x5e, 0x0a, 0xf4, 0x67, 0xe4, 0x98, 0x63, 0x4f, 0x69, 0x61, 0x1f, 0xfa, 0x37,
+	0x8a, 0x99, 0xa5, 0xe4, 0x02, 0x55, 0x80, 0x5c, 0x8c, 0x99, 0xf7, 0x66, 0x96, 0x6f, 0x66, 0x9e,
+	0x45, 0x06, 0x8b, 0x52, 0x85, 0x4b, 0x26, 0xb2, 0xf1, 0x36, 0xb8, 0x7e, 0x36, 0x4e, 0x99, 0x0a,
+	0x97, 0xa3, 0x55, 0x2e, 0x95, 0xa4, 0x7b, 0x1b, 0x62, 0xb4, 0x0d, 0xae, 0x9f, 0xf5, 0x1e, 0xb3,
+	0x54, 0x64, 0x72, 0x8c, 0x7f, 0x75, 0x61, 0x6f, 0x2f, 0x96, 0xb1, 0xc4, 0x70, 0x0c, 0x51, 0x85,
+	0x1e, 0xc5, 0x52, 0xc6, 0x09, 0x1f, 0x63, 0x76, 0x55, 0x2e, 0xc6, 0x4a, 0xa4, 0xbc, 0x50, 0x2c,
+	0x5d, 0xe9, 0x82, 0xe1, 0xcf, 0x06, 0x69, 0xbb, 0x9c, 0xc5, 0x25, 0xa7, 0x1f, 0x91, 0xba, 0x88,
+	0x6c, 0x63, 0x60, 0x1c, 0x37, 0xbc, 0xba, 0x88, 0x28, 0x25, 0xcd, 0x8c, 0xa5, 0xdc, 0xae, 0x0f,
+	0x8c, 0x63, 0xcb, 0xc3, 0x98, 0x1e, 0x12, 0x12, 0xe7, 0xb2, 0x5c, 0x05, 0xc8, 0x34, 0x90, 0xb1,
+	0x10, 0x99, 0x01, 0xfd, 0x09, 0x31, 0x45, 0x11, 0x60, 0x6e, 0x37, 0x07, 0xc6, 0xb1, 0xe9, 0x75,
+	0x44, 0x71, 0x01, 0x29, 0xdd, 0x23, 0xad, 0x30, 0x94, 0x11, 0xb7, 0x5b, 0xd8, 0xa4, 0x13, 0x78,
+	0x6f, 0x95, 0x8b, 0x94, 0xe5, 0xeb, 0x40, 0x44, 0x76, 0x1b, 0xbf, 0x6d, 0x55, 0x88, 0x13, 0x0d,
+	0x2f, 0x48, 0xd3, 0xe7, 0x2c, 0xfd, 0x20, 0x69, 0xfb, 0xc4, 0x4a, 0x64, 0x16, 0xff, 0x57, 0x99,
+	0x09, 0x00, 0x08, 0x1b, 0xfe, 0x40, 0x4c, 0x57, 0x5c, 0x73, 0x5f, 0xa4, 0x1c, 0x9a, 0x01, 0xc7,
+	0xe7, 0x2c, 0x0f, 0x63, 0x10, 0x9e, 0xb2, 0x9b, 0x00, 0xb6, 0x83, 0x8f, 0xb6, 0xbc, 0x4e, 0xca,
+	0x6e, 0xb0, 0xfc, 0x90, 0x10, 0x16, 0x45, 0x3c, 0xd2, 0x64, 0x03, 0x49, 0x0b, 0x11, 0xa0, 0x87,
+	0xbf, 0xd6, 0x49, 0x7b, 0xae, 0x98, 0x2a, 0x0b, 0x7a, 0x46, 0xcc, 0x52, 0x85, 0xba, 0x0e, 0x1e,
+	0x7f, 0xf0, 0x65, 0x6f, 0xa4, 0xf7, 0x3f, 0xda, 0xec, 0x7f, 0xe4, 0x6f, 0xf6, 0x7f, 0xf2, 0xe8,
+	0xcd, 0xbb, 0xa3, 0xda, 0xeb, 0xbf, 0x8e, 0x8c, 0xdf, 0xfe, 0xf9, 0xe3, 0xa9, 0xe1, 0x75, 0x4a,
+	0x15, 0xe2, 0xf7, 0x3e, 0x23, 0x8f, 0x56, 0x3c, 0x17, 0x32, 0x0a, 0x12, 0x9e, 0xc5, 0x6a, 0x59,
+	0xe9, 0x79, 0xa8, 0x41, 0x17, 0x31, 0x6a, 0x93, 0x4e, 0xa1, 0x58, 0xae, 0x78, 0x84, 0x8a, 0x4c,
+	0x6f, 0x93, 0xd2, 0x03, 0x62, 0x85, 0x2c, 0x0b, 0x79, 0x92, 0xf0, 0xa8, 0xba, 0xc1, 0x3d, 0x40,
+	0x7b, 0xc4, 0x5c, 0x88, 0x4c, 0x14, 0x4b, 0x1e, 0xe1, 0x21, 0x4c, 0x6f, 0x9b, 0xc3, 0x9b, 0x32,
+	0x8b, 0xa5, 0xc8, 0x62, 0x3c, 0x84, 0xe9, 0x6d, 0x52, 0x3a, 0x21, 0x56, 0x22, 0xae, 0xb9, 0x9e,
+	0xac, 0x83, 0x93, 0xf5, 0x47, 0xbb, 0x8c, 0x39, 0xda, 0x2c, 0xf9, 0xa4, 0x09, 0xd3, 0x79, 0x66,
+	0x52, 0xe5, 0xc3, 0xdf, 0x1b, 0xa4, 0x75, 0x09, 0xbe, 0xfe, 0xdf, 0x2d, 0xe1, 0x6e, 0x68, 0x40,
+	0x70, 0x40, 0x1d, 0x61, 0x53, 0x03, 0x0e, 0x1e, 0x7a, 0xbb, 0x76, 0xcb, 0xc3, 0x98, 0x7e, 0x4d,
+	0x9a, 0x4b, 0x99, 0x72, 0x1c, 0x0e, 0x56, 0xbc, 0x53, 0x08, 0xd8, 0xa6, 0x12, 0x81, 0xd5, 0xd0,
+	0xc5, 0x5e, 0xb1, 0x35, 0x4e, 0xfd, 0x41, 0x5d, 0x50, 0x0d, 0xc7, 0x87, 0xee, 0xa0, 0x08, 0x65,
+	0xce, 0x37, 0xfe, 0x04, 0x64, 0x0e, 0x00, 0x7a, 0xe3, 0x15, 0x5b, 0x57, 0x74, 0x47, 0xd3, 0x80,
+	0x68, 0x7a, 0x9f, 0x58, 0x05, 0x5a, 0x03, 0x46, 0x33, 0xf1, 0x8c, 0xa6, 0x06, 0x9c, 0x88, 0x7e,
+	0x4e, 0xba, 0x4a, 0x96, 0x39, 0xd8, 0x35, 0x53, 0x41, 0xa1, 0x58, 0xcc, 0x6d, 0x0b, 0xc7, 0xfc,
+	0xf8, 0x1e, 0x9f, 0x03, 0x4c, 0xbf, 0x21, 0x6d, 0xdd, 0x66, 0x13, 0x54, 0x7f, 0xb0, 0x5b, 0xbd,
+	0xb6, 0x61, 0xa5, 0xbf, 0xea, 0xa0, 0x4f, 0x48, 0x07, 0xb6, 0x16, 0xa8, 0xc2, 0x7e, 0x80, 0xfa,
+	0xda, 0x90, 0xfa, 0x05, 0x18, 0x65, 0x21, 0x32, 0x96, 0x88, 0x1f, 0x79, 0x64, 0x3f, 0xd4, 0x46,
+	0xd9, 0x02, 0x4f, 0xdf, 0x19, 0xe4, 0x31, 0xde, 0x4b, 0x3f, 0x7a, 0x2e, 0x12, 0xc5, 0x73, 0xba,
+	0x4f, 0x9e, 0x5c, 0x4e, 0xfc, 0xd3, 0xe7, 0xc1, 0xdc, 0x9f, 0xf8, 0x2f, 0xe6, 0xc1, 0xb9, 0xe3,
+	0xfa, 0x53, 0x2f, 0x98, 0xb8, 0x6e, 0xb7, 0x46, 0x07, 0xe4, 0x60, 0x17, 0xf9, 0xe2, 0xbb, 0xd3,
+	0x6f, 0x2f, 0x9d, 0xd9, 0x45, 0xd7, 0xa0, 0x07, 0xc4, 0xde, 0x55, 0xe1, 0x3a, 0xdf, 0x4f, 0xbb,
+	0xf5, 0xf7, 0xf5, 0x9f, 0x3b, 0x33, 0x67, 0xfe, 0x7c, 0x7a, 0xd6, 0x6d, 0xd0, 0x4f, 0xc9, 0xe1,
+	0xae, 0x8a, 0xd3, 0xc9, 0xec, 0x74, 0xea, 0xba, 0xd3, 0xb3, 0x6e, 0xf3, 0x7d, 0x25, 0xe7, 0xce,
+	0x6c, 0xe2, 0x3a, 0x2f, 0xa7, 0x67, 0xdd, 0x56, 0xaf, 0xf9, 0xd3, 0x2f, 0xfd, 0xda, 0xc9, 0xf4,
+	0xcd, 0x6d, 0xdf, 0x78, 0x7b, 0xdb, 0x37, 0xfe, 0xbe, 0xed, 0x1b, 0xaf, 0xef, 0xfa, 0xb5, 0xb7,
+	0x77, 0xfd, 0xda, 0x9f, 0x77, 0xfd, 0xda, 0xcb, 0x2f, 0x62, 0xa1, 0x96, 0xe5, 0xd5, 0x28, 0x94,
+	0xe9, 0x38, 0x67, 0x62, 0xb1, 0x5a, 0xdf, 0xff, 0x3a, 0xdf, 0xdc, 0x87, 0x6a, 0xbd, 0xe2, 0xc5,
+	0x55, 0x1b, 0xff, 0xb3, 0xbf, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xee, 0xb3, 0x71, 0xca,
+	0x05, 0x00, 0x00,
+}
+
+func (m *League) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *League) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *League) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrimaryId != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.PrimaryId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Ccode) > 0 {
+		i -= len(m.Ccode)
+		copy(dAtA[i:], m.Ccode)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.Ccode)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.IsGroup {
+		i--
+		if m.IsGroup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Team) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Team) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Team) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LongName) > 0 {
+		i -= len(m.LongName)
+		copy(dAtA[i:], m.LongName)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.LongName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiveTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiveTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiveTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddedTime != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.AddedTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTime != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.MaxTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Long) > 0 {
+		i -= len(m.Long)
+		copy(dAtA[i:], m.Long)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.Long)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiveTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Ongoing {
+		i--
+		if m.Ongoing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PeriodLength != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.PeriodLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UtcTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UtcTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMatch(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Match) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Match) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Match) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TimeTs != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.TimeTs))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.TournamentStage) > 0 {
+		i -= len(m.TournamentStage)
+		copy(dAtA[i:], m.TournamentStage)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.TournamentStage)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StatusId != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.StatusId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Away.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Home.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintMatch(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LeagueId != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.LeagueId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintMatch(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *League) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMatch(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	if m.IsGroup {
+		n += 2
+	}
+	l = len(m.Ccode)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	if m.PrimaryId != 0 {
+		n += 1 + sovMatch(uint64(m.PrimaryId))
+	}
+	return n
+}
+
+func (m *Team) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMatch(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	l = len(m.LongName)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	return n
+}
+
+func (m *LiveTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Long)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	if m.MaxTime != 0 {
+		n += 1 + sovMatch(uint64(m.MaxTime))
+	}
+	if m.AddedTime != 0 {
+		n += 1 + sovMatch(uint64(m.AddedTime))
+	}
+	return n
+}
+
+func (m *Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UtcTime)
+	n += 1 + l + sovMatch(uint64(l))
+	if m.PeriodLength != 0 {
+		n += 1 + sovMatch(uint64(m.PeriodLength))
+	}
+	if m.Started {
+		n += 2
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	if m.Finished {
+		n += 2
+	}
+	if m.Ongoing {
+		n += 2
+	}
+	l = m.LiveTime.Size()
+	n += 1 + l + sovMatch(uint64(l))
+	return n
+}
+
+func (m *Match) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMatch(uint64(m.Id))
+	}
+	if m.LeagueId != 0 {
+		n += 1 + sovMatch(uint64(m.LeagueId))
+	}
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	l = m.Home.Size()
+	n += 1 + l + sovMatch(uint64(l))
+	l = m.Away.Size()
+	n += 1 + l + sovMatch(uint64(l))
+	if m.HomeScore != 0 {
+		n += 1 + sovMatch(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovMatch(uint64(m.AwayScore))
+	}
+	if m.StatusId != 0 {
+		n += 1 + sovMatch(uint64(m.StatusId))
+	}
+	l = len(m.TournamentStage)
+	if l > 0 {
+		n += 1 + l + sovMatch(uint64(l))
+	}
+	l = m.Status.Size()
+	n += 1 + l + sovMatch(uint64(l))
+	if m.TimeTs != 0 {
+		n += 1 + sovMatch(uint64(m.TimeTs))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func sovMatch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMatch(x uint64) (n int) {
+	return sovMatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *League) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: League: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: League: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsGroup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsGroup = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ccode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ccode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryId", wireType)
+			}
+			m.PrimaryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Team) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Team: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Team: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LongName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiveTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiveTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiveTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Long", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Long = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTime", wireType)
+			}
+			m.MaxTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTime |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedTime", wireType)
+			}
+			m.AddedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedTime |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtcTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UtcTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodLength", wireType)
+			}
+			m.PeriodLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finished = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ongoing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ongoing = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiveTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Match) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Match: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Match: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeagueId", wireType)
+			}
+			m.LeagueId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeagueId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Home.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Away", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Away.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
+			}
+			m.HomeScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
+			}
+			m.AwayScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusId", wireType)
+			}
+			m.StatusId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentStage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentStage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeTs", wireType)
+			}
+			m.TimeTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeTs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMatch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMatch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMatch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMatch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMatch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMatch = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// MatchResultSize is the size of an encoded match result.
+const MatchResultSize = 17
+
+// Flags of an encoded match result.
+const (
+	MatchResultStarted uint8 = 1 << iota
+	MatchResultFinished
+	MatchResultCancelled
+	MatchResultFinalized
+)
+
+// MatchResult is the result record of a match, stored next to the match under MatchResultsKey so that it
+// can be proven against the app hash and decoded by light verifiers on other chains, such as the
+// FutchainProofVerifier solidity library.
+//
+// It is encoded as the big endian match id (8 bytes), home score (4 bytes), away score (4 bytes), and a
+// byte of MatchResult flags.
+type MatchResult struct {
+	MatchID   uint64
+	HomeScore uint32
+	AwayScore uint32
+	Started   bool
+	Finished  bool
+	Cancelled bool
+	Finalized bool
+}
+
+// Bytes returns the encoding of the result.
+func (r MatchResult) Bytes() []byte {
+	bz := make([]byte, MatchResultSize)
+	binary.BigEndian.PutUint64(bz[0:8], r.MatchID)
+	binary.BigEndian.PutUint32(bz[8:12], r.HomeScore)
+	binary.BigEndian.PutUint32(bz[12:16], r.AwayScore)
+
+	var flags uint8
+	if r.Started {
+		flags |= MatchResultStarted
+	}
+	if r.Finished {
+		flags |= MatchResultFinished
+	}
+	if r.Cancelled {
+		flags |= MatchResultCancelled
+	}
+	if r.Finalized {
+		flags |= MatchResultFinalized
+	}
+	bz[16] = flags
+	return bz
+}
+
+// ParseMatchResult decodes an encoded match result.
+func ParseMatchResult(bz []byte) (MatchResult, error) {
+	if len(bz) != MatchResultSize {
+		return MatchResult{}, fmt.Errorf("match result must be %d bytes, got %d", MatchResultSize, len(bz))
+	}
+	flags := bz[16]
+	return MatchResult{
+		MatchID:   binary.BigEndian.Uint64(bz[0:8]),
+		HomeScore: binary.BigEndian.Uint32(bz[8:12]),
+		AwayScore: binary.BigEndian.Uint32(bz[12:16]),
+		Started:   flags&MatchResultStarted != 0,
+		Finished:  flags&MatchResultFinished != 0,
+		Cancelled: flags&MatchResultCancelled != 0,
+		Finalized: flags&MatchResultFinalized != 0,
+	}, nil
+}
+
+// MatchResultProofPath is the ABCI query path of the module store keys, which proves the result records
+// against the app hash when queried with prove set.
+const MatchResultProofPath = "/store/" + StoreKey + "/key"
+
+// MatchResultKey returns the store key of the result record of a match.
+func MatchResultKey(matchID uint64) []byte {
+	return append(append([]byte{}, MatchResultsKey...), binary.BigEndian.AppendUint64(nil, matchID)...)
+}
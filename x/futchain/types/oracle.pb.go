@@ -0,0 +1,838 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: futchain/futchain/v1/oracle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReportOutcome is how a validator's oracle report compared to the finalized
+// result of a match.
+type ReportOutcome int32
+
+const (
+	REPORT_OUTCOME_UNSPECIFIED ReportOutcome = 0
+	// REPORT_OUTCOME_VALID matched the finalized result.
+	REPORT_OUTCOME_VALID ReportOutcome = 1
+	// REPORT_OUTCOME_MISSED was not submitted before finalization.
+	REPORT_OUTCOME_MISSED ReportOutcome = 2
+	// REPORT_OUTCOME_DEVIATED differed from the finalized result.
+	REPORT_OUTCOME_DEVIATED ReportOutcome = 3
+)
+
+var ReportOutcome_name = map[int32]string{
+	0: "REPORT_OUTCOME_UNSPECIFIED",
+	1: "REPORT_OUTCOME_VALID",
+	2: "REPORT_OUTCOME_MISSED",
+	3: "REPORT_OUTCOME_DEVIATED",
+}
+
+var ReportOutcome_value = map[string]int32{
+	"REPORT_OUTCOME_UNSPECIFIED": 0,
+	"REPORT_OUTCOME_VALID":       1,
+	"REPORT_OUTCOME_MISSED":      2,
+	"REPORT_OUTCOME_DEVIATED":    3,
+}
+
+func (x ReportOutcome) String() string {
+	return proto.EnumName(ReportOutcome_name, int32(x))
+}
+
+func (ReportOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8b601820f7378fa4, []int{0}
+}
+
+// OracleReport is the result of a match as reported by a validator.
+type OracleReport struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	MatchId          int64  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	HomeScore        int64  `protobuf:"varint,3,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore        int64  `protobuf:"varint,4,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Cancelled        bool   `protobuf:"varint,5,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// height is the block height the report was last submitted at.
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *OracleReport) Reset()         { *m = OracleReport{} }
+func (m *OracleReport) String() string { return proto.CompactTextString(m) }
+func (*OracleReport) ProtoMessage()    {}
+func (*OracleReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b601820f7378fa4, []int{0}
+}
+func (m *OracleReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleReport.Merge(m, src)
+}
+func (m *OracleReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleReport proto.InternalMessageInfo
+
+func (m *OracleReport) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *OracleReport) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *OracleReport) GetHomeScore() int64 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *OracleReport) GetAwayScore() int64 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *OracleReport) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *OracleReport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ValidatorOracleInfo tracks the oracle reports of a validator over the
+// sliding window of its last oracle_report_window finalized matches.
+type ValidatorOracleInfo struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// index_offset is the number of finalized matches the validator was
+	// evaluated on since its counters were last reset.
+	IndexOffset uint64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_counter is the number of missed reports in the window.
+	MissedCounter uint64 `protobuf:"varint,3,opt,name=missed_counter,json=missedCounter,proto3" json:"missed_counter,omitempty"`
+	// deviation_counter is the number of deviating reports in the window.
+	DeviationCounter uint64 `protobuf:"varint,4,opt,name=deviation_counter,json=deviationCounter,proto3" json:"deviation_counter,omitempty"`
+}
+
+func (m *ValidatorOracleInfo) Reset()         { *m = ValidatorOracleInfo{} }
+func (m *ValidatorOracleInfo) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOracleInfo) ProtoMessage()    {}
+func (*ValidatorOracleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b601820f7378fa4, []int{1}
+}
+func (m *ValidatorOracleInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOracleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOracleInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorOracleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOracleInfo.Merge(m, src)
+}
+func (m *ValidatorOracleInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOracleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOracleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOracleInfo proto.InternalMessageInfo
+
+func (m *ValidatorOracleInfo) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorOracleInfo) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ValidatorOracleInfo) GetMissedCounter() uint64 {
+	if m != nil {
+		return m.MissedCounter
+	}
+	return 0
+}
+
+func (m *ValidatorOracleInfo) GetDeviationCounter() uint64 {
+	if m != nil {
+		return m.DeviationCounter
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("futchain.futchain.v1.ReportOutcome", ReportOutcome_name, ReportOutcome_value)
+	proto.RegisterType((*OracleReport)(nil), "futchain.futchain.v1.OracleReport")
+	proto.RegisterType((*ValidatorOracleInfo)(nil), "futchain.futchain.v1.ValidatorOracleInfo")
+}
+
+func init() { proto.RegisterFile("futchain/futchain/v1/oracle.proto", fileDescriptor_8b601820f7378fa4) }
+
+var fileDescriptor_8b601820f7378fa4 = []byte{
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0xb5, 0x94, 0xd5, 0x6c, 0x28, 0x33, 0x05, 0xd2, 0xc2, 0xa2, 0x76, 0x12, 0x52,
+	0xc5, 0x44, 0xab, 0x89, 0x27, 0xe8, 0xda, 0x20, 0x45, 0x62, 0xcb, 0x94, 0x74, 0x3d, 0x70, 0x89,
+	0x3c, 0xc7, 0x49, 0x2c, 0x35, 0x71, 0x95, 0xb8, 0x61, 0x7d, 0x02, 0x38, 0xf2, 0x0e, 0xbc, 0x02,
+	0x0f, 0xc1, 0x71, 0xe2, 0xc4, 0x81, 0x03, 0x6a, 0xcf, 0xbc, 0x03, 0x8a, 0xdd, 0xa5, 0x52, 0xcf,
+	0xbb, 0x7d, 0xdf, 0xef, 0xf7, 0xb7, 0x95, 0x2f, 0xfe, 0x60, 0x37, 0x58, 0x08, 0x12, 0x61, 0x96,
+	0x0c, 0xca, 0x22, 0x3f, 0x1b, 0xf0, 0x14, 0x93, 0x19, 0xed, 0xcf, 0x53, 0x2e, 0x38, 0x6a, 0xde,
+	0x9b, 0x7e, 0x59, 0xe4, 0x67, 0xed, 0x16, 0xe1, 0x59, 0xcc, 0x33, 0x4f, 0x66, 0x06, 0xaa, 0x51,
+	0x07, 0xda, 0xcd, 0x90, 0x87, 0x5c, 0xf1, 0xa2, 0x52, 0xf4, 0xe4, 0x1f, 0x80, 0x07, 0xb6, 0xbc,
+	0xd7, 0xa1, 0x73, 0x9e, 0x0a, 0x74, 0x09, 0x8f, 0x72, 0x3c, 0x63, 0x3e, 0x16, 0x3c, 0xf5, 0xb0,
+	0xef, 0xa7, 0x34, 0xcb, 0x74, 0xd0, 0x01, 0xbd, 0xc6, 0x79, 0xf7, 0xd7, 0x8f, 0x77, 0xc7, 0x9b,
+	0x3b, 0xa7, 0xf7, 0x99, 0xa1, 0x8a, 0xb8, 0x22, 0x65, 0x49, 0xe8, 0x68, 0xf9, 0x0e, 0x47, 0x2d,
+	0xb8, 0x1f, 0x63, 0x41, 0x22, 0x8f, 0xf9, 0xfa, 0x5e, 0x07, 0xf4, 0xaa, 0xce, 0x63, 0xd9, 0x5b,
+	0x3e, 0x3a, 0x86, 0x30, 0xe2, 0x31, 0xf5, 0x32, 0xc2, 0x53, 0xaa, 0x57, 0xa5, 0x6c, 0x14, 0xc4,
+	0x2d, 0x40, 0xa1, 0xf1, 0x67, 0xbc, 0xdc, 0xe8, 0x9a, 0xd2, 0x05, 0x51, 0xfa, 0x35, 0x6c, 0x10,
+	0x9c, 0x10, 0x3a, 0x9b, 0x51, 0x5f, 0x7f, 0xd4, 0x01, 0xbd, 0x7d, 0x67, 0x0b, 0xd0, 0x0b, 0x58,
+	0x8f, 0x28, 0x0b, 0x23, 0xa1, 0xd7, 0xe5, 0xc1, 0x4d, 0x77, 0xf2, 0x07, 0xc0, 0x67, 0xe5, 0xb7,
+	0xab, 0xc1, 0xad, 0x24, 0xe0, 0x0f, 0x3e, 0x76, 0x17, 0x1e, 0xb0, 0xc4, 0xa7, 0xb7, 0x1e, 0x0f,
+	0x82, 0x8c, 0x0a, 0x39, 0x7a, 0xcd, 0x79, 0x22, 0x99, 0x2d, 0x11, 0x7a, 0x03, 0x9f, 0xc6, 0x2c,
+	0xcb, 0xa8, 0xef, 0x11, 0xbe, 0x48, 0x04, 0x4d, 0xe5, 0x2f, 0xa8, 0x39, 0x87, 0x8a, 0x8e, 0x14,
+	0x44, 0xa7, 0xf0, 0xc8, 0xa7, 0x39, 0xc3, 0x82, 0xf1, 0xa4, 0x4c, 0xd6, 0x64, 0x52, 0x2b, 0xc5,
+	0x26, 0xfc, 0xf6, 0x0b, 0x80, 0x87, 0xea, 0x21, 0xed, 0x85, 0x20, 0x3c, 0xa6, 0xc8, 0x80, 0x6d,
+	0xc7, 0xbc, 0xb2, 0x9d, 0x89, 0x67, 0x5f, 0x4f, 0x46, 0xf6, 0x85, 0xe9, 0x5d, 0x5f, 0xba, 0x57,
+	0xe6, 0xc8, 0xfa, 0x60, 0x99, 0x63, 0xad, 0x82, 0x74, 0xd8, 0xdc, 0xf1, 0xd3, 0xe1, 0x47, 0x6b,
+	0xac, 0x01, 0xd4, 0x82, 0xcf, 0x77, 0xcc, 0x85, 0xe5, 0xba, 0xe6, 0x58, 0xdb, 0x43, 0xaf, 0xe0,
+	0xcb, 0x1d, 0x35, 0x36, 0xa7, 0xd6, 0x70, 0x62, 0x8e, 0xb5, 0x6a, 0xbb, 0xf6, 0xf5, 0xbb, 0x51,
+	0x39, 0x37, 0x7f, 0xae, 0x0c, 0x70, 0xb7, 0x32, 0xc0, 0xdf, 0x95, 0x01, 0xbe, 0xad, 0x8d, 0xca,
+	0xdd, 0xda, 0xa8, 0xfc, 0x5e, 0x1b, 0x95, 0x4f, 0xa7, 0x21, 0x13, 0xd1, 0xe2, 0xa6, 0x4f, 0x78,
+	0x3c, 0x48, 0x31, 0x0b, 0xe6, 0xcb, 0xed, 0x96, 0xdf, 0x6e, 0x4b, 0xb1, 0x9c, 0xd3, 0xec, 0xa6,
+	0x2e, 0xd7, 0xf4, 0xfd, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x36, 0xf5, 0xde, 0xd4, 0x12, 0x03,
+	0x00, 0x00,
+}
+
+func (m *OracleReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOracleInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOracleInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOracleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviationCounter != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DeviationCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedCounter != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MissedCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OracleReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovOracle(uint64(m.MatchId))
+	}
+	if m.HomeScore != 0 {
+		n += 1 + sovOracle(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovOracle(uint64(m.AwayScore))
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovOracle(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ValidatorOracleInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovOracle(uint64(m.IndexOffset))
+	}
+	if m.MissedCounter != 0 {
+		n += 1 + sovOracle(uint64(m.MissedCounter))
+	}
+	if m.DeviationCounter != 0 {
+		n += 1 + sovOracle(uint64(m.DeviationCounter))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OracleReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
+			}
+			m.HomeScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
+			}
+			m.AwayScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOracleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOracleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOracleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCounter", wireType)
+			}
+			m.MissedCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationCounter", wireType)
+			}
+			m.DeviationCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOracle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOracle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOracle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOracle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOracle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOracle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOracle = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MatchOutcomes are the outcomes a match can be tokenized on.
+var MatchOutcomes = []uint64{OutcomeHome, OutcomeDraw, OutcomeAway}
+
+var outcomeNames = map[uint64]string{
+	OutcomeHome: "home",
+	OutcomeDraw: "draw",
+	OutcomeAway: "away",
+}
+
+// OutcomeDenom returns the bank denom of an outcome share of a match, e.g. futchain/match/42/home.
+func OutcomeDenom(matchID int64, outcome uint64) string {
+	return fmt.Sprintf("%s/match/%d/%s", ModuleName, matchID, outcomeNames[outcome])
+}
+
+// OutcomeTokenAddress returns the address of the ERC-20 precompile of an outcome share denom.
+func OutcomeTokenAddress(denom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(denom)))
+}
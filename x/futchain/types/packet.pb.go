@@ -0,0 +1,1510 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: futchain/futchain/v1/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FutchainPacketData is the data of the packets of the futchain IBC
+// application, JSON encoded on the wire.
+type FutchainPacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*FutchainPacketData_QueryMatch
+	//	*FutchainPacketData_Subscribe
+	//	*FutchainPacketData_MatchResult
+	Packet isFutchainPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *FutchainPacketData) Reset()         { *m = FutchainPacketData{} }
+func (m *FutchainPacketData) String() string { return proto.CompactTextString(m) }
+func (*FutchainPacketData) ProtoMessage()    {}
+func (*FutchainPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_395cf7ee192f6c76, []int{0}
+}
+func (m *FutchainPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FutchainPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FutchainPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FutchainPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FutchainPacketData.Merge(m, src)
+}
+func (m *FutchainPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *FutchainPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_FutchainPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FutchainPacketData proto.InternalMessageInfo
+
+type isFutchainPacketData_Packet interface {
+	isFutchainPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type FutchainPacketData_QueryMatch struct {
+	QueryMatch *QueryMatchPacketData `protobuf:"bytes,1,opt,name=query_match,json=queryMatch,proto3,oneof" json:"query_match,omitempty"`
+}
+type FutchainPacketData_Subscribe struct {
+	Subscribe *SubscribePacketData `protobuf:"bytes,2,opt,name=subscribe,proto3,oneof" json:"subscribe,omitempty"`
+}
+type FutchainPacketData_MatchResult struct {
+	MatchResult *MatchResultPacketData `protobuf:"bytes,3,opt,name=match_result,json=matchResult,proto3,oneof" json:"match_result,omitempty"`
+}
+
+func (*FutchainPacketData_QueryMatch) isFutchainPacketData_Packet()  {}
+func (*FutchainPacketData_Subscribe) isFutchainPacketData_Packet()   {}
+func (*FutchainPacketData_MatchResult) isFutchainPacketData_Packet() {}
+
+func (m *FutchainPacketData) GetPacket() isFutchainPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *FutchainPacketData) GetQueryMatch() *QueryMatchPacketData {
+	if x, ok := m.GetPacket().(*FutchainPacketData_QueryMatch); ok {
+		return x.QueryMatch
+	}
+	return nil
+}
+
+func (m *FutchainPacketData) GetSubscribe() *SubscribePacketData {
+	if x, ok := m.GetPacket().(*FutchainPacketData_Subscribe); ok {
+		return x.Subscribe
+	}
+	return nil
+}
+
+func (m *FutchainPacketData) GetMatchResult() *MatchResultPacketData {
+	if x, ok := m.GetPacket().(*FutchainPacketData_MatchResult); ok {
+		return x.MatchResult
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*FutchainPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*FutchainPacketData_QueryMatch)(nil),
+		(*FutchainPacketData_Subscribe)(nil),
+		(*FutchainPacketData_MatchResult)(nil),
+	}
+}
+
+// QueryMatchPacketData is sent by a counterparty chain to query the current
+// result of a match. It is acknowledged with a MatchResultPacketData.
+type QueryMatchPacketData struct {
+	MatchId int64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+}
+
+func (m *QueryMatchPacketData) Reset()         { *m = QueryMatchPacketData{} }
+func (m *QueryMatchPacketData) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchPacketData) ProtoMessage()    {}
+func (*QueryMatchPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_395cf7ee192f6c76, []int{1}
+}
+func (m *QueryMatchPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMatchPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchPacketData.Merge(m, src)
+}
+func (m *QueryMatchPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMatchPacketData proto.InternalMessageInfo
+
+func (m *QueryMatchPacketData) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+// SubscribePacketData is sent by a counterparty chain to receive the result
+// of a match when it finalizes. It is acknowledged with the current
+// MatchResultPacketData; a match that is already finalized is not subscribed
+// to.
+type SubscribePacketData struct {
+	MatchId int64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+}
+
+func (m *SubscribePacketData) Reset()         { *m = SubscribePacketData{} }
+func (m *SubscribePacketData) String() string { return proto.CompactTextString(m) }
+func (*SubscribePacketData) ProtoMessage()    {}
+func (*SubscribePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_395cf7ee192f6c76, []int{2}
+}
+func (m *SubscribePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribePacketData.Merge(m, src)
+}
+func (m *SubscribePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribePacketData proto.InternalMessageInfo
+
+func (m *SubscribePacketData) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+// MatchResultPacketData is the result of a match. It is sent on the channels
+// subscribed to the match when it finalizes, and acknowledges query and
+// subscribe packets.
+type MatchResultPacketData struct {
+	MatchId   int64  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	LeagueId  int64  `protobuf:"varint,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	HomeId    int64  `protobuf:"varint,3,opt,name=home_id,json=homeId,proto3" json:"home_id,omitempty"`
+	HomeName  string `protobuf:"bytes,4,opt,name=home_name,json=homeName,proto3" json:"home_name,omitempty"`
+	AwayId    int64  `protobuf:"varint,5,opt,name=away_id,json=awayId,proto3" json:"away_id,omitempty"`
+	AwayName  string `protobuf:"bytes,6,opt,name=away_name,json=awayName,proto3" json:"away_name,omitempty"`
+	HomeScore int64  `protobuf:"varint,7,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore int64  `protobuf:"varint,8,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Started   bool   `protobuf:"varint,9,opt,name=started,proto3" json:"started,omitempty"`
+	Finished  bool   `protobuf:"varint,10,opt,name=finished,proto3" json:"finished,omitempty"`
+	Cancelled bool   `protobuf:"varint,11,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Finalized bool   `protobuf:"varint,12,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// height is the FutChain block height of the result.
+	Height int64 `protobuf:"varint,13,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MatchResultPacketData) Reset()         { *m = MatchResultPacketData{} }
+func (m *MatchResultPacketData) String() string { return proto.CompactTextString(m) }
+func (*MatchResultPacketData) ProtoMessage()    {}
+func (*MatchResultPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_395cf7ee192f6c76, []int{3}
+}
+func (m *MatchResultPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatchResultPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MatchResultPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MatchResultPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchResultPacketData.Merge(m, src)
+}
+func (m *MatchResultPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatchResultPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchResultPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchResultPacketData proto.InternalMessageInfo
+
+func (m *MatchResultPacketData) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *MatchResultPacketData) GetLeagueId() int64 {
+	if m != nil {
+		return m.LeagueId
+	}
+	return 0
+}
+
+func (m *MatchResultPacketData) GetHomeId() int64 {
+	if m != nil {
+		return m.HomeId
+	}
+	return 0
+}
+
+func (m *MatchResultPacketData) GetHomeName() string {
+	if m != nil {
+		return m.HomeName
+	}
+	return ""
+}
+
+func (m *MatchResultPacketData) GetAwayId() int64 {
+	if m != nil {
+		return m.AwayId
+	}
+	return 0
+}
+
+func (m *MatchResultPacketData) GetAwayName() string {
+	if m != nil {
+		return m.AwayName
+	}
+	return ""
+}
+
+func (m *MatchResultPacketData) GetHomeScore() int64 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *MatchResultPacketData) GetAwayScore() int64 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *MatchResultPacketData) GetStarted() bool {
+	if m != nil {
+		return m.Started
+	}
+	return false
+}
+
+func (m *MatchResultPacketData) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *MatchResultPacketData) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *MatchResultPacketData) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func (m *MatchResultPacketData) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FutchainPacketData)(nil), "futchain.futchain.v1.FutchainPacketData")
+	proto.RegisterType((*QueryMatchPacketData)(nil), "futchain.futchain.v1.QueryMatchPacketData")
+	proto.RegisterType((*SubscribePacketData)(nil), "futchain.futchain.v1.SubscribePacketData")
+	proto.RegisterType((*MatchResultPacketData)(nil), "futchain.futchain.v1.MatchResultPacketData")
+}
+
+func init() { proto.RegisterFile("futchain/futchain/v1/packet.proto", fileDescriptor_395cf7ee192f6c76) }
+
+var fileDescriptor_395cf7ee192f6c76 = []byte{
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0xe3, 0x04, 0x1c, 0xfb, 0xa6, 0x6c, 0x86, 0x02, 0xc3, 0x9f, 0x15, 0xb2, 0x0a, 0x54,
+	0x72, 0x08, 0xbc, 0x41, 0x05, 0x88, 0x2c, 0x8a, 0x8a, 0xbb, 0x63, 0x53, 0x4d, 0xec, 0x9b, 0x78,
+	0x84, 0x7f, 0x52, 0x7b, 0x5c, 0x30, 0x5b, 0x76, 0xac, 0x78, 0x2c, 0x96, 0x5d, 0xb2, 0x44, 0xc9,
+	0x8b, 0xa0, 0xb9, 0xd3, 0xd8, 0xaa, 0x64, 0x75, 0x37, 0xe7, 0x7c, 0xf7, 0x9c, 0x19, 0x5f, 0xc9,
+	0xf0, 0x62, 0x55, 0xa9, 0x30, 0x16, 0x32, 0x9b, 0x35, 0x87, 0xcb, 0xf9, 0x6c, 0x23, 0xc2, 0xaf,
+	0xa8, 0xfc, 0x4d, 0x91, 0xab, 0x9c, 0x1d, 0xee, 0x89, 0xdf, 0x1c, 0x2e, 0xe7, 0x93, 0x5f, 0x7d,
+	0x60, 0x1f, 0xae, 0xf5, 0x29, 0x8d, 0xbf, 0x13, 0x4a, 0xb0, 0x13, 0x18, 0x5d, 0x54, 0x58, 0xd4,
+	0xe7, 0xa9, 0x50, 0x61, 0xcc, 0xad, 0xb1, 0x35, 0x1d, 0xbd, 0x79, 0xe5, 0x77, 0x55, 0xf8, 0x9f,
+	0xf5, 0xe0, 0x89, 0x9e, 0x6b, 0x0b, 0x3e, 0xf6, 0x02, 0xb8, 0x68, 0x7c, 0xb6, 0x00, 0xb7, 0xac,
+	0x96, 0x65, 0x58, 0xc8, 0x25, 0xf2, 0x3e, 0x95, 0xbd, 0xec, 0x2e, 0x3b, 0xdb, 0x8f, 0xdd, 0xe8,
+	0x6a, 0xd3, 0xec, 0x14, 0x0e, 0xe8, 0x4d, 0xe7, 0x05, 0x96, 0x55, 0xa2, 0xf8, 0x80, 0xda, 0x8e,
+	0xba, 0xdb, 0xe8, 0xf6, 0x80, 0x06, 0x6f, 0xf4, 0x8d, 0xd2, 0x16, 0x1c, 0x3b, 0x60, 0x9b, 0x45,
+	0x4d, 0xe6, 0x70, 0xd8, 0xf5, 0x31, 0xec, 0x31, 0x38, 0xe6, 0x4e, 0x19, 0xd1, 0x2a, 0x06, 0xc1,
+	0x90, 0xf4, 0x22, 0x9a, 0xbc, 0x86, 0xfb, 0x1d, 0x4f, 0xbe, 0x2d, 0xf1, 0x73, 0x00, 0x0f, 0x3a,
+	0xdf, 0x75, 0x4b, 0x88, 0x3d, 0x05, 0x37, 0x41, 0xb1, 0xae, 0x50, 0xb3, 0x3e, 0x31, 0xc7, 0x18,
+	0x8b, 0x88, 0x3d, 0x82, 0x61, 0x9c, 0xa7, 0x84, 0x06, 0x84, 0x6c, 0x2d, 0x4d, 0x8a, 0x40, 0x26,
+	0x52, 0xe4, 0x77, 0xc6, 0xd6, 0xd4, 0x0d, 0x1c, 0x6d, 0x7c, 0x12, 0x29, 0xea, 0x94, 0xf8, 0x26,
+	0x6a, 0x9d, 0xba, 0x6b, 0x52, 0x5a, 0x9a, 0x14, 0x01, 0x4a, 0xd9, 0x26, 0xa5, 0x0d, 0x4a, 0x3d,
+	0x07, 0xa0, 0xca, 0x32, 0xcc, 0x0b, 0xe4, 0x43, 0x0a, 0xd2, 0x25, 0x67, 0xda, 0xd0, 0x98, 0xb2,
+	0x06, 0x3b, 0x06, 0x6b, 0xc7, 0x60, 0x0e, 0xc3, 0x52, 0x89, 0x42, 0x61, 0xc4, 0xdd, 0xb1, 0x35,
+	0x75, 0x82, 0xbd, 0x64, 0x4f, 0xc0, 0x59, 0xc9, 0x4c, 0x96, 0x31, 0x46, 0x1c, 0x08, 0x35, 0x9a,
+	0x3d, 0x03, 0x37, 0x14, 0x59, 0x88, 0x49, 0x82, 0x11, 0x1f, 0x11, 0x6c, 0x0d, 0x4d, 0x57, 0x32,
+	0x13, 0x89, 0xfc, 0x81, 0x11, 0x3f, 0x30, 0xb4, 0x31, 0xd8, 0x43, 0xb0, 0x63, 0x94, 0xeb, 0x58,
+	0xf1, 0x7b, 0xd7, 0xab, 0x21, 0x75, 0xfc, 0xfe, 0xcf, 0xd6, 0xb3, 0xae, 0xb6, 0x9e, 0xf5, 0x6f,
+	0xeb, 0x59, 0xbf, 0x77, 0x5e, 0xef, 0x6a, 0xe7, 0xf5, 0xfe, 0xee, 0xbc, 0xde, 0x97, 0xa3, 0xb5,
+	0x54, 0x71, 0xb5, 0xf4, 0xc3, 0x3c, 0x9d, 0x15, 0x42, 0xae, 0x36, 0x75, 0xfb, 0x4f, 0x7d, 0x6f,
+	0x8f, 0xaa, 0xde, 0x60, 0xb9, 0xb4, 0xe9, 0xdf, 0x7a, 0xfb, 0x3f, 0x00, 0x00, 0xff, 0xff, 0xe0,
+	0x11, 0xcf, 0x86, 0x80, 0x03, 0x00, 0x00,
+}
+
+func (m *FutchainPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FutchainPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FutchainPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FutchainPacketData_QueryMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FutchainPacketData_QueryMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.QueryMatch != nil {
+		{
+			size, err := m.QueryMatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *FutchainPacketData_Subscribe) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FutchainPacketData_Subscribe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Subscribe != nil {
+		{
+			size, err := m.Subscribe.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *FutchainPacketData_MatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FutchainPacketData_MatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MatchResult != nil {
+		{
+			size, err := m.MatchResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *QueryMatchPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MatchResultPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatchResultPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MatchResultPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AwayName) > 0 {
+		i -= len(m.AwayName)
+		copy(dAtA[i:], m.AwayName)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.AwayName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AwayId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.AwayId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.HomeName) > 0 {
+		i -= len(m.HomeName)
+		copy(dAtA[i:], m.HomeName)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.HomeName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HomeId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.HomeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LeagueId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.LeagueId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FutchainPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *FutchainPacketData_QueryMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryMatch != nil {
+		l = m.QueryMatch.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *FutchainPacketData_Subscribe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subscribe != nil {
+		l = m.Subscribe.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *FutchainPacketData_MatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchResult != nil {
+		l = m.MatchResult.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *QueryMatchPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovPacket(uint64(m.MatchId))
+	}
+	return n
+}
+
+func (m *SubscribePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovPacket(uint64(m.MatchId))
+	}
+	return n
+}
+
+func (m *MatchResultPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovPacket(uint64(m.MatchId))
+	}
+	if m.LeagueId != 0 {
+		n += 1 + sovPacket(uint64(m.LeagueId))
+	}
+	if m.HomeId != 0 {
+		n += 1 + sovPacket(uint64(m.HomeId))
+	}
+	l = len(m.HomeName)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.AwayId != 0 {
+		n += 1 + sovPacket(uint64(m.AwayId))
+	}
+	l = len(m.AwayName)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.HomeScore != 0 {
+		n += 1 + sovPacket(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovPacket(uint64(m.AwayScore))
+	}
+	if m.Started {
+		n += 2
+	}
+	if m.Finished {
+		n += 2
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FutchainPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FutchainPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FutchainPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryMatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &QueryMatchPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &FutchainPacketData_QueryMatch{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SubscribePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &FutchainPacketData_Subscribe{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MatchResultPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &FutchainPacketData_MatchResult{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MatchResultPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatchResultPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatchResultPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeagueId", wireType)
+			}
+			m.LeagueId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeagueId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeId", wireType)
+			}
+			m.HomeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HomeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayId", wireType)
+			}
+			m.AwayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AwayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
+			}
+			m.HomeScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
+			}
+			m.AwayScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finished = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
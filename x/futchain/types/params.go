@@ -1,16 +1,95 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const DefaultTimezone string = "Europe/Istanbul"
 const DefaultFetchModulo int64 = 5
+const DefaultMaxCallbackGasLimit uint64 = 500_000
+const DefaultCallbackGasPrice uint64 = 1_000_000_000
+const DefaultFinalityBlocks uint64 = 300
+
+// The upper bounds of the callback and finality params.
+const (
+	// MaxCallbackGasLimitBound bounds the gas limit of a single match callback.
+	MaxCallbackGasLimitBound uint64 = 5_000_000
+	// MaxCallbackGasPriceBound bounds the price of the callback gas, 10^6 times the default.
+	MaxCallbackGasPriceBound uint64 = 1_000_000_000_000_000
+	// MaxFinalityBlocks bounds the blocks a match result waits to be finalized.
+	MaxFinalityBlocks uint64 = 1_000_000
+)
+const DefaultCorrectionVotingBlocks uint64 = 600
+const DefaultOracleReportWindow uint64 = 0
+const DefaultMaxMissedReports uint64 = 50
+const DefaultMaxDeviatingReports uint64 = 10
+const DefaultOracleJailDuration = 10 * time.Minute
+const DefaultOracleRewardEpochBlocks uint64 = 0
+const DefaultReporterUnbondingBlocks uint64 = 1000
+const DefaultReportCommitBlocks uint64 = 5
+const DefaultReportRevealBlocks uint64 = 5
+const DefaultMinReporters uint32 = 3
+const DefaultReporterRewardEpochBlocks uint64 = 1000
+const DefaultMaxMarketsPerMatch uint32 = 20
+
+var DefaultSlashFractionOracle = math.LegacyNewDecWithPrec(1, 3)
+var DefaultOracleRewardPerEpoch = math.ZeroInt()
+var DefaultMinReporterBond = math.NewIntWithDecimal(100, 18)
+var DefaultReporterQuorum = math.LegacyNewDecWithPrec(5, 1)
+var DefaultReporterSlashFraction = math.LegacyNewDecWithPrec(5, 2)
+var DefaultReporterReward = math.ZeroInt()
+var DefaultReporterNonRevealSlashFraction = math.LegacyNewDecWithPrec(1, 2)
+var DefaultMinTotalReporterBond = math.NewIntWithDecimal(300, 18)
+var DefaultReporterRewardEpochCap = math.ZeroInt()
+var DefaultMarketCreationDeposit = math.NewIntWithDecimal(10, 18)
 
-// NewParams creates a new Params instance.
+// NewParams creates a new Params instance with the given fetch settings, the other parameters keeping
+// their defaults.
 func NewParams(timezone string, fetchModulo int64) Params {
-	return Params{Timezone: timezone, FetchModulo: fetchModulo}
+	params := DefaultParams()
+	params.Timezone = timezone
+	params.FetchModulo = fetchModulo
+	return params
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultTimezone, DefaultFetchModulo)
+	return Params{
+		Timezone:                DefaultTimezone,
+		FetchModulo:             DefaultFetchModulo,
+		MaxCallbackGasLimit:     DefaultMaxCallbackGasLimit,
+		CallbackGasPrice:        DefaultCallbackGasPrice,
+		FinalityBlocks:          DefaultFinalityBlocks,
+		CorrectionVotingBlocks:  DefaultCorrectionVotingBlocks,
+		OracleReportWindow:      DefaultOracleReportWindow,
+		MaxMissedReports:        DefaultMaxMissedReports,
+		MaxDeviatingReports:     DefaultMaxDeviatingReports,
+		SlashFractionOracle:     DefaultSlashFractionOracle,
+		OracleJailDuration:      DefaultOracleJailDuration,
+		OracleRewardEpochBlocks: DefaultOracleRewardEpochBlocks,
+		OracleRewardPerEpoch:    DefaultOracleRewardPerEpoch,
+		MinReporterBond:         DefaultMinReporterBond,
+		ReporterQuorum:          DefaultReporterQuorum,
+		ReporterSlashFraction:   DefaultReporterSlashFraction,
+		ReporterReward:          DefaultReporterReward,
+		ReporterUnbondingBlocks: DefaultReporterUnbondingBlocks,
+
+		ReportCommitBlocks:             DefaultReportCommitBlocks,
+		ReportRevealBlocks:             DefaultReportRevealBlocks,
+		ReporterNonRevealSlashFraction: DefaultReporterNonRevealSlashFraction,
+
+		MinReporters:              DefaultMinReporters,
+		MinTotalReporterBond:      DefaultMinTotalReporterBond,
+		ReporterRewardEpochBlocks: DefaultReporterRewardEpochBlocks,
+		ReporterRewardEpochCap:    DefaultReporterRewardEpochCap,
+
+		MarketCreationDeposit: DefaultMarketCreationDeposit,
+		MaxMarketsPerMatch:    DefaultMaxMarketsPerMatch,
+	}
 }
 
 // Validate validates the set of params.
@@ -21,6 +100,54 @@ func (p Params) Validate() error {
 	if err := validateFetchModulo(p.FetchModulo); err != nil {
 		return err
 	}
+	if p.MaxCallbackGasLimit > MaxCallbackGasLimitBound {
+		return fmt.Errorf("max callback gas limit must be at most %d: %d", MaxCallbackGasLimitBound, p.MaxCallbackGasLimit)
+	}
+	if p.CallbackGasPrice > MaxCallbackGasPriceBound {
+		return fmt.Errorf("callback gas price must be at most %d: %d", MaxCallbackGasPriceBound, p.CallbackGasPrice)
+	}
+	if p.FinalityBlocks > MaxFinalityBlocks {
+		return fmt.Errorf("finality blocks must be at most %d: %d", MaxFinalityBlocks, p.FinalityBlocks)
+	}
+	if err := validateDataCouncilMembers(p.DataCouncilMembers, p.CorrectionThreshold, p.CorrectionVotingBlocks); err != nil {
+		return err
+	}
+	if err := validateSlashFractionOracle(p.SlashFractionOracle); err != nil {
+		return err
+	}
+	if !p.OracleRewardPerEpoch.IsNil() && p.OracleRewardPerEpoch.IsNegative() {
+		return fmt.Errorf("oracle reward per epoch must not be negative: %s", p.OracleRewardPerEpoch)
+	}
+	if p.OracleJailDuration < 0 {
+		return fmt.Errorf("oracle jail duration must not be negative: %s", p.OracleJailDuration)
+	}
+	if err := validateReporterParams(p.MinReporterBond, p.ReporterQuorum, p.ReporterSlashFraction, p.ReporterReward); err != nil {
+		return err
+	}
+	if (p.ReportCommitBlocks == 0) != (p.ReportRevealBlocks == 0) {
+		return fmt.Errorf("report commit and reveal blocks must both be zero or positive, got %d and %d", p.ReportCommitBlocks, p.ReportRevealBlocks)
+	}
+	if f := p.ReporterNonRevealSlashFraction; !f.IsNil() && (f.IsNegative() || f.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("reporter non-reveal slash fraction must be between 0 and 1: %s", f)
+	}
+	if !p.MinTotalReporterBond.IsNil() && p.MinTotalReporterBond.IsNegative() {
+		return fmt.Errorf("min total reporter bond must not be negative: %s", p.MinTotalReporterBond)
+	}
+	if !p.ReporterRewardEpochCap.IsNil() && p.ReporterRewardEpochCap.IsNegative() {
+		return fmt.Errorf("reporter reward epoch cap must not be negative: %s", p.ReporterRewardEpochCap)
+	}
+	if !p.ReporterRewardEpochCap.IsNil() && p.ReporterRewardEpochCap.IsPositive() && p.ReporterRewardEpochBlocks == 0 {
+		return fmt.Errorf("reporter reward epoch blocks must be positive with a reporter reward epoch cap")
+	}
+	if !p.MarketCreationDeposit.IsNil() && p.MarketCreationDeposit.IsNegative() {
+		return fmt.Errorf("market creation deposit must not be negative: %s", p.MarketCreationDeposit)
+	}
+	if p.MaxMarketsPerMatch == 0 {
+		return fmt.Errorf("max markets per match must be positive")
+	}
+	if _, err := ProviderPubKeys(p.DataProviders); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -28,7 +155,62 @@ func validateTimezone(v string) error {
 
 	return nil
 }
+
+// validateFetchModulo checks that the data source is fetched every positive number of blocks.
 func validateFetchModulo(v int64) error {
+	if v <= 0 {
+		return fmt.Errorf("fetch modulo must be positive: %d", v)
+	}
+	return nil
+}
+
+// validateDataCouncilMembers checks that the members are unique addresses and the threshold can be reached.
+func validateDataCouncilMembers(members []string, threshold uint32, votingBlocks uint64) error {
+	seen := make(map[string]bool, len(members))
+	for _, member := range members {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return fmt.Errorf("invalid data council member %q: %w", member, err)
+		}
+		if seen[member] {
+			return fmt.Errorf("duplicate data council member %s", member)
+		}
+		seen[member] = true
+	}
+
+	if len(members) == 0 {
+		return nil
+	}
+	if threshold == 0 || int(threshold) > len(members) {
+		return fmt.Errorf("correction threshold must be between 1 and %d, got %d", len(members), threshold)
+	}
+	if votingBlocks == 0 {
+		return fmt.Errorf("correction voting blocks must be positive")
+	}
+	return nil
+}
+
+func validateSlashFractionOracle(v math.LegacyDec) error {
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("oracle slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
 
+func validateReporterParams(minBond math.Int, quorum, slashFraction math.LegacyDec, reward math.Int) error {
+	if !minBond.IsNil() && !minBond.IsPositive() {
+		return fmt.Errorf("min reporter bond must be positive: %s", minBond)
+	}
+	if !quorum.IsNil() && (!quorum.IsPositive() || quorum.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("reporter quorum must be greater than 0 and at most 1: %s", quorum)
+	}
+	if !slashFraction.IsNil() && (slashFraction.IsNegative() || slashFraction.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("reporter slash fraction must be between 0 and 1: %s", slashFraction)
+	}
+	if !reward.IsNil() && reward.IsNegative() {
+		return fmt.Errorf("reporter reward must not be negative: %s", reward)
+	}
 	return nil
 }
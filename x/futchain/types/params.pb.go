@@ -4,19 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +34,99 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	Timezone    string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
 	FetchModulo int64  `protobuf:"varint,2,opt,name=fetch_modulo,json=fetchModulo,proto3" json:"fetch_modulo,omitempty"`
+	// max_callback_gas_limit is the highest gas limit a contract can request for
+	// its match result callback. Zero disables subscriptions.
+	MaxCallbackGasLimit uint64 `protobuf:"varint,3,opt,name=max_callback_gas_limit,json=maxCallbackGasLimit,proto3" json:"max_callback_gas_limit,omitempty"`
+	// callback_gas_price is the price per gas, in the evm denom, a contract
+	// prepays when subscribing to a match result.
+	CallbackGasPrice uint64 `protobuf:"varint,4,opt,name=callback_gas_price,json=callbackGasPrice,proto3" json:"callback_gas_price,omitempty"`
+	// finality_blocks is the number of blocks a finished or cancelled match
+	// result must stay unchanged before it is finalized. Markets, outcome shares
+	// and callbacks only settle on finalized results.
+	FinalityBlocks uint64 `protobuf:"varint,5,opt,name=finality_blocks,json=finalityBlocks,proto3" json:"finality_blocks,omitempty"`
+	// data_council_members may propose and approve data corrections.
+	DataCouncilMembers []string `protobuf:"bytes,7,rep,name=data_council_members,json=dataCouncilMembers,proto3" json:"data_council_members,omitempty"`
+	// correction_threshold is the number of member approvals a correction needs
+	// to be applied.
+	CorrectionThreshold uint32 `protobuf:"varint,8,opt,name=correction_threshold,json=correctionThreshold,proto3" json:"correction_threshold,omitempty"`
+	// correction_voting_blocks is the number of blocks a correction collects
+	// approvals before it expires.
+	CorrectionVotingBlocks uint64 `protobuf:"varint,9,opt,name=correction_voting_blocks,json=correctionVotingBlocks,proto3" json:"correction_voting_blocks,omitempty"`
+	// oracle_report_window is the number of finalized matches the oracle reports
+	// of the bonded validators are tracked over. Zero disables the tracking.
+	// Missing reports only count for the results that came in once it was enabled.
+	OracleReportWindow uint64 `protobuf:"varint,10,opt,name=oracle_report_window,json=oracleReportWindow,proto3" json:"oracle_report_window,omitempty"`
+	// max_missed_reports is the number of missed reports in the window past
+	// which a validator is slashed and jailed.
+	MaxMissedReports uint64 `protobuf:"varint,11,opt,name=max_missed_reports,json=maxMissedReports,proto3" json:"max_missed_reports,omitempty"`
+	// max_deviating_reports is the number of reports deviating from the
+	// finalized result in the window past which a validator is slashed and
+	// jailed.
+	MaxDeviatingReports uint64 `protobuf:"varint,12,opt,name=max_deviating_reports,json=maxDeviatingReports,proto3" json:"max_deviating_reports,omitempty"`
+	// slash_fraction_oracle is the fraction of stake slashed from validators
+	// past either threshold.
+	SlashFractionOracle cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=slash_fraction_oracle,json=slashFractionOracle,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_oracle"`
+	// oracle_jail_duration is how long validators past either threshold are
+	// jailed for.
+	OracleJailDuration time.Duration `protobuf:"bytes,14,opt,name=oracle_jail_duration,json=oracleJailDuration,proto3,stdduration" json:"oracle_jail_duration"`
+	// oracle_reward_epoch_blocks is the length, in blocks, of an oracle reward
+	// epoch. Zero disables the rewards.
+	OracleRewardEpochBlocks uint64 `protobuf:"varint,15,opt,name=oracle_reward_epoch_blocks,json=oracleRewardEpochBlocks,proto3" json:"oracle_reward_epoch_blocks,omitempty"`
+	// oracle_reward_per_epoch is the amount of the bond denom minted at the end
+	// of every epoch and shared by the validators whose reports agreed with the
+	// finalized results, weighted by their stake.
+	OracleRewardPerEpoch cosmossdk_io_math.Int `protobuf:"bytes,16,opt,name=oracle_reward_per_epoch,json=oracleRewardPerEpoch,proto3,customtype=cosmossdk.io/math.Int" json:"oracle_reward_per_epoch"`
+	// min_reporter_bond is the bond, in the bond denom, an account needs to
+	// register as a data reporter.
+	MinReporterBond cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=min_reporter_bond,json=minReporterBond,proto3,customtype=cosmossdk.io/math.Int" json:"min_reporter_bond"`
+	// reporter_quorum is the share of the active reporter bond that must agree
+	// on the state of a match for it to be ingested.
+	ReporterQuorum cosmossdk_io_math.LegacyDec `protobuf:"bytes,18,opt,name=reporter_quorum,json=reporterQuorum,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reporter_quorum"`
+	// reporter_slash_fraction is the fraction of the bond slashed from a
+	// reporter for a report deviating from a finalized result.
+	ReporterSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,19,opt,name=reporter_slash_fraction,json=reporterSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reporter_slash_fraction"`
+	// reporter_reward is the amount of the bond denom paid from the reporter
+	// rewards pool to a reporter for a report agreeing with a finalized result.
+	ReporterReward cosmossdk_io_math.Int `protobuf:"bytes,20,opt,name=reporter_reward,json=reporterReward,proto3,customtype=cosmossdk.io/math.Int" json:"reporter_reward"`
+	// reporter_unbonding_blocks is the number of blocks an unbonding reporter
+	// waits for its bond.
+	ReporterUnbondingBlocks uint64 `protobuf:"varint,21,opt,name=reporter_unbonding_blocks,json=reporterUnbondingBlocks,proto3" json:"reporter_unbonding_blocks,omitempty"`
+	// reporter_only_ingestion disables the direct HTTP fetch, so match data is
+	// only ingested from the aggregated reporter reports.
+	ReporterOnlyIngestion bool `protobuf:"varint,22,opt,name=reporter_only_ingestion,json=reporterOnlyIngestion,proto3" json:"reporter_only_ingestion,omitempty"`
+	// report_commit_blocks is the length of the commit phase of a reporter
+	// round, in blocks. Reporters submit plaintext reports if it is zero.
+	ReportCommitBlocks uint64 `protobuf:"varint,23,opt,name=report_commit_blocks,json=reportCommitBlocks,proto3" json:"report_commit_blocks,omitempty"`
+	// report_reveal_blocks is the length of the reveal phase of a reporter
+	// round, in blocks.
+	ReportRevealBlocks uint64 `protobuf:"varint,24,opt,name=report_reveal_blocks,json=reportRevealBlocks,proto3" json:"report_reveal_blocks,omitempty"`
+	// reporter_non_reveal_slash_fraction is the fraction of the bond slashed
+	// from a reporter for a commit it did not reveal in its round.
+	ReporterNonRevealSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,25,opt,name=reporter_non_reveal_slash_fraction,json=reporterNonRevealSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reporter_non_reveal_slash_fraction"`
+	// data_providers are the providers whose payload signatures are verified on
+	// ingestion.
+	DataProviders []DataProvider `protobuf:"bytes,26,rep,name=data_providers,json=dataProviders,proto3" json:"data_providers"`
+	// require_provider_signatures drops the fetched matches without a valid
+	// provider attestation.
+	RequireProviderSignatures bool `protobuf:"varint,27,opt,name=require_provider_signatures,json=requireProviderSignatures,proto3" json:"require_provider_signatures,omitempty"`
+	// min_reporters is the number of active reporters below which the reports
+	// are not aggregated.
+	MinReporters uint32 `protobuf:"varint,28,opt,name=min_reporters,json=minReporters,proto3" json:"min_reporters,omitempty"`
+	// min_total_reporter_bond is the active reporter bond, in the bond denom,
+	// below which the reports are not aggregated.
+	MinTotalReporterBond cosmossdk_io_math.Int `protobuf:"bytes,29,opt,name=min_total_reporter_bond,json=minTotalReporterBond,proto3,customtype=cosmossdk.io/math.Int" json:"min_total_reporter_bond"`
+	// reporter_reward_epoch_blocks is the length of a reporter reward epoch, in
+	// blocks.
+	ReporterRewardEpochBlocks uint64 `protobuf:"varint,30,opt,name=reporter_reward_epoch_blocks,json=reporterRewardEpochBlocks,proto3" json:"reporter_reward_epoch_blocks,omitempty"`
+	// reporter_reward_epoch_cap is the amount of the bond denom paid at most
+	// from the reporter rewards pool in a reporter reward epoch.
+	ReporterRewardEpochCap cosmossdk_io_math.Int `protobuf:"bytes,31,opt,name=reporter_reward_epoch_cap,json=reporterRewardEpochCap,proto3,customtype=cosmossdk.io/math.Int" json:"reporter_reward_epoch_cap"`
+	// market_creation_deposit is the amount of the bond denom a market creator
+	// deposits, returned once the market is settled or refunded.
+	MarketCreationDeposit cosmossdk_io_math.Int `protobuf:"bytes,32,opt,name=market_creation_deposit,json=marketCreationDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"market_creation_deposit"`
+	// max_markets_per_match is the number of markets that can be created on a
+	// match.
+	MaxMarketsPerMatch uint32 `protobuf:"varint,33,opt,name=max_markets_per_match,json=maxMarketsPerMatch,proto3" json:"max_markets_per_match,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -77,6 +176,146 @@ func (m *Params) GetFetchModulo() int64 {
 	return 0
 }
 
+func (m *Params) GetMaxCallbackGasLimit() uint64 {
+	if m != nil {
+		return m.MaxCallbackGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetCallbackGasPrice() uint64 {
+	if m != nil {
+		return m.CallbackGasPrice
+	}
+	return 0
+}
+
+func (m *Params) GetFinalityBlocks() uint64 {
+	if m != nil {
+		return m.FinalityBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDataCouncilMembers() []string {
+	if m != nil {
+		return m.DataCouncilMembers
+	}
+	return nil
+}
+
+func (m *Params) GetCorrectionThreshold() uint32 {
+	if m != nil {
+		return m.CorrectionThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetCorrectionVotingBlocks() uint64 {
+	if m != nil {
+		return m.CorrectionVotingBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetOracleReportWindow() uint64 {
+	if m != nil {
+		return m.OracleReportWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedReports() uint64 {
+	if m != nil {
+		return m.MaxMissedReports
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDeviatingReports() uint64 {
+	if m != nil {
+		return m.MaxDeviatingReports
+	}
+	return 0
+}
+
+func (m *Params) GetOracleJailDuration() time.Duration {
+	if m != nil {
+		return m.OracleJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetOracleRewardEpochBlocks() uint64 {
+	if m != nil {
+		return m.OracleRewardEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetReporterUnbondingBlocks() uint64 {
+	if m != nil {
+		return m.ReporterUnbondingBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetReporterOnlyIngestion() bool {
+	if m != nil {
+		return m.ReporterOnlyIngestion
+	}
+	return false
+}
+
+func (m *Params) GetReportCommitBlocks() uint64 {
+	if m != nil {
+		return m.ReportCommitBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetReportRevealBlocks() uint64 {
+	if m != nil {
+		return m.ReportRevealBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDataProviders() []DataProvider {
+	if m != nil {
+		return m.DataProviders
+	}
+	return nil
+}
+
+func (m *Params) GetRequireProviderSignatures() bool {
+	if m != nil {
+		return m.RequireProviderSignatures
+	}
+	return false
+}
+
+func (m *Params) GetMinReporters() uint32 {
+	if m != nil {
+		return m.MinReporters
+	}
+	return 0
+}
+
+func (m *Params) GetReporterRewardEpochBlocks() uint64 {
+	if m != nil {
+		return m.ReporterRewardEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMarketsPerMatch() uint32 {
+	if m != nil {
+		return m.MaxMarketsPerMatch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "futchain.futchain.v1.Params")
 }
@@ -84,21 +323,77 @@ func init() {
 func init() { proto.RegisterFile("futchain/futchain/v1/params.proto", fileDescriptor_be589addacc8f4b9) }
 
 var fileDescriptor_be589addacc8f4b9 = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x2b, 0x2d, 0x49,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x33, 0xca, 0x0c, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b,
-	0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x60, 0x32, 0x7a, 0x70, 0x46, 0x99, 0xa1, 0x94,
-	0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x28, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52, 0x0e, 0x17, 0x5b, 0x00, 0xd8, 0x38, 0x21, 0x29,
-	0x2e, 0x8e, 0x92, 0xcc, 0xdc, 0xd4, 0xaa, 0xfc, 0xbc, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce,
-	0x20, 0x38, 0x5f, 0x48, 0x91, 0x8b, 0x27, 0x2d, 0xb5, 0x24, 0x39, 0x23, 0x3e, 0x37, 0x3f, 0xa5,
-	0x34, 0x27, 0x5f, 0x82, 0x49, 0x81, 0x51, 0x83, 0x39, 0x88, 0x1b, 0x2c, 0xe6, 0x0b, 0x16, 0xb2,
-	0x52, 0x7e, 0xb1, 0x40, 0x9e, 0xb1, 0xeb, 0xf9, 0x06, 0x2d, 0x29, 0xb8, 0x53, 0x2b, 0x10, 0xae,
-	0x86, 0xd8, 0xe1, 0xe4, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
-	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xda,
-	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x45, 0x89, 0x99, 0x69, 0x05,
-	0x95, 0xfa, 0xd8, 0xcc, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xbb, 0xdd, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0x03, 0x89, 0xfa, 0x48, 0x1f, 0x01, 0x00, 0x00,
+	// 1105 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x92, 0x52, 0x92, 0xc9, 0xaf, 0x66, 0x62, 0x27, 0x63, 0xa7, 0x38, 0x4e, 0x72, 0xc0,
+	0x2a, 0xd4, 0x6e, 0x52, 0xa9, 0x42, 0x45, 0x02, 0xe1, 0xb8, 0xa0, 0x54, 0x0d, 0x0d, 0x4e, 0x00,
+	0x51, 0x21, 0xad, 0xc6, 0xbb, 0xe3, 0xf5, 0x34, 0xbb, 0x33, 0xee, 0xcc, 0xac, 0x13, 0x73, 0xe4,
+	0x06, 0x27, 0x8e, 0x1c, 0x39, 0x72, 0xec, 0xa1, 0x7f, 0x44, 0x8f, 0x55, 0x4f, 0x88, 0x43, 0x41,
+	0xc9, 0xa1, 0xfc, 0x19, 0x68, 0x67, 0x76, 0xd6, 0xeb, 0x90, 0x53, 0x72, 0xb1, 0xbc, 0xf3, 0xbd,
+	0xef, 0x7b, 0x6f, 0xbf, 0xf7, 0x66, 0x66, 0xc1, 0x7a, 0x37, 0x56, 0x5e, 0x0f, 0x53, 0xd6, 0xc8,
+	0xfe, 0x0c, 0xb6, 0x1a, 0x7d, 0x2c, 0x70, 0x24, 0xeb, 0x7d, 0xc1, 0x15, 0x87, 0x05, 0x8b, 0xd4,
+	0xb3, 0x3f, 0x83, 0xad, 0xf2, 0x22, 0x8e, 0x28, 0xe3, 0x0d, 0xfd, 0x6b, 0x02, 0xcb, 0x25, 0x8f,
+	0xcb, 0x88, 0x4b, 0x57, 0x3f, 0x35, 0xcc, 0x43, 0x0a, 0x6d, 0x5e, 0x9c, 0x46, 0xf0, 0x01, 0xf5,
+	0x89, 0x48, 0x83, 0x0a, 0x01, 0x0f, 0xb8, 0x21, 0x27, 0xff, 0xd2, 0xd5, 0x4a, 0xc0, 0x79, 0x10,
+	0x92, 0x86, 0x7e, 0xea, 0xc4, 0xdd, 0x86, 0x1f, 0x0b, 0xac, 0x28, 0x67, 0x06, 0xdf, 0xf8, 0x19,
+	0x82, 0xeb, 0xfb, 0xba, 0x5e, 0x58, 0x06, 0x53, 0x8a, 0x46, 0xe4, 0x47, 0xce, 0x08, 0x72, 0xaa,
+	0x4e, 0x6d, 0xba, 0x9d, 0x3d, 0xc3, 0x75, 0x30, 0xdb, 0x25, 0xca, 0xeb, 0xb9, 0x11, 0xf7, 0xe3,
+	0x90, 0xa3, 0x77, 0xaa, 0x4e, 0x6d, 0xb2, 0x3d, 0xa3, 0xd7, 0xf6, 0xf4, 0x12, 0xbc, 0x0b, 0x96,
+	0x23, 0x7c, 0xe2, 0x7a, 0x38, 0x0c, 0x3b, 0xd8, 0x3b, 0x72, 0x03, 0x2c, 0xdd, 0x90, 0x46, 0x54,
+	0xa1, 0xc9, 0xaa, 0x53, 0xbb, 0xd6, 0x5e, 0x8a, 0xf0, 0xc9, 0x4e, 0x0a, 0x7e, 0x89, 0xe5, 0xa3,
+	0x04, 0x82, 0x1f, 0x01, 0x38, 0x46, 0xe8, 0x0b, 0xea, 0x11, 0x74, 0x4d, 0x13, 0x6e, 0x78, 0xa3,
+	0xe8, 0xfd, 0x64, 0x1d, 0x7e, 0x00, 0x16, 0xba, 0x94, 0xe1, 0x90, 0xaa, 0xa1, 0xdb, 0x09, 0xb9,
+	0x77, 0x24, 0xd1, 0xbb, 0x3a, 0x74, 0xde, 0x2e, 0x37, 0xf5, 0x2a, 0x7c, 0x08, 0x0a, 0x3e, 0x56,
+	0xd8, 0xf5, 0x78, 0xcc, 0x3c, 0x1a, 0xba, 0x11, 0x89, 0x3a, 0x44, 0x48, 0xf4, 0x5e, 0x75, 0xb2,
+	0x36, 0xdd, 0x44, 0xaf, 0x5f, 0xdc, 0x2e, 0xa4, 0x06, 0x7f, 0xee, 0xfb, 0x82, 0x48, 0x79, 0xa0,
+	0x04, 0x65, 0x41, 0x1b, 0x26, 0xac, 0x1d, 0x43, 0xda, 0x33, 0x1c, 0xb8, 0x05, 0x0a, 0x1e, 0x17,
+	0x82, 0x78, 0x89, 0x6b, 0xae, 0xea, 0x09, 0x22, 0x7b, 0x3c, 0xf4, 0xd1, 0x54, 0xd5, 0xa9, 0xcd,
+	0xb5, 0x97, 0x46, 0xd8, 0xa1, 0x85, 0xe0, 0xc7, 0x00, 0xe5, 0x28, 0x03, 0xae, 0x28, 0x0b, 0x6c,
+	0xc1, 0xd3, 0xba, 0xe0, 0xe5, 0x11, 0xfe, 0xad, 0x86, 0xd3, 0xc2, 0xef, 0x80, 0x02, 0x17, 0xd8,
+	0x0b, 0x89, 0x2b, 0x48, 0x9f, 0x0b, 0xe5, 0x1e, 0x53, 0xe6, 0xf3, 0x63, 0x04, 0x34, 0x0b, 0x1a,
+	0xac, 0xad, 0xa1, 0xef, 0x34, 0x92, 0x38, 0x98, 0xd8, 0x1e, 0x51, 0x29, 0x89, 0x9f, 0xb2, 0x24,
+	0x9a, 0x31, 0x0e, 0x46, 0xf8, 0x64, 0x4f, 0x03, 0x86, 0x22, 0xe1, 0x36, 0x28, 0x26, 0xd1, 0x3e,
+	0x19, 0x50, 0xac, 0xab, 0xb2, 0x84, 0xd9, 0xac, 0x47, 0x2d, 0x8b, 0x59, 0xce, 0x53, 0x50, 0x94,
+	0x21, 0x96, 0x3d, 0xb7, 0x2b, 0xb0, 0x79, 0x23, 0x53, 0x06, 0x9a, 0x4b, 0x86, 0xa4, 0x79, 0xef,
+	0xe5, 0x9b, 0xb5, 0x89, 0xbf, 0xde, 0xac, 0xad, 0x1a, 0x47, 0xa5, 0x7f, 0x54, 0xa7, 0xbc, 0x11,
+	0x61, 0xd5, 0xab, 0x3f, 0x22, 0x01, 0xf6, 0x86, 0x2d, 0xe2, 0xbd, 0x7e, 0x71, 0x1b, 0xa4, 0x86,
+	0xb7, 0x88, 0xf7, 0xc7, 0xdb, 0xe7, 0xb7, 0x9c, 0xf6, 0x92, 0x16, 0xfd, 0x22, 0xd5, 0x7c, 0xac,
+	0x25, 0xe1, 0x93, 0xec, 0xfd, 0x9f, 0x62, 0x1a, 0xba, 0x76, 0x58, 0xd1, 0x7c, 0xd5, 0xa9, 0xcd,
+	0x6c, 0x97, 0xea, 0x66, 0x9a, 0xeb, 0x76, 0x9a, 0xeb, 0xad, 0x34, 0xa0, 0x39, 0x97, 0x54, 0xf1,
+	0xdb, 0xdf, 0x6b, 0x8e, 0x11, 0x4f, 0x9d, 0x7a, 0x88, 0x69, 0x68, 0x43, 0xe0, 0x27, 0xa0, 0x9c,
+	0x79, 0x7b, 0x8c, 0x85, 0xef, 0x92, 0x3e, 0xf7, 0x7a, 0xb6, 0x2f, 0x0b, 0xda, 0x80, 0x15, 0xeb,
+	0x70, 0x12, 0xf0, 0x20, 0xc1, 0xd3, 0xc6, 0x04, 0x60, 0x65, 0x9c, 0xdc, 0x27, 0xc2, 0x08, 0xa0,
+	0x1b, 0xda, 0x86, 0x3b, 0xa9, 0x0d, 0xc5, 0xff, 0xdb, 0xb0, 0xcb, 0x54, 0xce, 0x80, 0x5d, 0xa6,
+	0x4c, 0x8d, 0x85, 0x7c, 0xae, 0x7d, 0x22, 0x74, 0x3a, 0xf8, 0x03, 0x58, 0x8c, 0x28, 0x4b, 0xfb,
+	0x42, 0x84, 0xdb, 0xe1, 0xcc, 0x47, 0x8b, 0x97, 0x4c, 0xb1, 0x10, 0x51, 0xd6, 0x4e, 0x95, 0x9a,
+	0x9c, 0xf9, 0xd0, 0x05, 0x0b, 0x99, 0xf2, 0xb3, 0x98, 0x8b, 0x38, 0x42, 0xf0, 0x4a, 0x5d, 0x9c,
+	0xb7, 0x72, 0x5f, 0x6b, 0x35, 0xc8, 0xc0, 0x4a, 0x96, 0x60, 0x7c, 0x6a, 0xd0, 0xd2, 0x95, 0x12,
+	0x15, 0xad, 0xec, 0x41, 0x7e, 0x6c, 0xe0, 0xf7, 0xb9, 0x17, 0x32, 0x9d, 0x41, 0x85, 0x4b, 0x9a,
+	0x95, 0xbd, 0x8a, 0xe9, 0x08, 0xbc, 0x0f, 0x4a, 0x99, 0x74, 0xcc, 0x92, 0x3e, 0xe4, 0xb6, 0x71,
+	0xd1, 0x8c, 0x8b, 0x0d, 0xf8, 0xc6, 0xe2, 0xe9, 0xb8, 0xdc, 0xcb, 0xd9, 0xc0, 0x59, 0x38, 0x74,
+	0x29, 0x0b, 0x88, 0xd4, 0x36, 0x2c, 0x57, 0x9d, 0xda, 0xd4, 0xe8, 0x75, 0x1e, 0xb3, 0x70, 0xb8,
+	0x6b, 0xc1, 0x64, 0xff, 0xa7, 0x1b, 0xdf, 0xe3, 0x51, 0x44, 0x95, 0x4d, 0xb7, 0x62, 0xf6, 0xbf,
+	0xc1, 0x76, 0x34, 0x34, 0x3a, 0x31, 0x52, 0x86, 0x20, 0x03, 0x82, 0x43, 0xcb, 0x40, 0x79, 0x46,
+	0x5b, 0x43, 0x29, 0xe3, 0x27, 0x07, 0x6c, 0x64, 0xc5, 0x31, 0xce, 0x2c, 0xf1, 0x5c, 0xbb, 0x4a,
+	0x57, 0x6a, 0x57, 0xc5, 0x66, 0xf8, 0x8a, 0x33, 0x93, 0x7d, 0xbc, 0x6f, 0x87, 0x60, 0x5e, 0x9f,
+	0xd0, 0xf6, 0x12, 0x93, 0xa8, 0x5c, 0x9d, 0xac, 0xcd, 0x6c, 0x6f, 0xd4, 0x2f, 0xba, 0x2f, 0xeb,
+	0x2d, 0xac, 0xf0, 0x7e, 0x1a, 0xda, 0x9c, 0x4e, 0x6a, 0x32, 0x69, 0xe6, 0xfc, 0x1c, 0x20, 0xe1,
+	0xa7, 0x60, 0x55, 0x90, 0x67, 0x31, 0x15, 0x24, 0x13, 0x76, 0x25, 0x0d, 0x18, 0x56, 0xb1, 0x20,
+	0x12, 0xad, 0x6a, 0xeb, 0x4b, 0x69, 0x88, 0xa5, 0x1d, 0x64, 0x01, 0x70, 0x13, 0xcc, 0xe5, 0x37,
+	0x9f, 0x44, 0x37, 0xf5, 0x21, 0x3f, 0x9b, 0xdb, 0x46, 0xfa, 0x28, 0x48, 0x82, 0x14, 0x57, 0x38,
+	0x3c, 0xb7, 0x4f, 0xdf, 0xbf, 0xec, 0x51, 0x10, 0x51, 0x76, 0x98, 0xe8, 0x8d, 0x6d, 0xd6, 0xcf,
+	0xc0, 0xcd, 0x73, 0xb3, 0x3d, 0x7e, 0x64, 0x55, 0x74, 0x8b, 0x4b, 0xe3, 0x63, 0x9b, 0x3f, 0xb4,
+	0x8e, 0x72, 0x13, 0x3c, 0x26, 0xe0, 0xe1, 0x3e, 0x5a, 0xbb, 0x64, 0xad, 0xcb, 0x17, 0xe4, 0xdb,
+	0xc1, 0x7d, 0xd8, 0x03, 0x2b, 0x11, 0x16, 0x47, 0x44, 0xb9, 0x9e, 0x20, 0xfa, 0xc4, 0x75, 0x7d,
+	0xd2, 0xe7, 0x92, 0x2a, 0x54, 0xbd, 0x64, 0xaa, 0xa2, 0x11, 0xdc, 0x49, 0xf5, 0x5a, 0x46, 0x0e,
+	0x6e, 0x99, 0x4b, 0xcc, 0x80, 0x52, 0x9f, 0xc4, 0x11, 0x56, 0x5e, 0x0f, 0xad, 0xeb, 0x6e, 0x25,
+	0xf7, 0xe1, 0x9e, 0xc1, 0xf6, 0x89, 0xd8, 0x4b, 0x90, 0xfb, 0x9b, 0xff, 0xfe, 0xbe, 0xe6, 0xfc,
+	0xf2, 0xf6, 0xf9, 0xad, 0x72, 0xf6, 0x05, 0x75, 0x32, 0xfa, 0x98, 0x32, 0x1f, 0x40, 0xcd, 0x07,
+	0x2f, 0x4f, 0x2b, 0xce, 0xab, 0xd3, 0x8a, 0xf3, 0xcf, 0x69, 0xc5, 0xf9, 0xf5, 0xac, 0x32, 0xf1,
+	0xea, 0xac, 0x32, 0xf1, 0xe7, 0x59, 0x65, 0xe2, 0xc9, 0x87, 0x01, 0x55, 0xbd, 0xb8, 0x53, 0xf7,
+	0x78, 0xd4, 0x10, 0x98, 0x76, 0xfb, 0xc3, 0xc6, 0x45, 0x3a, 0x6a, 0xd8, 0x27, 0xb2, 0x73, 0x5d,
+	0xdf, 0x4e, 0x77, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xda, 0x07, 0xc0, 0x1d, 0x0a, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -126,6 +421,106 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.FetchModulo != that1.FetchModulo {
 		return false
 	}
+	if this.MaxCallbackGasLimit != that1.MaxCallbackGasLimit {
+		return false
+	}
+	if this.CallbackGasPrice != that1.CallbackGasPrice {
+		return false
+	}
+	if this.FinalityBlocks != that1.FinalityBlocks {
+		return false
+	}
+	if len(this.DataCouncilMembers) != len(that1.DataCouncilMembers) {
+		return false
+	}
+	for i := range this.DataCouncilMembers {
+		if this.DataCouncilMembers[i] != that1.DataCouncilMembers[i] {
+			return false
+		}
+	}
+	if this.CorrectionThreshold != that1.CorrectionThreshold {
+		return false
+	}
+	if this.CorrectionVotingBlocks != that1.CorrectionVotingBlocks {
+		return false
+	}
+	if this.OracleReportWindow != that1.OracleReportWindow {
+		return false
+	}
+	if this.MaxMissedReports != that1.MaxMissedReports {
+		return false
+	}
+	if this.MaxDeviatingReports != that1.MaxDeviatingReports {
+		return false
+	}
+	if !this.SlashFractionOracle.Equal(that1.SlashFractionOracle) {
+		return false
+	}
+	if this.OracleJailDuration != that1.OracleJailDuration {
+		return false
+	}
+	if this.OracleRewardEpochBlocks != that1.OracleRewardEpochBlocks {
+		return false
+	}
+	if !this.OracleRewardPerEpoch.Equal(that1.OracleRewardPerEpoch) {
+		return false
+	}
+	if !this.MinReporterBond.Equal(that1.MinReporterBond) {
+		return false
+	}
+	if !this.ReporterQuorum.Equal(that1.ReporterQuorum) {
+		return false
+	}
+	if !this.ReporterSlashFraction.Equal(that1.ReporterSlashFraction) {
+		return false
+	}
+	if !this.ReporterReward.Equal(that1.ReporterReward) {
+		return false
+	}
+	if this.ReporterUnbondingBlocks != that1.ReporterUnbondingBlocks {
+		return false
+	}
+	if this.ReporterOnlyIngestion != that1.ReporterOnlyIngestion {
+		return false
+	}
+	if this.ReportCommitBlocks != that1.ReportCommitBlocks {
+		return false
+	}
+	if this.ReportRevealBlocks != that1.ReportRevealBlocks {
+		return false
+	}
+	if !this.ReporterNonRevealSlashFraction.Equal(that1.ReporterNonRevealSlashFraction) {
+		return false
+	}
+	if len(this.DataProviders) != len(that1.DataProviders) {
+		return false
+	}
+	for i := range this.DataProviders {
+		if !this.DataProviders[i].Equal(&that1.DataProviders[i]) {
+			return false
+		}
+	}
+	if this.RequireProviderSignatures != that1.RequireProviderSignatures {
+		return false
+	}
+	if this.MinReporters != that1.MinReporters {
+		return false
+	}
+	if !this.MinTotalReporterBond.Equal(that1.MinTotalReporterBond) {
+		return false
+	}
+	if this.ReporterRewardEpochBlocks != that1.ReporterRewardEpochBlocks {
+		return false
+	}
+	if !this.ReporterRewardEpochCap.Equal(that1.ReporterRewardEpochCap) {
+		return false
+	}
+	if !this.MarketCreationDeposit.Equal(that1.MarketCreationDeposit) {
+		return false
+	}
+	if this.MaxMarketsPerMatch != that1.MaxMarketsPerMatch {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -148,6 +543,268 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMarketsPerMatch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMarketsPerMatch))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.MarketCreationDeposit.Size()
+		i -= size
+		if _, err := m.MarketCreationDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.ReporterRewardEpochCap.Size()
+		i -= size
+		if _, err := m.ReporterRewardEpochCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfa
+	if m.ReporterRewardEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReporterRewardEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size := m.MinTotalReporterBond.Size()
+		i -= size
+		if _, err := m.MinTotalReporterBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.MinReporters != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinReporters))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.RequireProviderSignatures {
+		i--
+		if m.RequireProviderSignatures {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.DataProviders) > 0 {
+		for iNdEx := len(m.DataProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	{
+		size := m.ReporterNonRevealSlashFraction.Size()
+		i -= size
+		if _, err := m.ReporterNonRevealSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if m.ReportRevealBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReportRevealBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.ReportCommitBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReportCommitBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.ReporterOnlyIngestion {
+		i--
+		if m.ReporterOnlyIngestion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.ReporterUnbondingBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReporterUnbondingBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.ReporterReward.Size()
+		i -= size
+		if _, err := m.ReporterReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.ReporterSlashFraction.Size()
+		i -= size
+		if _, err := m.ReporterSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.ReporterQuorum.Size()
+		i -= size
+		if _, err := m.ReporterQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.MinReporterBond.Size()
+		i -= size
+		if _, err := m.MinReporterBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.OracleRewardPerEpoch.Size()
+		i -= size
+		if _, err := m.OracleRewardPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.OracleRewardEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OracleRewardEpochBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.OracleJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleJailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionOracle.Size()
+		i -= size
+		if _, err := m.SlashFractionOracle.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.MaxDeviatingReports != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDeviatingReports))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxMissedReports != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedReports))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.OracleReportWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OracleReportWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CorrectionVotingBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CorrectionVotingBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CorrectionThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CorrectionThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DataCouncilMembers) > 0 {
+		for iNdEx := len(m.DataCouncilMembers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DataCouncilMembers[iNdEx])
+			copy(dAtA[i:], m.DataCouncilMembers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DataCouncilMembers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.FinalityBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalityBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CallbackGasPrice != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CallbackGasPrice))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxCallbackGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCallbackGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.FetchModulo != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.FetchModulo))
 		i--
@@ -187,23 +844,108 @@ func (m *Params) Size() (n int) {
 	if m.FetchModulo != 0 {
 		n += 1 + sovParams(uint64(m.FetchModulo))
 	}
-	return n
-}
-
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+	if m.MaxCallbackGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.MaxCallbackGasLimit))
+	}
+	if m.CallbackGasPrice != 0 {
+		n += 1 + sovParams(uint64(m.CallbackGasPrice))
+	}
+	if m.FinalityBlocks != 0 {
+		n += 1 + sovParams(uint64(m.FinalityBlocks))
+	}
+	if len(m.DataCouncilMembers) > 0 {
+		for _, s := range m.DataCouncilMembers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CorrectionThreshold != 0 {
+		n += 1 + sovParams(uint64(m.CorrectionThreshold))
+	}
+	if m.CorrectionVotingBlocks != 0 {
+		n += 1 + sovParams(uint64(m.CorrectionVotingBlocks))
+	}
+	if m.OracleReportWindow != 0 {
+		n += 1 + sovParams(uint64(m.OracleReportWindow))
+	}
+	if m.MaxMissedReports != 0 {
+		n += 1 + sovParams(uint64(m.MaxMissedReports))
+	}
+	if m.MaxDeviatingReports != 0 {
+		n += 1 + sovParams(uint64(m.MaxDeviatingReports))
+	}
+	l = m.SlashFractionOracle.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.OracleJailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.OracleRewardEpochBlocks != 0 {
+		n += 1 + sovParams(uint64(m.OracleRewardEpochBlocks))
+	}
+	l = m.OracleRewardPerEpoch.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MinReporterBond.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ReporterQuorum.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ReporterSlashFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.ReporterReward.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.ReporterUnbondingBlocks != 0 {
+		n += 2 + sovParams(uint64(m.ReporterUnbondingBlocks))
+	}
+	if m.ReporterOnlyIngestion {
+		n += 3
+	}
+	if m.ReportCommitBlocks != 0 {
+		n += 2 + sovParams(uint64(m.ReportCommitBlocks))
+	}
+	if m.ReportRevealBlocks != 0 {
+		n += 2 + sovParams(uint64(m.ReportRevealBlocks))
+	}
+	l = m.ReporterNonRevealSlashFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.DataProviders) > 0 {
+		for _, e := range m.DataProviders {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RequireProviderSignatures {
+		n += 3
+	}
+	if m.MinReporters != 0 {
+		n += 2 + sovParams(uint64(m.MinReporters))
+	}
+	l = m.MinTotalReporterBond.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.ReporterRewardEpochBlocks != 0 {
+		n += 2 + sovParams(uint64(m.ReporterRewardEpochBlocks))
+	}
+	l = m.ReporterRewardEpochCap.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MarketCreationDeposit.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxMarketsPerMatch != 0 {
+		n += 2 + sovParams(uint64(m.MaxMarketsPerMatch))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowParams
 			}
 			if iNdEx >= l {
@@ -276,6 +1018,770 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGasLimit", wireType)
+			}
+			m.MaxCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasPrice", wireType)
+			}
+			m.CallbackGasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallbackGasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityBlocks", wireType)
+			}
+			m.FinalityBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalityBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCouncilMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataCouncilMembers = append(m.DataCouncilMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectionThreshold", wireType)
+			}
+			m.CorrectionThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorrectionThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectionVotingBlocks", wireType)
+			}
+			m.CorrectionVotingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorrectionVotingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleReportWindow", wireType)
+			}
+			m.OracleReportWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleReportWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedReports", wireType)
+			}
+			m.MaxMissedReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviatingReports", wireType)
+			}
+			m.MaxDeviatingReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeviatingReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionOracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionOracle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.OracleJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardEpochBlocks", wireType)
+			}
+			m.OracleRewardEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleRewardEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRewardPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleRewardPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReporterBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReporterBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterQuorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReporterQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReporterSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReporterReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterUnbondingBlocks", wireType)
+			}
+			m.ReporterUnbondingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReporterUnbondingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterOnlyIngestion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReporterOnlyIngestion = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportCommitBlocks", wireType)
+			}
+			m.ReportCommitBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportCommitBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportRevealBlocks", wireType)
+			}
+			m.ReportRevealBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportRevealBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterNonRevealSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReporterNonRevealSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataProviders = append(m.DataProviders, DataProvider{})
+			if err := m.DataProviders[len(m.DataProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireProviderSignatures", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireProviderSignatures = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReporters", wireType)
+			}
+			m.MinReporters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReporters |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTotalReporterBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTotalReporterBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterRewardEpochBlocks", wireType)
+			}
+			m.ReporterRewardEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReporterRewardEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterRewardEpochCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReporterRewardEpochCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketCreationDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarketCreationDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMarketsPerMatch", wireType)
+			}
+			m.MaxMarketsPerMatch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMarketsPerMatch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4,3 +4,12 @@ const (
 	// FutchainPrecompileAddress defines the address of the Futchain precompiled contract.
 	FutchainPrecompileAddress = "0x0000000000000000000000000000000000000807"
 )
+
+// Ethereum log names emitted by the futchain precompile. They must match the events of the base contract abi.
+const (
+	EvmEventNewLeague         = "NewLeague"
+	EvmEventNewMatch          = "NewMatch"
+	EvmEventMatchScoreChanged = "MatchScoreChanged"
+	EvmEventMatchFinished     = "MatchFinished"
+	EvmEventMatchFinalized    = "MatchFinalized"
+)
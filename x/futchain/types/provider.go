@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// CryptoPubKey returns the public key of the provider.
+func (p DataProvider) CryptoPubKey() (cryptotypes.PubKey, error) {
+	switch p.KeyType {
+	case PROVIDER_KEY_TYPE_ED25519:
+		if len(p.PubKey) != ed25519.PubKeySize {
+			return nil, fmt.Errorf("ed25519 key of provider %s must be %d bytes, got %d", p.Name, ed25519.PubKeySize, len(p.PubKey))
+		}
+		return &ed25519.PubKey{Key: p.PubKey}, nil
+	case PROVIDER_KEY_TYPE_SECP256K1:
+		if len(p.PubKey) != secp256k1.PubKeySize {
+			return nil, fmt.Errorf("secp256k1 key of provider %s must be %d bytes, got %d", p.Name, secp256k1.PubKeySize, len(p.PubKey))
+		}
+		return &secp256k1.PubKey{Key: p.PubKey}, nil
+	default:
+		return nil, fmt.Errorf("invalid key type %s of provider %s", p.KeyType, p.Name)
+	}
+}
+
+// ProviderPubKeys returns the public keys of the data providers by name.
+func ProviderPubKeys(providers []DataProvider) (map[string]cryptotypes.PubKey, error) {
+	pubKeys := make(map[string]cryptotypes.PubKey, len(providers))
+	for _, provider := range providers {
+		if provider.Name == "" {
+			return nil, fmt.Errorf("empty data provider name")
+		}
+		if _, found := pubKeys[provider.Name]; found {
+			return nil, fmt.Errorf("duplicate data provider %s", provider.Name)
+		}
+		pubKey, err := provider.CryptoPubKey()
+		if err != nil {
+			return nil, err
+		}
+		pubKeys[provider.Name] = pubKey
+	}
+	return pubKeys, nil
+}
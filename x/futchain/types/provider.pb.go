@@ -0,0 +1,842 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: futchain/futchain/v1/provider.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ProviderKeyType is the signature scheme of a data provider key.
+type ProviderKeyType int32
+
+const (
+	PROVIDER_KEY_TYPE_UNSPECIFIED ProviderKeyType = 0
+	// PROVIDER_KEY_TYPE_ED25519 keys sign the payload itself.
+	PROVIDER_KEY_TYPE_ED25519 ProviderKeyType = 1
+	// PROVIDER_KEY_TYPE_SECP256K1 keys sign the sha256 hash of the payload,
+	// with 64 byte r || s signatures.
+	PROVIDER_KEY_TYPE_SECP256K1 ProviderKeyType = 2
+)
+
+var ProviderKeyType_name = map[int32]string{
+	0: "PROVIDER_KEY_TYPE_UNSPECIFIED",
+	1: "PROVIDER_KEY_TYPE_ED25519",
+	2: "PROVIDER_KEY_TYPE_SECP256K1",
+}
+
+var ProviderKeyType_value = map[string]int32{
+	"PROVIDER_KEY_TYPE_UNSPECIFIED": 0,
+	"PROVIDER_KEY_TYPE_ED25519":     1,
+	"PROVIDER_KEY_TYPE_SECP256K1":   2,
+}
+
+func (x ProviderKeyType) String() string {
+	return proto.EnumName(ProviderKeyType_name, int32(x))
+}
+
+func (ProviderKeyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e50039bd0a18ab58, []int{0}
+}
+
+// DataProvider is a data provider whose signed payloads are verified on
+// ingestion.
+type DataProvider struct {
+	// name is the provider name payloads are attested with.
+	Name    string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	KeyType ProviderKeyType `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=futchain.futchain.v1.ProviderKeyType" json:"key_type,omitempty"`
+	// pub_key is the raw public key: 32 bytes for ed25519, 33 compressed bytes
+	// for secp256k1.
+	PubKey []byte `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *DataProvider) Reset()         { *m = DataProvider{} }
+func (m *DataProvider) String() string { return proto.CompactTextString(m) }
+func (*DataProvider) ProtoMessage()    {}
+func (*DataProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e50039bd0a18ab58, []int{0}
+}
+func (m *DataProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataProvider.Merge(m, src)
+}
+func (m *DataProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataProvider proto.InternalMessageInfo
+
+func (m *DataProvider) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DataProvider) GetKeyType() ProviderKeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return PROVIDER_KEY_TYPE_UNSPECIFIED
+}
+
+func (m *DataProvider) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// MatchAttestation is the signature of a data provider over the payload a
+// match was ingested from, so the origin of the data can be verified
+// off-chain.
+type MatchAttestation struct {
+	MatchId  int64  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// payload is the signed payload, the JSON encoding of the match by the
+	// provider.
+	Payload   []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// height is the block height the attestation was ingested at.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MatchAttestation) Reset()         { *m = MatchAttestation{} }
+func (m *MatchAttestation) String() string { return proto.CompactTextString(m) }
+func (*MatchAttestation) ProtoMessage()    {}
+func (*MatchAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e50039bd0a18ab58, []int{1}
+}
+func (m *MatchAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatchAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MatchAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MatchAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchAttestation.Merge(m, src)
+}
+func (m *MatchAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatchAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchAttestation proto.InternalMessageInfo
+
+func (m *MatchAttestation) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *MatchAttestation) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MatchAttestation) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *MatchAttestation) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *MatchAttestation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("futchain.futchain.v1.ProviderKeyType", ProviderKeyType_name, ProviderKeyType_value)
+	proto.RegisterType((*DataProvider)(nil), "futchain.futchain.v1.DataProvider")
+	proto.RegisterType((*MatchAttestation)(nil), "futchain.futchain.v1.MatchAttestation")
+}
+
+func init() {
+	proto.RegisterFile("futchain/futchain/v1/provider.proto", fileDescriptor_e50039bd0a18ab58)
+}
+
+var fileDescriptor_e50039bd0a18ab58 = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0x2b, 0x2d, 0x49,
+	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x33, 0xca, 0x0c, 0xf5, 0x0b, 0x8a, 0xf2, 0xcb, 0x32, 0x53,
+	0x52, 0x8b, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x60, 0x72, 0x7a, 0x70, 0x46, 0x99,
+	0xa1, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x81, 0x3e, 0x88, 0x05, 0x51, 0xab, 0xd4, 0xcc,
+	0xc8, 0xc5, 0xe3, 0x92, 0x58, 0x92, 0x18, 0x00, 0x35, 0x42, 0x48, 0x88, 0x8b, 0x25, 0x2f, 0x31,
+	0x37, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xcc, 0x16, 0x72, 0xe0, 0xe2, 0xc8, 0x4e,
+	0xad, 0x8c, 0x2f, 0xa9, 0x2c, 0x48, 0x95, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x33, 0x52, 0xd5, 0xc3,
+	0x66, 0x87, 0x1e, 0xcc, 0x14, 0xef, 0xd4, 0xca, 0x90, 0xca, 0x82, 0xd4, 0x20, 0xf6, 0x6c, 0x08,
+	0x43, 0x48, 0x9c, 0x8b, 0xbd, 0xa0, 0x34, 0x29, 0x3e, 0x3b, 0xb5, 0x52, 0x82, 0x59, 0x81, 0x51,
+	0x83, 0x27, 0x88, 0xad, 0xa0, 0x34, 0xc9, 0x3b, 0xb5, 0xd2, 0x8a, 0xe5, 0xc5, 0x02, 0x79, 0x46,
+	0xa5, 0x99, 0x8c, 0x5c, 0x02, 0xbe, 0x89, 0x25, 0xc9, 0x19, 0x8e, 0x25, 0x25, 0xa9, 0xc5, 0x25,
+	0x89, 0x25, 0x99, 0xf9, 0x79, 0x42, 0x92, 0x5c, 0x1c, 0xb9, 0x20, 0xb1, 0xf8, 0xcc, 0x14, 0xb0,
+	0x6b, 0x98, 0x83, 0xd8, 0xc1, 0x7c, 0xcf, 0x14, 0x21, 0x29, 0x2e, 0x0e, 0x98, 0x9f, 0xc1, 0x0e,
+	0xe2, 0x0c, 0x82, 0xf3, 0x85, 0x24, 0xb8, 0xd8, 0x0b, 0x12, 0x2b, 0x73, 0xf2, 0x13, 0x53, 0xa0,
+	0x56, 0xc1, 0xb8, 0x42, 0x32, 0x5c, 0x9c, 0xc5, 0x99, 0xe9, 0x79, 0x89, 0x25, 0xa5, 0x45, 0xa9,
+	0x12, 0x2c, 0x60, 0x39, 0x84, 0x80, 0x90, 0x18, 0x17, 0x5b, 0x46, 0x6a, 0x66, 0x7a, 0x46, 0x89,
+	0x04, 0x2b, 0xd8, 0x32, 0x28, 0x4f, 0xab, 0x8a, 0x8b, 0x1f, 0xcd, 0x5b, 0x42, 0x8a, 0x5c, 0xb2,
+	0x01, 0x41, 0xfe, 0x61, 0x9e, 0x2e, 0xae, 0x41, 0xf1, 0xde, 0xae, 0x91, 0xf1, 0x21, 0x91, 0x01,
+	0xae, 0xf1, 0xa1, 0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae, 0x2e, 0x02, 0x0c, 0x42,
+	0xb2, 0x5c, 0x92, 0x98, 0x4a, 0x5c, 0x5d, 0x8c, 0x4c, 0x4d, 0x0d, 0x2d, 0x05, 0x18, 0x85, 0xe4,
+	0xb9, 0xa4, 0x31, 0xa5, 0x83, 0x5d, 0x9d, 0x03, 0x8c, 0x4c, 0xcd, 0xbc, 0x0d, 0x05, 0x98, 0xa4,
+	0x58, 0x3a, 0x16, 0xcb, 0x31, 0x38, 0xb9, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
+	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
+	0x43, 0x94, 0x76, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x51, 0x62,
+	0x66, 0x5a, 0x41, 0x25, 0x22, 0x45, 0x54, 0x20, 0x98, 0xa0, 0x28, 0x2b, 0x4e, 0x62, 0x03, 0xc7,
+	0xb5, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x65, 0x01, 0x3d, 0x55, 0x3e, 0x02, 0x00, 0x00,
+}
+
+func (this *DataProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataProvider)
+	if !ok {
+		that2, ok := that.(DataProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.KeyType != that1.KeyType {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	return true
+}
+func (m *DataProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MatchAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatchAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MatchAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProvider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DataProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovProvider(uint64(m.KeyType))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *MatchAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovProvider(uint64(m.MatchId))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	return n
+}
+
+func sovProvider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProvider(x uint64) (n int) {
+	return sovProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DataProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= ProviderKeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MatchAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatchAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatchAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProvider(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProvider
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProvider
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProvider
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProvider        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProvider          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProvider = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,8 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -161,8 +164,7 @@ func (m *QueryTeamRequest) GetId() int64 {
 
 // QueryTeamResponse defines the QueryTeamResponse message.
 type QueryTeamResponse struct {
-	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Team Team `protobuf:"bytes,3,opt,name=team,proto3" json:"team"`
 }
 
 func (m *QueryTeamResponse) Reset()         { *m = QueryTeamResponse{} }
@@ -198,18 +200,11 @@ func (m *QueryTeamResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTeamResponse proto.InternalMessageInfo
 
-func (m *QueryTeamResponse) GetId() int64 {
+func (m *QueryTeamResponse) GetTeam() Team {
 	if m != nil {
-		return m.Id
-	}
-	return 0
-}
-
-func (m *QueryTeamResponse) GetName() string {
-	if m != nil {
-		return m.Name
+		return m.Team
 	}
-	return ""
+	return Team{}
 }
 
 // QueryLeagueRequest defines the QueryLeagueRequest message.
@@ -259,9 +254,7 @@ func (m *QueryLeagueRequest) GetId() int64 {
 
 // QueryLeagueResponse defines the QueryLeagueResponse message.
 type QueryLeagueResponse struct {
-	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	GroupName string `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	League League `protobuf:"bytes,4,opt,name=league,proto3" json:"league"`
 }
 
 func (m *QueryLeagueResponse) Reset()         { *m = QueryLeagueResponse{} }
@@ -297,25 +290,11 @@ func (m *QueryLeagueResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryLeagueResponse proto.InternalMessageInfo
 
-func (m *QueryLeagueResponse) GetId() int64 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
-
-func (m *QueryLeagueResponse) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *QueryLeagueResponse) GetGroupName() string {
+func (m *QueryLeagueResponse) GetLeague() League {
 	if m != nil {
-		return m.GroupName
+		return m.League
 	}
-	return ""
+	return League{}
 }
 
 // QueryMatchRequest defines the QueryMatchRequest message.
@@ -365,19 +344,7 @@ func (m *QueryMatchRequest) GetId() int64 {
 
 // QueryMatchResponse defines the QueryMatchResponse message.
 type QueryMatchResponse struct {
-	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	LeagueId  int64  `protobuf:"varint,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
-	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Time      string `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
-	HomeId    int64  `protobuf:"varint,5,opt,name=home_id,json=homeId,proto3" json:"home_id,omitempty"`
-	HomeScore int64  `protobuf:"varint,6,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
-	HomeName  string `protobuf:"bytes,7,opt,name=home_name,json=homeName,proto3" json:"home_name,omitempty"`
-	AwayId    int64  `protobuf:"varint,8,opt,name=away_id,json=awayId,proto3" json:"away_id,omitempty"`
-	AwayScore int64  `protobuf:"varint,9,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
-	AwayName  string `protobuf:"bytes,10,opt,name=away_name,json=awayName,proto3" json:"away_name,omitempty"`
-	Started   bool   `protobuf:"varint,11,opt,name=started,proto3" json:"started,omitempty"`
-	Cancelled bool   `protobuf:"varint,12,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
-	Finished  bool   `protobuf:"varint,13,opt,name=finished,proto3" json:"finished,omitempty"`
+	Match Match `protobuf:"bytes,15,opt,name=match,proto3" json:"match"`
 }
 
 func (m *QueryMatchResponse) Reset()         { *m = QueryMatchResponse{} }
@@ -413,95 +380,11 @@ func (m *QueryMatchResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryMatchResponse proto.InternalMessageInfo
 
-func (m *QueryMatchResponse) GetId() int64 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
-
-func (m *QueryMatchResponse) GetLeagueId() int64 {
-	if m != nil {
-		return m.LeagueId
-	}
-	return 0
-}
-
-func (m *QueryMatchResponse) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *QueryMatchResponse) GetTime() string {
-	if m != nil {
-		return m.Time
-	}
-	return ""
-}
-
-func (m *QueryMatchResponse) GetHomeId() int64 {
-	if m != nil {
-		return m.HomeId
-	}
-	return 0
-}
-
-func (m *QueryMatchResponse) GetHomeScore() int64 {
-	if m != nil {
-		return m.HomeScore
-	}
-	return 0
-}
-
-func (m *QueryMatchResponse) GetHomeName() string {
-	if m != nil {
-		return m.HomeName
-	}
-	return ""
-}
-
-func (m *QueryMatchResponse) GetAwayId() int64 {
-	if m != nil {
-		return m.AwayId
-	}
-	return 0
-}
-
-func (m *QueryMatchResponse) GetAwayScore() int64 {
-	if m != nil {
-		return m.AwayScore
-	}
-	return 0
-}
-
-func (m *QueryMatchResponse) GetAwayName() string {
-	if m != nil {
-		return m.AwayName
-	}
-	return ""
-}
-
-func (m *QueryMatchResponse) GetStarted() bool {
-	if m != nil {
-		return m.Started
-	}
-	return false
-}
-
-func (m *QueryMatchResponse) GetCancelled() bool {
-	if m != nil {
-		return m.Cancelled
-	}
-	return false
-}
-
-func (m *QueryMatchResponse) GetFinished() bool {
+func (m *QueryMatchResponse) GetMatch() Match {
 	if m != nil {
-		return m.Finished
+		return m.Match
 	}
-	return false
+	return Match{}
 }
 
 type QueryUnfinishedMatchesRequest struct {
@@ -584,885 +467,11191 @@ func (m *QueryUnfinishedMatchesResponse) GetIds() []int64 {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "futchain.futchain.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "futchain.futchain.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryTeamRequest)(nil), "futchain.futchain.v1.QueryTeamRequest")
-	proto.RegisterType((*QueryTeamResponse)(nil), "futchain.futchain.v1.QueryTeamResponse")
-	proto.RegisterType((*QueryLeagueRequest)(nil), "futchain.futchain.v1.QueryLeagueRequest")
-	proto.RegisterType((*QueryLeagueResponse)(nil), "futchain.futchain.v1.QueryLeagueResponse")
-	proto.RegisterType((*QueryMatchRequest)(nil), "futchain.futchain.v1.QueryMatchRequest")
-	proto.RegisterType((*QueryMatchResponse)(nil), "futchain.futchain.v1.QueryMatchResponse")
-	proto.RegisterType((*QueryUnfinishedMatchesRequest)(nil), "futchain.futchain.v1.QueryUnfinishedMatchesRequest")
-	proto.RegisterType((*QueryUnfinishedMatchesResponse)(nil), "futchain.futchain.v1.QueryUnfinishedMatchesResponse")
+// QueryMarketRequest defines the QueryMarketRequest message.
+type QueryMarketRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("futchain/futchain/v1/query.proto", fileDescriptor_1d8828dc5742c02c) }
-
-var fileDescriptor_1d8828dc5742c02c = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0xf6, 0x17, 0xed, 0xa0, 0x06, 0x46, 0x12, 0x37, 0x15, 0x4a, 0x5d, 0x14, 0x16, 0x48,
-	0xba, 0x14, 0x8c, 0x1e, 0x4d, 0x48, 0x3c, 0x90, 0xa8, 0xd1, 0xf5, 0x47, 0x8c, 0x17, 0x32, 0xdd,
-	0x1d, 0xb6, 0x93, 0x74, 0x77, 0x96, 0xfd, 0x81, 0x36, 0xc6, 0x8b, 0x17, 0x13, 0x3d, 0x68, 0xe2,
-	0x3f, 0xe1, 0xd1, 0x93, 0x7f, 0x03, 0x47, 0x12, 0x2f, 0x9e, 0x8c, 0x01, 0x13, 0xfe, 0x04, 0xaf,
-	0x66, 0xde, 0x4c, 0x4b, 0x11, 0xba, 0xe0, 0xa5, 0x79, 0xf3, 0xbd, 0xf7, 0xbe, 0xef, 0x7b, 0xb3,
-	0xf3, 0x52, 0xd4, 0xd8, 0x4a, 0x13, 0xa7, 0x43, 0x58, 0x60, 0x0d, 0x82, 0x9d, 0x96, 0xb5, 0x9d,
-	0xd2, 0xa8, 0xd7, 0x0c, 0x23, 0x9e, 0x70, 0x3c, 0xd5, 0x4f, 0x34, 0x07, 0xc1, 0x4e, 0xab, 0x36,
-	0x49, 0x7c, 0x16, 0x70, 0x0b, 0x7e, 0x65, 0x61, 0x6d, 0xc9, 0xe1, 0xb1, 0xcf, 0x63, 0xab, 0x4d,
-	0x62, 0x2a, 0x19, 0xac, 0x9d, 0x56, 0x9b, 0x26, 0xa4, 0x65, 0x85, 0xc4, 0x63, 0x01, 0x49, 0x18,
-	0x0f, 0x54, 0xed, 0xb5, 0x53, 0x65, 0x43, 0x12, 0x11, 0x3f, 0x56, 0x25, 0x53, 0x1e, 0xf7, 0x38,
-	0x84, 0x96, 0x88, 0x14, 0x3a, 0xed, 0x71, 0xee, 0x75, 0xa9, 0x45, 0x42, 0x66, 0x91, 0x20, 0xe0,
-	0x09, 0xb0, 0xaa, 0x1e, 0x63, 0x0a, 0xe1, 0x47, 0x42, 0xf8, 0x21, 0x10, 0xd9, 0x74, 0x3b, 0xa5,
-	0x71, 0x62, 0x3c, 0x43, 0x97, 0x8f, 0xa1, 0x71, 0xc8, 0x83, 0x98, 0xe2, 0x3b, 0xa8, 0x2c, 0x05,
-	0x75, 0xad, 0xa1, 0x99, 0xe3, 0xab, 0xd3, 0xcd, 0xd3, 0x26, 0x6d, 0xca, 0xae, 0xf5, 0xea, 0xee,
-	0xcf, 0xd9, 0xdc, 0x97, 0xc3, 0xaf, 0x4b, 0x9a, 0xad, 0xda, 0x0c, 0x03, 0x4d, 0x00, 0xef, 0x13,
-	0x4a, 0x7c, 0xa5, 0x85, 0x2f, 0xa1, 0x3c, 0x73, 0x81, 0xb0, 0x60, 0xe7, 0x99, 0x6b, 0xdc, 0x46,
-	0x93, 0x43, 0x35, 0x4a, 0xf9, 0x9f, 0x22, 0x8c, 0x51, 0x31, 0x20, 0x3e, 0xd5, 0xf3, 0x0d, 0xcd,
-	0xac, 0xda, 0x10, 0x1b, 0xd7, 0xd5, 0x28, 0xf7, 0x28, 0xf1, 0x52, 0x3a, 0x8a, 0xfe, 0xb9, 0x1a,
-	0xad, 0x5f, 0x75, 0x7e, 0x01, 0x3c, 0x83, 0x90, 0x17, 0xf1, 0x34, 0xdc, 0x84, 0x4c, 0x01, 0x32,
-	0x55, 0x40, 0x1e, 0x08, 0xfd, 0x39, 0x65, 0xfc, 0x3e, 0x49, 0x9c, 0xce, 0x28, 0xf9, 0xc3, 0xbc,
-	0x72, 0xa9, 0xaa, 0x46, 0xc8, 0x5f, 0x45, 0xd5, 0x2e, 0x18, 0xdc, 0x64, 0x2e, 0x78, 0x28, 0xd8,
-	0x15, 0x09, 0x6c, 0x1c, 0x79, 0x2b, 0x0c, 0x79, 0xc3, 0xa8, 0x98, 0x30, 0x9f, 0xea, 0x45, 0x89,
-	0x89, 0x18, 0x5f, 0x41, 0x63, 0x1d, 0xee, 0x03, 0x45, 0x09, 0x28, 0xca, 0xe2, 0xb8, 0xe1, 0x8a,
-	0x41, 0x20, 0x11, 0x3b, 0x3c, 0xa2, 0x7a, 0x19, 0x72, 0x55, 0x81, 0x3c, 0x16, 0x80, 0x10, 0x87,
-	0x34, 0x88, 0x8c, 0x01, 0x61, 0x45, 0x00, 0x62, 0x4a, 0x41, 0x4a, 0x5e, 0x92, 0x9e, 0x20, 0xad,
-	0x48, 0x52, 0x71, 0x94, 0xa4, 0x90, 0x90, 0xa4, 0x55, 0x49, 0x2a, 0x90, 0x01, 0x29, 0xa4, 0x81,
-	0x14, 0x49, 0x52, 0x01, 0x00, 0xa9, 0x8e, 0xc6, 0xe2, 0x84, 0x44, 0x09, 0x75, 0xf5, 0xf1, 0x86,
-	0x66, 0x56, 0xec, 0xfe, 0x11, 0x4f, 0xa3, 0xaa, 0x43, 0x02, 0x87, 0x76, 0xbb, 0xd4, 0xd5, 0x2f,
-	0x40, 0xee, 0x08, 0xc0, 0x35, 0x54, 0xd9, 0x62, 0x01, 0x8b, 0x3b, 0xd4, 0xd5, 0x2f, 0x42, 0x72,
-	0x70, 0x36, 0x66, 0xd1, 0x0c, 0x5c, 0xf4, 0xd3, 0xa0, 0x0f, 0xc1, 0x95, 0xd3, 0xc1, 0x23, 0x5f,
-	0x45, 0xf5, 0x51, 0x05, 0xea, 0xab, 0x4c, 0xa0, 0x02, 0x73, 0xc5, 0x63, 0x2f, 0x98, 0x05, 0x5b,
-	0x84, 0xab, 0x7f, 0x4a, 0xa8, 0x04, 0x4d, 0xf8, 0xbd, 0x86, 0xca, 0xf2, 0xa1, 0x63, 0xf3, 0xf4,
-	0x35, 0x38, 0xb9, 0x57, 0xb5, 0xc5, 0x73, 0x54, 0x4a, 0x6d, 0x63, 0xf9, 0xed, 0xf7, 0xdf, 0x9f,
-	0xf3, 0x37, 0xf0, 0x9c, 0x15, 0x11, 0xb6, 0x15, 0xf6, 0xac, 0x8c, 0xfd, 0xc7, 0xef, 0x34, 0x54,
-	0x14, 0xfb, 0x82, 0xe7, 0x33, 0x04, 0x86, 0x96, 0xae, 0xb6, 0x70, 0x66, 0x9d, 0xb2, 0xd1, 0x04,
-	0x1b, 0x26, 0x9e, 0xcf, 0xb4, 0x91, 0x50, 0xe2, 0x5b, 0xaf, 0x99, 0xfb, 0x06, 0x7f, 0xd4, 0x50,
-	0x59, 0xae, 0x56, 0xe6, 0xb5, 0x1c, 0xdb, 0xd1, 0xcc, 0x6b, 0x39, 0xbe, 0xa7, 0xc6, 0x0a, 0xf8,
-	0x59, 0xc2, 0x66, 0xa6, 0x1f, 0xb9, 0x2a, 0xd2, 0xd1, 0x07, 0x0d, 0x95, 0xe0, 0xc3, 0xe2, 0xac,
-	0xa1, 0x87, 0x97, 0xb6, 0x66, 0x9e, 0x5d, 0xa8, 0xec, 0x58, 0x60, 0x67, 0x11, 0x2f, 0x64, 0xda,
-	0xf1, 0x45, 0x8f, 0x74, 0xf3, 0x4d, 0x43, 0x93, 0x27, 0x1e, 0x1c, 0x5e, 0xcb, 0x10, 0x1c, 0xf5,
-	0x7e, 0x6b, 0x37, 0xff, 0xaf, 0x49, 0x39, 0xbe, 0x05, 0x8e, 0x57, 0x70, 0x33, 0xd3, 0x71, 0x3a,
-	0xe8, 0xf7, 0x65, 0xff, 0xfa, 0xdd, 0xdd, 0xfd, 0xba, 0xb6, 0xb7, 0x5f, 0xd7, 0x7e, 0xed, 0xd7,
-	0xb5, 0x4f, 0x07, 0xf5, 0xdc, 0xde, 0x41, 0x3d, 0xf7, 0xe3, 0xa0, 0x9e, 0x7b, 0xb1, 0xec, 0xb1,
-	0xa4, 0x93, 0xb6, 0x9b, 0x0e, 0xf7, 0x4f, 0x70, 0xbe, 0x3a, 0x0a, 0x93, 0x5e, 0x48, 0xe3, 0x76,
-	0x19, 0xfe, 0x76, 0xd6, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xba, 0x87, 0x3e, 0x1f, 0x46, 0x07,
-	0x00, 0x00,
+func (m *QueryMarketRequest) Reset()         { *m = QueryMarketRequest{} }
+func (m *QueryMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketRequest) ProtoMessage()    {}
+func (*QueryMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{10}
+}
+func (m *QueryMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketRequest.Merge(m, src)
+}
+func (m *QueryMarketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryMarketRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Team Queries a list of Team items.
-	Team(ctx context.Context, in *QueryTeamRequest, opts ...grpc.CallOption) (*QueryTeamResponse, error)
-	// League Queries a list of League items.
-	League(ctx context.Context, in *QueryLeagueRequest, opts ...grpc.CallOption) (*QueryLeagueResponse, error)
-	// Match Queries a list of Match items.
-	Match(ctx context.Context, in *QueryMatchRequest, opts ...grpc.CallOption) (*QueryMatchResponse, error)
-	UnfinishedMatches(ctx context.Context, in *QueryUnfinishedMatchesRequest, opts ...grpc.CallOption) (*QueryUnfinishedMatchesResponse, error)
+func (m *QueryMarketRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryMarketResponse defines the QueryMarketResponse message.
+type QueryMarketResponse struct {
+	Market Market `protobuf:"bytes,1,opt,name=market,proto3" json:"market"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryMarketResponse) Reset()         { *m = QueryMarketResponse{} }
+func (m *QueryMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketResponse) ProtoMessage()    {}
+func (*QueryMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{11}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketResponse.Merge(m, src)
+}
+func (m *QueryMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Team(ctx context.Context, in *QueryTeamRequest, opts ...grpc.CallOption) (*QueryTeamResponse, error) {
-	out := new(QueryTeamResponse)
-	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Team", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMarketResponse proto.InternalMessageInfo
+
+func (m *QueryMarketResponse) GetMarket() Market {
+	if m != nil {
+		return m.Market
 	}
-	return out, nil
+	return Market{}
 }
 
-func (c *queryClient) League(ctx context.Context, in *QueryLeagueRequest, opts ...grpc.CallOption) (*QueryLeagueResponse, error) {
-	out := new(QueryLeagueResponse)
-	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/League", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryMatchMarketsRequest defines the QueryMatchMarketsRequest message.
+type QueryMatchMarketsRequest struct {
+	MatchId    int64              `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Match(ctx context.Context, in *QueryMatchRequest, opts ...grpc.CallOption) (*QueryMatchResponse, error) {
-	out := new(QueryMatchResponse)
-	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Match", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMatchMarketsRequest) Reset()         { *m = QueryMatchMarketsRequest{} }
+func (m *QueryMatchMarketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchMarketsRequest) ProtoMessage()    {}
+func (*QueryMatchMarketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{12}
+}
+func (m *QueryMatchMarketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchMarketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryMatchMarketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchMarketsRequest.Merge(m, src)
+}
+func (m *QueryMatchMarketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchMarketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchMarketsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) UnfinishedMatches(ctx context.Context, in *QueryUnfinishedMatchesRequest, opts ...grpc.CallOption) (*QueryUnfinishedMatchesResponse, error) {
-	out := new(QueryUnfinishedMatchesResponse)
-	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/UnfinishedMatches", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMatchMarketsRequest proto.InternalMessageInfo
+
+func (m *QueryMatchMarketsRequest) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Team Queries a list of Team items.
-	Team(context.Context, *QueryTeamRequest) (*QueryTeamResponse, error)
-	// League Queries a list of League items.
-	League(context.Context, *QueryLeagueRequest) (*QueryLeagueResponse, error)
-	// Match Queries a list of Match items.
-	Match(context.Context, *QueryMatchRequest) (*QueryMatchResponse, error)
-	UnfinishedMatches(context.Context, *QueryUnfinishedMatchesRequest) (*QueryUnfinishedMatchesResponse, error)
+func (m *QueryMatchMarketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryMatchMarketsResponse defines the QueryMatchMarketsResponse message.
+type QueryMatchMarketsResponse struct {
+	Markets    []Market            `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryMatchMarketsResponse) Reset()         { *m = QueryMatchMarketsResponse{} }
+func (m *QueryMatchMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchMarketsResponse) ProtoMessage()    {}
+func (*QueryMatchMarketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{13}
 }
-func (*UnimplementedQueryServer) Team(ctx context.Context, req *QueryTeamRequest) (*QueryTeamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Team not implemented")
+func (m *QueryMatchMarketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) League(ctx context.Context, req *QueryLeagueRequest) (*QueryLeagueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method League not implemented")
+func (m *QueryMatchMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchMarketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Match(ctx context.Context, req *QueryMatchRequest) (*QueryMatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Match not implemented")
+func (m *QueryMatchMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchMarketsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) UnfinishedMatches(ctx context.Context, req *QueryUnfinishedMatchesRequest) (*QueryUnfinishedMatchesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnfinishedMatches not implemented")
+func (m *QueryMatchMarketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchMarketsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryMatchMarketsResponse proto.InternalMessageInfo
+
+func (m *QueryMatchMarketsResponse) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/futchain.futchain.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryMatchMarketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Team_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTeamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Team(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/futchain.futchain.v1.Query/Team",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Team(ctx, req.(*QueryTeamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryMarketStakesRequest defines the QueryMarketStakesRequest message.
+type QueryMarketStakesRequest struct {
+	MarketId   uint64             `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_League_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLeagueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).League(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/futchain.futchain.v1.Query/League",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).League(ctx, req.(*QueryLeagueRequest))
+func (m *QueryMarketStakesRequest) Reset()         { *m = QueryMarketStakesRequest{} }
+func (m *QueryMarketStakesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketStakesRequest) ProtoMessage()    {}
+func (*QueryMarketStakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{14}
+}
+func (m *QueryMarketStakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketStakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketStakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMarketStakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketStakesRequest.Merge(m, src)
+}
+func (m *QueryMarketStakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketStakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketStakesRequest.DiscardUnknown(m)
 }
 
-func _Query_Match_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Match(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/futchain.futchain.v1.Query/Match",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Match(ctx, req.(*QueryMatchRequest))
+var xxx_messageInfo_QueryMarketStakesRequest proto.InternalMessageInfo
+
+func (m *QueryMarketStakesRequest) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_UnfinishedMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnfinishedMatchesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UnfinishedMatches(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/futchain.futchain.v1.Query/UnfinishedMatches",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnfinishedMatches(ctx, req.(*QueryUnfinishedMatchesRequest))
+func (m *QueryMarketStakesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "futchain.futchain.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Team",
-			Handler:    _Query_Team_Handler,
-		},
-		{
-			MethodName: "League",
-			Handler:    _Query_League_Handler,
-		},
-		{
-			MethodName: "Match",
-			Handler:    _Query_Match_Handler,
-		},
-		{
-			MethodName: "UnfinishedMatches",
-			Handler:    _Query_UnfinishedMatches_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "futchain/futchain/v1/query.proto",
+// QueryMarketStakesResponse defines the QueryMarketStakesResponse message.
+type QueryMarketStakesResponse struct {
+	Stakes     []Stake             `protobuf:"bytes,1,rep,name=stakes,proto3" json:"stakes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMarketStakesResponse) Reset()         { *m = QueryMarketStakesResponse{} }
+func (m *QueryMarketStakesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketStakesResponse) ProtoMessage()    {}
+func (*QueryMarketStakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{15}
+}
+func (m *QueryMarketStakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketStakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketStakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMarketStakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketStakesResponse.Merge(m, src)
+}
+func (m *QueryMarketStakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketStakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketStakesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryMarketStakesResponse proto.InternalMessageInfo
+
+func (m *QueryMarketStakesResponse) GetStakes() []Stake {
+	if m != nil {
+		return m.Stakes
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMarketStakesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOutcomeTokensRequest defines the QueryOutcomeTokensRequest message.
+type QueryOutcomeTokensRequest struct {
+	MatchId int64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryOutcomeTokensRequest) Reset()         { *m = QueryOutcomeTokensRequest{} }
+func (m *QueryOutcomeTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutcomeTokensRequest) ProtoMessage()    {}
+func (*QueryOutcomeTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{16}
+}
+func (m *QueryOutcomeTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutcomeTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutcomeTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryOutcomeTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutcomeTokensRequest.Merge(m, src)
+}
+func (m *QueryOutcomeTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutcomeTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutcomeTokensRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTeamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOutcomeTokensRequest proto.InternalMessageInfo
+
+func (m *QueryOutcomeTokensRequest) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryTeamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOutcomeTokensResponse defines the QueryOutcomeTokensResponse message.
+type QueryOutcomeTokensResponse struct {
+	Tokens []OutcomeToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+	// collateral locked for the outstanding complete sets of the match.
+	Collateral types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
 }
 
-func (m *QueryTeamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryOutcomeTokensResponse) Reset()         { *m = QueryOutcomeTokensResponse{} }
+func (m *QueryOutcomeTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutcomeTokensResponse) ProtoMessage()    {}
+func (*QueryOutcomeTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{17}
+}
+func (m *QueryOutcomeTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutcomeTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutcomeTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOutcomeTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutcomeTokensResponse.Merge(m, src)
+}
+func (m *QueryOutcomeTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutcomeTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutcomeTokensResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTeamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOutcomeTokensResponse proto.InternalMessageInfo
+
+func (m *QueryOutcomeTokensResponse) GetTokens() []OutcomeToken {
+	if m != nil {
+		return m.Tokens
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTeamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOutcomeTokensResponse) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
 }
 
-func (m *QueryTeamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// QueryCorrectionRequest defines the QueryCorrectionRequest message.
+type QueryCorrectionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryLeagueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCorrectionRequest) Reset()         { *m = QueryCorrectionRequest{} }
+func (m *QueryCorrectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCorrectionRequest) ProtoMessage()    {}
+func (*QueryCorrectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{18}
 }
-
-func (m *QueryLeagueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCorrectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryLeagueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryCorrectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorrectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorrectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorrectionRequest.Merge(m, src)
+}
+func (m *QueryCorrectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorrectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorrectionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLeagueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorrectionRequest proto.InternalMessageInfo
+
+func (m *QueryCorrectionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryLeagueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCorrectionResponse defines the QueryCorrectionResponse message.
+type QueryCorrectionResponse struct {
+	Correction Correction `protobuf:"bytes,1,opt,name=correction,proto3" json:"correction"`
 }
 
-func (m *QueryLeagueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.GroupName) > 0 {
-		i -= len(m.GroupName)
-		copy(dAtA[i:], m.GroupName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GroupName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryCorrectionResponse) Reset()         { *m = QueryCorrectionResponse{} }
+func (m *QueryCorrectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCorrectionResponse) ProtoMessage()    {}
+func (*QueryCorrectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{19}
+}
+func (m *QueryCorrectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorrectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorrectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorrectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorrectionResponse.Merge(m, src)
+}
+func (m *QueryCorrectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorrectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorrectionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorrectionResponse proto.InternalMessageInfo
+
+func (m *QueryCorrectionResponse) GetCorrection() Correction {
+	if m != nil {
+		return m.Correction
 	}
-	return dAtA[:n], nil
+	return Correction{}
 }
 
-func (m *QueryMatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCorrectionsRequest defines the QueryCorrectionsRequest message.
+type QueryCorrectionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryCorrectionsRequest) Reset()         { *m = QueryCorrectionsRequest{} }
+func (m *QueryCorrectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCorrectionsRequest) ProtoMessage()    {}
+func (*QueryCorrectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{20}
+}
+func (m *QueryCorrectionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorrectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorrectionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCorrectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorrectionsRequest.Merge(m, src)
+}
+func (m *QueryCorrectionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorrectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorrectionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCorrectionsRequest proto.InternalMessageInfo
+
+func (m *QueryCorrectionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCorrectionsResponse defines the QueryCorrectionsResponse message.
+type QueryCorrectionsResponse struct {
+	Corrections []Correction        `protobuf:"bytes,1,rep,name=corrections,proto3" json:"corrections"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Finished {
-		i--
-		if m.Finished {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.Cancelled {
-		i--
-		if m.Cancelled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.Started {
-		i--
-		if m.Started {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryCorrectionsResponse) Reset()         { *m = QueryCorrectionsResponse{} }
+func (m *QueryCorrectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCorrectionsResponse) ProtoMessage()    {}
+func (*QueryCorrectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{21}
+}
+func (m *QueryCorrectionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCorrectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCorrectionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.AwayName) > 0 {
-		i -= len(m.AwayName)
-		copy(dAtA[i:], m.AwayName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AwayName)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.AwayScore != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AwayScore))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.AwayId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AwayId))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.HomeName) > 0 {
-		i -= len(m.HomeName)
-		copy(dAtA[i:], m.HomeName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.HomeName)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.HomeScore != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.HomeScore))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.HomeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.HomeId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Time) > 0 {
-		i -= len(m.Time)
-		copy(dAtA[i:], m.Time)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Time)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LeagueId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LeagueId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryUnfinishedMatchesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCorrectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCorrectionsResponse.Merge(m, src)
 }
-
-func (m *QueryUnfinishedMatchesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCorrectionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCorrectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCorrectionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUnfinishedMatchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryCorrectionsResponse proto.InternalMessageInfo
+
+func (m *QueryCorrectionsResponse) GetCorrections() []Correction {
+	if m != nil {
+		return m.Corrections
+	}
+	return nil
 }
 
-func (m *QueryUnfinishedMatchesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCorrectionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUnfinishedMatchesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorOracleInfoRequest defines the QueryValidatorOracleInfoRequest message.
+type QueryValidatorOracleInfoRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *QueryUnfinishedMatchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA3 := make([]byte, len(m.Ids)*10)
-		var j2 int
-		for _, num1 := range m.Ids {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
+func (m *QueryValidatorOracleInfoRequest) Reset()         { *m = QueryValidatorOracleInfoRequest{} }
+func (m *QueryValidatorOracleInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleInfoRequest) ProtoMessage()    {}
+func (*QueryValidatorOracleInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{22}
+}
+func (m *QueryValidatorOracleInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOracleInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintQuery(dAtA, i, uint64(j2))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorOracleInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleInfoRequest.Merge(m, src)
+}
+func (m *QueryValidatorOracleInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleInfoRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryValidatorOracleInfoRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorOracleInfoRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+// QueryValidatorOracleInfoResponse defines the QueryValidatorOracleInfoResponse message.
+type QueryValidatorOracleInfoResponse struct {
+	Info ValidatorOracleInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info"`
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorOracleInfoResponse) Reset()         { *m = QueryValidatorOracleInfoResponse{} }
+func (m *QueryValidatorOracleInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleInfoResponse) ProtoMessage()    {}
+func (*QueryValidatorOracleInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{23}
+}
+func (m *QueryValidatorOracleInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOracleInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryValidatorOracleInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleInfoResponse.Merge(m, src)
+}
+func (m *QueryValidatorOracleInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleInfoResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTeamRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+var xxx_messageInfo_QueryValidatorOracleInfoResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorOracleInfoResponse) GetInfo() ValidatorOracleInfo {
+	if m != nil {
+		return m.Info
 	}
-	return n
+	return ValidatorOracleInfo{}
 }
 
-func (m *QueryTeamResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryValidatorOracleReportsRequest defines the QueryValidatorOracleReportsRequest message.
+type QueryValidatorOracleReportsRequest struct {
+	ValidatorAddress string             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLeagueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryValidatorOracleReportsRequest) Reset()         { *m = QueryValidatorOracleReportsRequest{} }
+func (m *QueryValidatorOracleReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleReportsRequest) ProtoMessage()    {}
+func (*QueryValidatorOracleReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{24}
+}
+func (m *QueryValidatorOracleReportsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOracleReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleReportsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryValidatorOracleReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleReportsRequest.Merge(m, src)
+}
+func (m *QueryValidatorOracleReportsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleReportsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLeagueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.GroupName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryValidatorOracleReportsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorOracleReportsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryMatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *QueryValidatorOracleReportsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryMatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	if m.LeagueId != 0 {
-		n += 1 + sovQuery(uint64(m.LeagueId))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Time)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.HomeId != 0 {
-		n += 1 + sovQuery(uint64(m.HomeId))
-	}
-	if m.HomeScore != 0 {
-		n += 1 + sovQuery(uint64(m.HomeScore))
-	}
-	l = len(m.HomeName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AwayId != 0 {
-		n += 1 + sovQuery(uint64(m.AwayId))
-	}
-	if m.AwayScore != 0 {
-		n += 1 + sovQuery(uint64(m.AwayScore))
-	}
-	l = len(m.AwayName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+// QueryValidatorOracleReportsResponse defines the QueryValidatorOracleReportsResponse message.
+type QueryValidatorOracleReportsResponse struct {
+	Reports    []OracleReport      `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorOracleReportsResponse) Reset()         { *m = QueryValidatorOracleReportsResponse{} }
+func (m *QueryValidatorOracleReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOracleReportsResponse) ProtoMessage()    {}
+func (*QueryValidatorOracleReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{25}
+}
+func (m *QueryValidatorOracleReportsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOracleReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOracleReportsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Started {
-		n += 2
+}
+func (m *QueryValidatorOracleReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOracleReportsResponse.Merge(m, src)
+}
+func (m *QueryValidatorOracleReportsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOracleReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOracleReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorOracleReportsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorOracleReportsResponse) GetReports() []OracleReport {
+	if m != nil {
+		return m.Reports
 	}
-	if m.Cancelled {
-		n += 2
+	return nil
+}
+
+func (m *QueryValidatorOracleReportsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if m.Finished {
-		n += 2
+	return nil
+}
+
+// QueryOracleRewardsRequest defines the QueryOracleRewardsRequest message.
+type QueryOracleRewardsRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryOracleRewardsRequest) Reset()         { *m = QueryOracleRewardsRequest{} }
+func (m *QueryOracleRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleRewardsRequest) ProtoMessage()    {}
+func (*QueryOracleRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{26}
+}
+func (m *QueryOracleRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryOracleRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleRewardsRequest.Merge(m, src)
+}
+func (m *QueryOracleRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleRewardsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUnfinishedMatchesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryOracleRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryOracleRewardsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryUnfinishedMatchesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryOracleRewardsResponse defines the QueryOracleRewardsResponse message.
+type QueryOracleRewardsResponse struct {
+	// rewards are the claimable rewards of the validator.
+	Rewards types.Coin `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards"`
+	// epoch_weight is the stake the validator accrued with accurate reports in
+	// the current epoch.
+	EpochWeight cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=epoch_weight,json=epochWeight,proto3,customtype=cosmossdk.io/math.Int" json:"epoch_weight"`
+}
+
+func (m *QueryOracleRewardsResponse) Reset()         { *m = QueryOracleRewardsResponse{} }
+func (m *QueryOracleRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleRewardsResponse) ProtoMessage()    {}
+func (*QueryOracleRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{27}
+}
+func (m *QueryOracleRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
+}
+func (m *QueryOracleRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleRewardsResponse.Merge(m, src)
+}
+func (m *QueryOracleRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleRewardsResponse) GetRewards() types.Coin {
+	if m != nil {
+		return m.Rewards
+	}
+	return types.Coin{}
+}
+
+// QueryReporterRequest defines the QueryReporterRequest message.
+type QueryReporterRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryReporterRequest) Reset()         { *m = QueryReporterRequest{} }
+func (m *QueryReporterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReporterRequest) ProtoMessage()    {}
+func (*QueryReporterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{28}
+}
+func (m *QueryReporterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReporterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReporterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReporterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReporterRequest.Merge(m, src)
+}
+func (m *QueryReporterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReporterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReporterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReporterRequest proto.InternalMessageInfo
+
+func (m *QueryReporterRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryReporterResponse defines the QueryReporterResponse message.
+type QueryReporterResponse struct {
+	Reporter Reporter `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter"`
+}
+
+func (m *QueryReporterResponse) Reset()         { *m = QueryReporterResponse{} }
+func (m *QueryReporterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReporterResponse) ProtoMessage()    {}
+func (*QueryReporterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{29}
+}
+func (m *QueryReporterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReporterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReporterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReporterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReporterResponse.Merge(m, src)
+}
+func (m *QueryReporterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReporterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReporterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReporterResponse proto.InternalMessageInfo
+
+func (m *QueryReporterResponse) GetReporter() Reporter {
+	if m != nil {
+		return m.Reporter
+	}
+	return Reporter{}
+}
+
+// QueryReportersRequest defines the QueryReportersRequest message.
+type QueryReportersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReportersRequest) Reset()         { *m = QueryReportersRequest{} }
+func (m *QueryReportersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReportersRequest) ProtoMessage()    {}
+func (*QueryReportersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{30}
+}
+func (m *QueryReportersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReportersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReportersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReportersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportersRequest.Merge(m, src)
+}
+func (m *QueryReportersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReportersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReportersRequest proto.InternalMessageInfo
+
+func (m *QueryReportersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryReportersResponse defines the QueryReportersResponse message.
+type QueryReportersResponse struct {
+	Reporters  []Reporter          `protobuf:"bytes,1,rep,name=reporters,proto3" json:"reporters"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReportersResponse) Reset()         { *m = QueryReportersResponse{} }
+func (m *QueryReportersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReportersResponse) ProtoMessage()    {}
+func (*QueryReportersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{31}
+}
+func (m *QueryReportersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReportersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReportersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReportersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportersResponse.Merge(m, src)
+}
+func (m *QueryReportersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReportersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReportersResponse proto.InternalMessageInfo
+
+func (m *QueryReportersResponse) GetReporters() []Reporter {
+	if m != nil {
+		return m.Reporters
+	}
+	return nil
+}
+
+func (m *QueryReportersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMatchReportsRequest defines the QueryMatchReportsRequest message.
+type QueryMatchReportsRequest struct {
+	MatchId    int64              `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMatchReportsRequest) Reset()         { *m = QueryMatchReportsRequest{} }
+func (m *QueryMatchReportsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchReportsRequest) ProtoMessage()    {}
+func (*QueryMatchReportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{32}
+}
+func (m *QueryMatchReportsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchReportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchReportsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMatchReportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchReportsRequest.Merge(m, src)
+}
+func (m *QueryMatchReportsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchReportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchReportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMatchReportsRequest proto.InternalMessageInfo
+
+func (m *QueryMatchReportsRequest) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *QueryMatchReportsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMatchReportsResponse defines the QueryMatchReportsResponse message.
+type QueryMatchReportsResponse struct {
+	Reports    []MatchReport       `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMatchReportsResponse) Reset()         { *m = QueryMatchReportsResponse{} }
+func (m *QueryMatchReportsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchReportsResponse) ProtoMessage()    {}
+func (*QueryMatchReportsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{33}
+}
+func (m *QueryMatchReportsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchReportsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchReportsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMatchReportsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchReportsResponse.Merge(m, src)
+}
+func (m *QueryMatchReportsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchReportsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchReportsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMatchReportsResponse proto.InternalMessageInfo
+
+func (m *QueryMatchReportsResponse) GetReports() []MatchReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+func (m *QueryMatchReportsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryReportRoundRequest defines the QueryReportRoundRequest message.
+type QueryReportRoundRequest struct {
+}
+
+func (m *QueryReportRoundRequest) Reset()         { *m = QueryReportRoundRequest{} }
+func (m *QueryReportRoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReportRoundRequest) ProtoMessage()    {}
+func (*QueryReportRoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{34}
+}
+func (m *QueryReportRoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReportRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReportRoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReportRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportRoundRequest.Merge(m, src)
+}
+func (m *QueryReportRoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReportRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportRoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReportRoundRequest proto.InternalMessageInfo
+
+// QueryReportRoundResponse defines the QueryReportRoundResponse message.
+type QueryReportRoundResponse struct {
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// revealing is true in the reveal phase of the round.
+	Revealing bool `protobuf:"varint,2,opt,name=revealing,proto3" json:"revealing,omitempty"`
+	// commit_end_height is the last height of the commit phase.
+	CommitEndHeight int64 `protobuf:"varint,3,opt,name=commit_end_height,json=commitEndHeight,proto3" json:"commit_end_height,omitempty"`
+	// reveal_end_height is the last height of the reveal phase. Unrevealed
+	// commits are penalized at its end.
+	RevealEndHeight int64 `protobuf:"varint,4,opt,name=reveal_end_height,json=revealEndHeight,proto3" json:"reveal_end_height,omitempty"`
+}
+
+func (m *QueryReportRoundResponse) Reset()         { *m = QueryReportRoundResponse{} }
+func (m *QueryReportRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReportRoundResponse) ProtoMessage()    {}
+func (*QueryReportRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{35}
+}
+func (m *QueryReportRoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReportRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReportRoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReportRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportRoundResponse.Merge(m, src)
+}
+func (m *QueryReportRoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReportRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportRoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReportRoundResponse proto.InternalMessageInfo
+
+func (m *QueryReportRoundResponse) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *QueryReportRoundResponse) GetRevealing() bool {
+	if m != nil {
+		return m.Revealing
+	}
+	return false
+}
+
+func (m *QueryReportRoundResponse) GetCommitEndHeight() int64 {
+	if m != nil {
+		return m.CommitEndHeight
+	}
+	return 0
+}
+
+func (m *QueryReportRoundResponse) GetRevealEndHeight() int64 {
+	if m != nil {
+		return m.RevealEndHeight
+	}
+	return 0
+}
+
+// QueryMatchAttestationRequest defines the QueryMatchAttestationRequest message.
+type QueryMatchAttestationRequest struct {
+	MatchId int64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+}
+
+func (m *QueryMatchAttestationRequest) Reset()         { *m = QueryMatchAttestationRequest{} }
+func (m *QueryMatchAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchAttestationRequest) ProtoMessage()    {}
+func (*QueryMatchAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{36}
+}
+func (m *QueryMatchAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMatchAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchAttestationRequest.Merge(m, src)
+}
+func (m *QueryMatchAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMatchAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryMatchAttestationRequest) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+// QueryMatchAttestationResponse defines the QueryMatchAttestationResponse message.
+type QueryMatchAttestationResponse struct {
+	Attestation MatchAttestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation"`
+	// provider is the registered provider of the attestation. It is empty if
+	// the provider has been removed from the params since.
+	Provider DataProvider `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider"`
+}
+
+func (m *QueryMatchAttestationResponse) Reset()         { *m = QueryMatchAttestationResponse{} }
+func (m *QueryMatchAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchAttestationResponse) ProtoMessage()    {}
+func (*QueryMatchAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{37}
+}
+func (m *QueryMatchAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMatchAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchAttestationResponse.Merge(m, src)
+}
+func (m *QueryMatchAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMatchAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryMatchAttestationResponse) GetAttestation() MatchAttestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return MatchAttestation{}
+}
+
+func (m *QueryMatchAttestationResponse) GetProvider() DataProvider {
+	if m != nil {
+		return m.Provider
+	}
+	return DataProvider{}
+}
+
+// QueryLeaguesRequest defines the QueryLeaguesRequest message.
+type QueryLeaguesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLeaguesRequest) Reset()         { *m = QueryLeaguesRequest{} }
+func (m *QueryLeaguesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaguesRequest) ProtoMessage()    {}
+func (*QueryLeaguesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{38}
+}
+func (m *QueryLeaguesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaguesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaguesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLeaguesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaguesRequest.Merge(m, src)
+}
+func (m *QueryLeaguesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaguesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaguesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLeaguesRequest proto.InternalMessageInfo
+
+func (m *QueryLeaguesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLeaguesResponse defines the QueryLeaguesResponse message.
+type QueryLeaguesResponse struct {
+	Leagues    []League            `protobuf:"bytes,1,rep,name=leagues,proto3" json:"leagues"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLeaguesResponse) Reset()         { *m = QueryLeaguesResponse{} }
+func (m *QueryLeaguesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaguesResponse) ProtoMessage()    {}
+func (*QueryLeaguesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{39}
+}
+func (m *QueryLeaguesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaguesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaguesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLeaguesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaguesResponse.Merge(m, src)
+}
+func (m *QueryLeaguesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaguesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaguesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLeaguesResponse proto.InternalMessageInfo
+
+func (m *QueryLeaguesResponse) GetLeagues() []League {
+	if m != nil {
+		return m.Leagues
+	}
+	return nil
+}
+
+func (m *QueryLeaguesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTeamsRequest defines the QueryTeamsRequest message.
+type QueryTeamsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTeamsRequest) Reset()         { *m = QueryTeamsRequest{} }
+func (m *QueryTeamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTeamsRequest) ProtoMessage()    {}
+func (*QueryTeamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{40}
+}
+func (m *QueryTeamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTeamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTeamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTeamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTeamsRequest.Merge(m, src)
+}
+func (m *QueryTeamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTeamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTeamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTeamsRequest proto.InternalMessageInfo
+
+func (m *QueryTeamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTeamsResponse defines the QueryTeamsResponse message.
+type QueryTeamsResponse struct {
+	Teams      []Team              `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTeamsResponse) Reset()         { *m = QueryTeamsResponse{} }
+func (m *QueryTeamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTeamsResponse) ProtoMessage()    {}
+func (*QueryTeamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{41}
+}
+func (m *QueryTeamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTeamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTeamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTeamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTeamsResponse.Merge(m, src)
+}
+func (m *QueryTeamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTeamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTeamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTeamsResponse proto.InternalMessageInfo
+
+func (m *QueryTeamsResponse) GetTeams() []Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+func (m *QueryTeamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMatchesRequest defines the QueryMatchesRequest message. Zero filters
+// are ignored.
+type QueryMatchesRequest struct {
+	LeagueId int64 `protobuf:"varint,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	// team_id selects the matches played by the team, home or away.
+	TeamId int64 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// from_time and to_time bound the kick-off time of the matches, in unix
+	// seconds, inclusive.
+	FromTime   int64              `protobuf:"varint,3,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime     int64              `protobuf:"varint,4,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	Status     MatchStatusFilter  `protobuf:"varint,5,opt,name=status,proto3,enum=futchain.futchain.v1.MatchStatusFilter" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMatchesRequest) Reset()         { *m = QueryMatchesRequest{} }
+func (m *QueryMatchesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchesRequest) ProtoMessage()    {}
+func (*QueryMatchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{42}
+}
+func (m *QueryMatchesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMatchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchesRequest.Merge(m, src)
+}
+func (m *QueryMatchesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMatchesRequest proto.InternalMessageInfo
+
+func (m *QueryMatchesRequest) GetLeagueId() int64 {
+	if m != nil {
+		return m.LeagueId
+	}
+	return 0
+}
+
+func (m *QueryMatchesRequest) GetTeamId() int64 {
+	if m != nil {
+		return m.TeamId
+	}
+	return 0
+}
+
+func (m *QueryMatchesRequest) GetFromTime() int64 {
+	if m != nil {
+		return m.FromTime
+	}
+	return 0
+}
+
+func (m *QueryMatchesRequest) GetToTime() int64 {
+	if m != nil {
+		return m.ToTime
+	}
+	return 0
+}
+
+func (m *QueryMatchesRequest) GetStatus() MatchStatusFilter {
+	if m != nil {
+		return m.Status
+	}
+	return MATCH_STATUS_FILTER_ALL
+}
+
+func (m *QueryMatchesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMatchesResponse defines the QueryMatchesResponse message.
+type QueryMatchesResponse struct {
+	Matches    []Match             `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMatchesResponse) Reset()         { *m = QueryMatchesResponse{} }
+func (m *QueryMatchesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchesResponse) ProtoMessage()    {}
+func (*QueryMatchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{43}
+}
+func (m *QueryMatchesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMatchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchesResponse.Merge(m, src)
+}
+func (m *QueryMatchesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMatchesResponse proto.InternalMessageInfo
+
+func (m *QueryMatchesResponse) GetMatches() []Match {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+func (m *QueryMatchesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySearchTeamsRequest defines the QuerySearchTeamsRequest message.
+type QuerySearchTeamsRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// limit is the max number of teams returned, 20 by default and at most 100.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QuerySearchTeamsRequest) Reset()         { *m = QuerySearchTeamsRequest{} }
+func (m *QuerySearchTeamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchTeamsRequest) ProtoMessage()    {}
+func (*QuerySearchTeamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{44}
+}
+func (m *QuerySearchTeamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchTeamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchTeamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySearchTeamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchTeamsRequest.Merge(m, src)
+}
+func (m *QuerySearchTeamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchTeamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchTeamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySearchTeamsRequest proto.InternalMessageInfo
+
+func (m *QuerySearchTeamsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *QuerySearchTeamsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QuerySearchTeamsResponse defines the QuerySearchTeamsResponse message. The
+// teams are ordered by their matching name, the exact matches first.
+type QuerySearchTeamsResponse struct {
+	Teams []Team `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams"`
+}
+
+func (m *QuerySearchTeamsResponse) Reset()         { *m = QuerySearchTeamsResponse{} }
+func (m *QuerySearchTeamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchTeamsResponse) ProtoMessage()    {}
+func (*QuerySearchTeamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{45}
+}
+func (m *QuerySearchTeamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchTeamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchTeamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySearchTeamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchTeamsResponse.Merge(m, src)
+}
+func (m *QuerySearchTeamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchTeamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchTeamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySearchTeamsResponse proto.InternalMessageInfo
+
+func (m *QuerySearchTeamsResponse) GetTeams() []Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+// QuerySearchLeaguesRequest defines the QuerySearchLeaguesRequest message.
+type QuerySearchLeaguesRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// limit is the max number of leagues returned, 20 by default and at most
+	// 100.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QuerySearchLeaguesRequest) Reset()         { *m = QuerySearchLeaguesRequest{} }
+func (m *QuerySearchLeaguesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchLeaguesRequest) ProtoMessage()    {}
+func (*QuerySearchLeaguesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{46}
+}
+func (m *QuerySearchLeaguesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchLeaguesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchLeaguesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySearchLeaguesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchLeaguesRequest.Merge(m, src)
+}
+func (m *QuerySearchLeaguesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchLeaguesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchLeaguesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySearchLeaguesRequest proto.InternalMessageInfo
+
+func (m *QuerySearchLeaguesRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *QuerySearchLeaguesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QuerySearchLeaguesResponse defines the QuerySearchLeaguesResponse message.
+// The leagues are ordered by their matching name, the exact matches first.
+type QuerySearchLeaguesResponse struct {
+	Leagues []League `protobuf:"bytes,1,rep,name=leagues,proto3" json:"leagues"`
+}
+
+func (m *QuerySearchLeaguesResponse) Reset()         { *m = QuerySearchLeaguesResponse{} }
+func (m *QuerySearchLeaguesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchLeaguesResponse) ProtoMessage()    {}
+func (*QuerySearchLeaguesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{47}
+}
+func (m *QuerySearchLeaguesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchLeaguesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchLeaguesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySearchLeaguesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchLeaguesResponse.Merge(m, src)
+}
+func (m *QuerySearchLeaguesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchLeaguesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchLeaguesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySearchLeaguesResponse proto.InternalMessageInfo
+
+func (m *QuerySearchLeaguesResponse) GetLeagues() []League {
+	if m != nil {
+		return m.Leagues
+	}
+	return nil
+}
+
+// QueryCanonicalIDRequest defines the QueryCanonicalIDRequest message.
+type QueryCanonicalIDRequest struct {
+	EntityType EntityType `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=futchain.futchain.v1.EntityType" json:"entity_type,omitempty"`
+	Provider   string     `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalId string     `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *QueryCanonicalIDRequest) Reset()         { *m = QueryCanonicalIDRequest{} }
+func (m *QueryCanonicalIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalIDRequest) ProtoMessage()    {}
+func (*QueryCanonicalIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{48}
+}
+func (m *QueryCanonicalIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanonicalIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalIDRequest.Merge(m, src)
+}
+func (m *QueryCanonicalIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanonicalIDRequest proto.InternalMessageInfo
+
+func (m *QueryCanonicalIDRequest) GetEntityType() EntityType {
+	if m != nil {
+		return m.EntityType
+	}
+	return ENTITY_TYPE_UNSPECIFIED
+}
+
+func (m *QueryCanonicalIDRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryCanonicalIDRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// QueryCanonicalIDResponse defines the QueryCanonicalIDResponse message.
+type QueryCanonicalIDResponse struct {
+	CanonicalId int64 `protobuf:"varint,1,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+}
+
+func (m *QueryCanonicalIDResponse) Reset()         { *m = QueryCanonicalIDResponse{} }
+func (m *QueryCanonicalIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCanonicalIDResponse) ProtoMessage()    {}
+func (*QueryCanonicalIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{49}
+}
+func (m *QueryCanonicalIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCanonicalIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCanonicalIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCanonicalIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCanonicalIDResponse.Merge(m, src)
+}
+func (m *QueryCanonicalIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCanonicalIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCanonicalIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCanonicalIDResponse proto.InternalMessageInfo
+
+func (m *QueryCanonicalIDResponse) GetCanonicalId() int64 {
+	if m != nil {
+		return m.CanonicalId
+	}
+	return 0
+}
+
+// QueryExternalIDsRequest defines the QueryExternalIDsRequest message.
+type QueryExternalIDsRequest struct {
+	EntityType  EntityType `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=futchain.futchain.v1.EntityType" json:"entity_type,omitempty"`
+	CanonicalId int64      `protobuf:"varint,2,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+}
+
+func (m *QueryExternalIDsRequest) Reset()         { *m = QueryExternalIDsRequest{} }
+func (m *QueryExternalIDsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalIDsRequest) ProtoMessage()    {}
+func (*QueryExternalIDsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{50}
+}
+func (m *QueryExternalIDsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalIDsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalIDsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExternalIDsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalIDsRequest.Merge(m, src)
+}
+func (m *QueryExternalIDsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalIDsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalIDsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExternalIDsRequest proto.InternalMessageInfo
+
+func (m *QueryExternalIDsRequest) GetEntityType() EntityType {
+	if m != nil {
+		return m.EntityType
+	}
+	return ENTITY_TYPE_UNSPECIFIED
+}
+
+func (m *QueryExternalIDsRequest) GetCanonicalId() int64 {
+	if m != nil {
+		return m.CanonicalId
+	}
+	return 0
+}
+
+// QueryExternalIDsResponse defines the QueryExternalIDsResponse message.
+type QueryExternalIDsResponse struct {
+	Mappings []IDMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings"`
+	// merged_into is the canonical id the entity was merged into, if it was
+	// merged as a duplicate. Its mappings were then moved to merged_into.
+	MergedInto int64 `protobuf:"varint,2,opt,name=merged_into,json=mergedInto,proto3" json:"merged_into,omitempty"`
+}
+
+func (m *QueryExternalIDsResponse) Reset()         { *m = QueryExternalIDsResponse{} }
+func (m *QueryExternalIDsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalIDsResponse) ProtoMessage()    {}
+func (*QueryExternalIDsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{51}
+}
+func (m *QueryExternalIDsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalIDsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalIDsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExternalIDsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalIDsResponse.Merge(m, src)
+}
+func (m *QueryExternalIDsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalIDsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalIDsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExternalIDsResponse proto.InternalMessageInfo
+
+func (m *QueryExternalIDsResponse) GetMappings() []IDMapping {
+	if m != nil {
+		return m.Mappings
+	}
+	return nil
+}
+
+func (m *QueryExternalIDsResponse) GetMergedInto() int64 {
+	if m != nil {
+		return m.MergedInto
+	}
+	return 0
+}
+
+// QueryIDMappingsRequest defines the QueryIDMappingsRequest message.
+type QueryIDMappingsRequest struct {
+	EntityType EntityType `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=futchain.futchain.v1.EntityType" json:"entity_type,omitempty"`
+	// provider filters the mappings by data provider if set.
+	Provider   string             `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIDMappingsRequest) Reset()         { *m = QueryIDMappingsRequest{} }
+func (m *QueryIDMappingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIDMappingsRequest) ProtoMessage()    {}
+func (*QueryIDMappingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{52}
+}
+func (m *QueryIDMappingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIDMappingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIDMappingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIDMappingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIDMappingsRequest.Merge(m, src)
+}
+func (m *QueryIDMappingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIDMappingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIDMappingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIDMappingsRequest proto.InternalMessageInfo
+
+func (m *QueryIDMappingsRequest) GetEntityType() EntityType {
+	if m != nil {
+		return m.EntityType
+	}
+	return ENTITY_TYPE_UNSPECIFIED
+}
+
+func (m *QueryIDMappingsRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *QueryIDMappingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIDMappingsResponse defines the QueryIDMappingsResponse message.
+type QueryIDMappingsResponse struct {
+	Mappings   []IDMapping         `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIDMappingsResponse) Reset()         { *m = QueryIDMappingsResponse{} }
+func (m *QueryIDMappingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIDMappingsResponse) ProtoMessage()    {}
+func (*QueryIDMappingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{53}
+}
+func (m *QueryIDMappingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIDMappingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIDMappingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIDMappingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIDMappingsResponse.Merge(m, src)
+}
+func (m *QueryIDMappingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIDMappingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIDMappingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIDMappingsResponse proto.InternalMessageInfo
+
+func (m *QueryIDMappingsResponse) GetMappings() []IDMapping {
+	if m != nil {
+		return m.Mappings
+	}
+	return nil
+}
+
+func (m *QueryIDMappingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMatchResultRecordRequest defines the QueryMatchResultRecordRequest message.
+type QueryMatchResultRecordRequest struct {
+	MatchId uint64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+}
+
+func (m *QueryMatchResultRecordRequest) Reset()         { *m = QueryMatchResultRecordRequest{} }
+func (m *QueryMatchResultRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchResultRecordRequest) ProtoMessage()    {}
+func (*QueryMatchResultRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{54}
+}
+func (m *QueryMatchResultRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchResultRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchResultRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMatchResultRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchResultRecordRequest.Merge(m, src)
+}
+func (m *QueryMatchResultRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchResultRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchResultRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMatchResultRecordRequest proto.InternalMessageInfo
+
+func (m *QueryMatchResultRecordRequest) GetMatchId() uint64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+// QueryMatchResultRecordResponse defines the QueryMatchResultRecordResponse message.
+type QueryMatchResultRecordResponse struct {
+	MatchId   uint64 `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	HomeScore uint32 `protobuf:"varint,2,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore uint32 `protobuf:"varint,3,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Started   bool   `protobuf:"varint,4,opt,name=started,proto3" json:"started,omitempty"`
+	Finished  bool   `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	Cancelled bool   `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Finalized bool   `protobuf:"varint,7,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// key is the key of the record in the module store: the "results" prefix
+	// followed by the big endian match id.
+	Key []byte `protobuf:"bytes,8,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the encoding of the record: the big endian match id (8 bytes),
+	// home score (4 bytes), away score (4 bytes), and a byte of flags, started
+	// 1, finished 2, cancelled 4 and finalized 8.
+	Value []byte `protobuf:"bytes,9,opt,name=value,proto3" json:"value,omitempty"`
+	// proof_path is the ABCI query path that proves the key, "/store/futchain/key".
+	ProofPath string `protobuf:"bytes,10,opt,name=proof_path,json=proofPath,proto3" json:"proof_path,omitempty"`
+}
+
+func (m *QueryMatchResultRecordResponse) Reset()         { *m = QueryMatchResultRecordResponse{} }
+func (m *QueryMatchResultRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMatchResultRecordResponse) ProtoMessage()    {}
+func (*QueryMatchResultRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8828dc5742c02c, []int{55}
+}
+func (m *QueryMatchResultRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMatchResultRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMatchResultRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMatchResultRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMatchResultRecordResponse.Merge(m, src)
+}
+func (m *QueryMatchResultRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMatchResultRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMatchResultRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMatchResultRecordResponse proto.InternalMessageInfo
+
+func (m *QueryMatchResultRecordResponse) GetMatchId() uint64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *QueryMatchResultRecordResponse) GetHomeScore() uint32 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *QueryMatchResultRecordResponse) GetAwayScore() uint32 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *QueryMatchResultRecordResponse) GetStarted() bool {
+	if m != nil {
+		return m.Started
+	}
+	return false
+}
+
+func (m *QueryMatchResultRecordResponse) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *QueryMatchResultRecordResponse) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *QueryMatchResultRecordResponse) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
+func (m *QueryMatchResultRecordResponse) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *QueryMatchResultRecordResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryMatchResultRecordResponse) GetProofPath() string {
+	if m != nil {
+		return m.ProofPath
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "futchain.futchain.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "futchain.futchain.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryTeamRequest)(nil), "futchain.futchain.v1.QueryTeamRequest")
+	proto.RegisterType((*QueryTeamResponse)(nil), "futchain.futchain.v1.QueryTeamResponse")
+	proto.RegisterType((*QueryLeagueRequest)(nil), "futchain.futchain.v1.QueryLeagueRequest")
+	proto.RegisterType((*QueryLeagueResponse)(nil), "futchain.futchain.v1.QueryLeagueResponse")
+	proto.RegisterType((*QueryMatchRequest)(nil), "futchain.futchain.v1.QueryMatchRequest")
+	proto.RegisterType((*QueryMatchResponse)(nil), "futchain.futchain.v1.QueryMatchResponse")
+	proto.RegisterType((*QueryUnfinishedMatchesRequest)(nil), "futchain.futchain.v1.QueryUnfinishedMatchesRequest")
+	proto.RegisterType((*QueryUnfinishedMatchesResponse)(nil), "futchain.futchain.v1.QueryUnfinishedMatchesResponse")
+	proto.RegisterType((*QueryMarketRequest)(nil), "futchain.futchain.v1.QueryMarketRequest")
+	proto.RegisterType((*QueryMarketResponse)(nil), "futchain.futchain.v1.QueryMarketResponse")
+	proto.RegisterType((*QueryMatchMarketsRequest)(nil), "futchain.futchain.v1.QueryMatchMarketsRequest")
+	proto.RegisterType((*QueryMatchMarketsResponse)(nil), "futchain.futchain.v1.QueryMatchMarketsResponse")
+	proto.RegisterType((*QueryMarketStakesRequest)(nil), "futchain.futchain.v1.QueryMarketStakesRequest")
+	proto.RegisterType((*QueryMarketStakesResponse)(nil), "futchain.futchain.v1.QueryMarketStakesResponse")
+	proto.RegisterType((*QueryOutcomeTokensRequest)(nil), "futchain.futchain.v1.QueryOutcomeTokensRequest")
+	proto.RegisterType((*QueryOutcomeTokensResponse)(nil), "futchain.futchain.v1.QueryOutcomeTokensResponse")
+	proto.RegisterType((*QueryCorrectionRequest)(nil), "futchain.futchain.v1.QueryCorrectionRequest")
+	proto.RegisterType((*QueryCorrectionResponse)(nil), "futchain.futchain.v1.QueryCorrectionResponse")
+	proto.RegisterType((*QueryCorrectionsRequest)(nil), "futchain.futchain.v1.QueryCorrectionsRequest")
+	proto.RegisterType((*QueryCorrectionsResponse)(nil), "futchain.futchain.v1.QueryCorrectionsResponse")
+	proto.RegisterType((*QueryValidatorOracleInfoRequest)(nil), "futchain.futchain.v1.QueryValidatorOracleInfoRequest")
+	proto.RegisterType((*QueryValidatorOracleInfoResponse)(nil), "futchain.futchain.v1.QueryValidatorOracleInfoResponse")
+	proto.RegisterType((*QueryValidatorOracleReportsRequest)(nil), "futchain.futchain.v1.QueryValidatorOracleReportsRequest")
+	proto.RegisterType((*QueryValidatorOracleReportsResponse)(nil), "futchain.futchain.v1.QueryValidatorOracleReportsResponse")
+	proto.RegisterType((*QueryOracleRewardsRequest)(nil), "futchain.futchain.v1.QueryOracleRewardsRequest")
+	proto.RegisterType((*QueryOracleRewardsResponse)(nil), "futchain.futchain.v1.QueryOracleRewardsResponse")
+	proto.RegisterType((*QueryReporterRequest)(nil), "futchain.futchain.v1.QueryReporterRequest")
+	proto.RegisterType((*QueryReporterResponse)(nil), "futchain.futchain.v1.QueryReporterResponse")
+	proto.RegisterType((*QueryReportersRequest)(nil), "futchain.futchain.v1.QueryReportersRequest")
+	proto.RegisterType((*QueryReportersResponse)(nil), "futchain.futchain.v1.QueryReportersResponse")
+	proto.RegisterType((*QueryMatchReportsRequest)(nil), "futchain.futchain.v1.QueryMatchReportsRequest")
+	proto.RegisterType((*QueryMatchReportsResponse)(nil), "futchain.futchain.v1.QueryMatchReportsResponse")
+	proto.RegisterType((*QueryReportRoundRequest)(nil), "futchain.futchain.v1.QueryReportRoundRequest")
+	proto.RegisterType((*QueryReportRoundResponse)(nil), "futchain.futchain.v1.QueryReportRoundResponse")
+	proto.RegisterType((*QueryMatchAttestationRequest)(nil), "futchain.futchain.v1.QueryMatchAttestationRequest")
+	proto.RegisterType((*QueryMatchAttestationResponse)(nil), "futchain.futchain.v1.QueryMatchAttestationResponse")
+	proto.RegisterType((*QueryLeaguesRequest)(nil), "futchain.futchain.v1.QueryLeaguesRequest")
+	proto.RegisterType((*QueryLeaguesResponse)(nil), "futchain.futchain.v1.QueryLeaguesResponse")
+	proto.RegisterType((*QueryTeamsRequest)(nil), "futchain.futchain.v1.QueryTeamsRequest")
+	proto.RegisterType((*QueryTeamsResponse)(nil), "futchain.futchain.v1.QueryTeamsResponse")
+	proto.RegisterType((*QueryMatchesRequest)(nil), "futchain.futchain.v1.QueryMatchesRequest")
+	proto.RegisterType((*QueryMatchesResponse)(nil), "futchain.futchain.v1.QueryMatchesResponse")
+	proto.RegisterType((*QuerySearchTeamsRequest)(nil), "futchain.futchain.v1.QuerySearchTeamsRequest")
+	proto.RegisterType((*QuerySearchTeamsResponse)(nil), "futchain.futchain.v1.QuerySearchTeamsResponse")
+	proto.RegisterType((*QuerySearchLeaguesRequest)(nil), "futchain.futchain.v1.QuerySearchLeaguesRequest")
+	proto.RegisterType((*QuerySearchLeaguesResponse)(nil), "futchain.futchain.v1.QuerySearchLeaguesResponse")
+	proto.RegisterType((*QueryCanonicalIDRequest)(nil), "futchain.futchain.v1.QueryCanonicalIDRequest")
+	proto.RegisterType((*QueryCanonicalIDResponse)(nil), "futchain.futchain.v1.QueryCanonicalIDResponse")
+	proto.RegisterType((*QueryExternalIDsRequest)(nil), "futchain.futchain.v1.QueryExternalIDsRequest")
+	proto.RegisterType((*QueryExternalIDsResponse)(nil), "futchain.futchain.v1.QueryExternalIDsResponse")
+	proto.RegisterType((*QueryIDMappingsRequest)(nil), "futchain.futchain.v1.QueryIDMappingsRequest")
+	proto.RegisterType((*QueryIDMappingsResponse)(nil), "futchain.futchain.v1.QueryIDMappingsResponse")
+	proto.RegisterType((*QueryMatchResultRecordRequest)(nil), "futchain.futchain.v1.QueryMatchResultRecordRequest")
+	proto.RegisterType((*QueryMatchResultRecordResponse)(nil), "futchain.futchain.v1.QueryMatchResultRecordResponse")
+}
+
+func init() { proto.RegisterFile("futchain/futchain/v1/query.proto", fileDescriptor_1d8828dc5742c02c) }
+
+var fileDescriptor_1d8828dc5742c02c = []byte{
+	// 2681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xdd, 0x8f, 0x14, 0xc7,
+	0x11, 0x67, 0xee, 0x73, 0xaf, 0x0e, 0x30, 0xd7, 0x3e, 0x9b, 0x65, 0x0d, 0x77, 0xc7, 0x80, 0xe1,
+	0x38, 0x60, 0x87, 0x3b, 0x30, 0x06, 0x1b, 0x63, 0x83, 0xef, 0x80, 0x8d, 0x03, 0xc6, 0x7b, 0xc4,
+	0x96, 0x12, 0x25, 0xab, 0x66, 0xa7, 0x6f, 0x77, 0x74, 0xbb, 0x33, 0xcb, 0xcc, 0xec, 0xe1, 0x0b,
+	0x3a, 0x59, 0x4a, 0xa4, 0x44, 0x72, 0xa4, 0x24, 0x92, 0xa5, 0x48, 0x89, 0xa2, 0x38, 0x89, 0xa2,
+	0x38, 0x89, 0xa2, 0x7c, 0x58, 0x28, 0xc9, 0x83, 0x95, 0x3c, 0x45, 0xf2, 0xa3, 0x45, 0xf2, 0x60,
+	0xe5, 0xc1, 0xb2, 0x20, 0x52, 0xfe, 0x8d, 0xa8, 0xbf, 0xe6, 0x63, 0x67, 0xb6, 0x77, 0x16, 0xd6,
+	0xbc, 0x9c, 0x76, 0xba, 0xab, 0xba, 0x7e, 0x55, 0x5d, 0x5d, 0x5d, 0x5d, 0x75, 0x30, 0xb7, 0xd6,
+	0xf6, 0xab, 0x75, 0x6c, 0xd9, 0x46, 0xf0, 0x63, 0x63, 0xd1, 0xb8, 0xd5, 0x26, 0xee, 0x66, 0xb1,
+	0xe5, 0x3a, 0xbe, 0x83, 0xa6, 0xe5, 0x44, 0x31, 0xf8, 0xb1, 0xb1, 0x58, 0x98, 0xc2, 0x4d, 0xcb,
+	0x76, 0x0c, 0xf6, 0x97, 0x13, 0x16, 0xf6, 0x54, 0x1d, 0xaf, 0xe9, 0x78, 0x15, 0xf6, 0x65, 0xf0,
+	0x0f, 0x31, 0xb5, 0xc0, 0xbf, 0x8c, 0x9b, 0xd8, 0x23, 0x7c, 0x71, 0x63, 0x63, 0xf1, 0x26, 0xf1,
+	0xf1, 0xa2, 0xd1, 0xc2, 0x35, 0xcb, 0xc6, 0xbe, 0xe5, 0xd8, 0x82, 0x76, 0x26, 0x4a, 0x2b, 0xa9,
+	0xaa, 0x8e, 0x25, 0xe7, 0x9f, 0x4d, 0x45, 0x5c, 0x75, 0x5c, 0x97, 0x54, 0x23, 0xcb, 0xec, 0x4f,
+	0x25, 0x23, 0xb6, 0x6f, 0xf9, 0x9b, 0x4a, 0x92, 0x26, 0x76, 0xd7, 0x89, 0x2f, 0x48, 0xe6, 0xba,
+	0x90, 0xf8, 0xd5, 0xba, 0x72, 0x11, 0xc7, 0xc5, 0xd5, 0x06, 0x51, 0x92, 0xb4, 0xb0, 0x8b, 0x9b,
+	0xd2, 0x40, 0x07, 0xd2, 0x49, 0x5c, 0x67, 0xc3, 0x32, 0x89, 0xab, 0x24, 0x72, 0x49, 0xcb, 0x71,
+	0xfd, 0x80, 0x68, 0xba, 0xe6, 0xd4, 0x1c, 0xbe, 0x05, 0xf4, 0x97, 0x18, 0xdd, 0x5b, 0x73, 0x9c,
+	0x5a, 0x83, 0x18, 0xb8, 0x65, 0x19, 0xd8, 0xb6, 0x1d, 0x9f, 0x59, 0x5c, 0x48, 0xd7, 0xa7, 0x01,
+	0xbd, 0x41, 0x37, 0xe5, 0x3a, 0x83, 0x54, 0x26, 0xb7, 0xda, 0xc4, 0xf3, 0xf5, 0x37, 0xe1, 0xc9,
+	0xd8, 0xa8, 0xd7, 0x72, 0x6c, 0x8f, 0xa0, 0x97, 0x61, 0x8c, 0x43, 0xcf, 0x6b, 0x73, 0xda, 0xfc,
+	0xe4, 0xd2, 0xde, 0x62, 0x9a, 0x83, 0x14, 0x39, 0xd7, 0xc5, 0x89, 0x8f, 0x3f, 0x9b, 0xdd, 0xf6,
+	0x9b, 0xff, 0xfd, 0x69, 0x41, 0x2b, 0x0b, 0x36, 0x5d, 0x87, 0x5d, 0x6c, 0xdd, 0x1b, 0x04, 0x37,
+	0x85, 0x2c, 0xb4, 0x13, 0x86, 0x2c, 0x93, 0x2d, 0x38, 0x5c, 0x1e, 0xb2, 0x4c, 0xfd, 0x1a, 0x4c,
+	0x45, 0x68, 0x84, 0xe4, 0xb3, 0x30, 0xe2, 0x13, 0xdc, 0xcc, 0x0f, 0x33, 0xb9, 0x85, 0x74, 0xb9,
+	0x94, 0x23, 0x2a, 0x95, 0xb1, 0xe8, 0x07, 0x85, 0x86, 0x5f, 0x26, 0xb8, 0xd6, 0x26, 0xdd, 0xa4,
+	0x4a, 0x8d, 0x25, 0x55, 0xa8, 0x71, 0x83, 0x8d, 0xe4, 0x47, 0x54, 0x1a, 0x73, 0xae, 0x98, 0xc6,
+	0x9c, 0x4d, 0x3f, 0x20, 0xb4, 0xb9, 0x4a, 0xfd, 0xa6, 0x9b, 0xf0, 0xb2, 0x80, 0x28, 0x88, 0x84,
+	0xec, 0x73, 0x30, 0xca, 0xbc, 0x2d, 0xff, 0x04, 0x13, 0xfd, 0x4c, 0xba, 0x68, 0xc6, 0x13, 0x95,
+	0xcc, 0x99, 0xf4, 0x59, 0xd8, 0xc7, 0xd6, 0xfc, 0x8a, 0xbd, 0x66, 0xd9, 0x96, 0x57, 0x27, 0x26,
+	0xa3, 0x24, 0xc1, 0x1e, 0x2f, 0xc1, 0x4c, 0x37, 0x02, 0x01, 0x60, 0x17, 0x0c, 0x5b, 0x26, 0xdd,
+	0xeb, 0xe1, 0xf9, 0xe1, 0x32, 0xfd, 0x19, 0xd8, 0xf2, 0x2a, 0x3b, 0x28, 0x49, 0x75, 0x46, 0x62,
+	0xb6, 0x94, 0x54, 0xa1, 0x2d, 0xf9, 0x01, 0x53, 0x7b, 0x0f, 0xe7, 0x8a, 0xd9, 0x92, 0xb3, 0xe9,
+	0x5b, 0x90, 0x0f, 0xcd, 0xc4, 0xc9, 0xa4, 0x36, 0x68, 0x0f, 0xe4, 0x98, 0xde, 0x95, 0xc0, 0xb0,
+	0xe3, 0xec, 0xbb, 0x64, 0xa2, 0x4b, 0x00, 0x61, 0xa4, 0xc9, 0x0f, 0x31, 0xd9, 0x87, 0x8a, 0x22,
+	0x48, 0xd1, 0x50, 0x53, 0xe4, 0x31, 0x4f, 0x04, 0x9c, 0xe2, 0x75, 0x5c, 0x93, 0x6e, 0x52, 0x8e,
+	0x70, 0xea, 0x1f, 0x68, 0xb0, 0x27, 0x45, 0xbe, 0xd0, 0xee, 0x02, 0x8c, 0x73, 0x98, 0xdc, 0x60,
+	0x7d, 0xa8, 0x27, 0xf9, 0xd0, 0xe5, 0x14, 0xa0, 0x87, 0x7b, 0x02, 0xe5, 0xf2, 0x63, 0x48, 0xdf,
+	0x09, 0x0c, 0x45, 0x17, 0x5e, 0xf5, 0xf1, 0x7a, 0xb0, 0xed, 0xe8, 0x19, 0x98, 0xe0, 0xf2, 0x2a,
+	0xc1, 0x9e, 0xe5, 0xf8, 0xc0, 0x00, 0x4d, 0xf5, 0xab, 0xd0, 0x54, 0x51, 0x04, 0xc2, 0x54, 0xe7,
+	0x61, 0xcc, 0x63, 0x23, 0xc2, 0x52, 0x5d, 0x3c, 0x9b, 0x71, 0xc5, 0xfc, 0x80, 0x73, 0x0d, 0xce,
+	0x4e, 0xa7, 0x05, 0xca, 0xd7, 0xdb, 0x7e, 0xd5, 0x69, 0x92, 0x1b, 0xce, 0x3a, 0xb1, 0x33, 0x78,
+	0x94, 0xfe, 0x5b, 0x0d, 0x0a, 0x69, 0x8c, 0x42, 0xbf, 0x15, 0x18, 0xf3, 0xd9, 0x88, 0xd0, 0x4f,
+	0x4f, 0xd7, 0x2f, 0xca, 0x1c, 0x53, 0x93, 0x33, 0xa3, 0x65, 0x80, 0xaa, 0xd3, 0x68, 0x60, 0x9f,
+	0xb8, 0xb8, 0x21, 0xd4, 0xdc, 0x13, 0x53, 0x53, 0x2a, 0xf8, 0xaa, 0x63, 0xc5, 0x56, 0x88, 0xf0,
+	0xe9, 0xf3, 0xf0, 0x34, 0x83, 0xfa, 0x6a, 0x70, 0x4b, 0x76, 0x3b, 0xb6, 0x6b, 0xb0, 0x3b, 0x41,
+	0x29, 0x34, 0x7a, 0x8d, 0x42, 0x91, 0xa3, 0xe2, 0xf8, 0xce, 0xa5, 0x6b, 0x15, 0x72, 0x77, 0x20,
+	0x92, 0xc3, 0x3a, 0x4e, 0xc8, 0x09, 0x6c, 0x1e, 0xf7, 0x3f, 0xed, 0xa1, 0xfd, 0xef, 0x43, 0x4d,
+	0x9c, 0x80, 0x98, 0x0c, 0xa1, 0xcc, 0x55, 0x98, 0x0c, 0xd1, 0xc8, 0x3d, 0xea, 0x4b, 0x9b, 0x28,
+	0xff, 0xe0, 0xbc, 0xf1, 0x16, 0xcc, 0x32, 0xcc, 0x6f, 0xe2, 0x86, 0x65, 0x62, 0xdf, 0x71, 0x5f,
+	0x67, 0x99, 0x44, 0xc9, 0x5e, 0x73, 0xa4, 0x7d, 0xae, 0xc1, 0xd4, 0x86, 0x9c, 0xad, 0x60, 0xd3,
+	0x74, 0x89, 0xc7, 0xef, 0xe2, 0x89, 0x8b, 0xfb, 0xef, 0xdd, 0x3d, 0xbe, 0x4f, 0x48, 0x0d, 0x56,
+	0xb8, 0xc0, 0x49, 0x56, 0x7d, 0xd7, 0xb2, 0x6b, 0xe5, 0x5d, 0x1b, 0x1d, 0xe3, 0x7a, 0x03, 0xe6,
+	0xba, 0x8b, 0x14, 0xe6, 0xba, 0x02, 0x23, 0x96, 0xbd, 0xe6, 0x88, 0xdd, 0x38, 0x92, 0x6e, 0xa7,
+	0x94, 0x05, 0x62, 0x37, 0x31, 0x5d, 0x41, 0xff, 0x48, 0x03, 0x3d, 0x4d, 0x5c, 0x99, 0xa5, 0x31,
+	0xde, 0x17, 0xa4, 0xe4, 0xc0, 0x82, 0xda, 0x5f, 0x35, 0x38, 0xa0, 0x84, 0x2f, 0x0c, 0x76, 0x19,
+	0xc6, 0x79, 0x62, 0xd6, 0xeb, 0xfc, 0x47, 0xb8, 0x63, 0xf7, 0x81, 0xe0, 0x1e, 0x9c, 0x67, 0xad,
+	0xcb, 0x38, 0x27, 0x24, 0xde, 0xc6, 0xae, 0xf9, 0x45, 0x99, 0x5b, 0xff, 0x73, 0x10, 0x1c, 0xe3,
+	0xd2, 0x82, 0xe0, 0x3f, 0xee, 0xf2, 0x21, 0xe1, 0x51, 0xd9, 0x42, 0x9a, 0x64, 0x42, 0xab, 0xb0,
+	0x9d, 0xb4, 0x9c, 0x6a, 0xbd, 0x72, 0x9b, 0x58, 0xb5, 0xba, 0xcf, 0xcc, 0x32, 0x71, 0xf1, 0x04,
+	0xa5, 0xfc, 0xcf, 0x67, 0xb3, 0x4f, 0xf1, 0xb5, 0x3c, 0x73, 0xbd, 0x68, 0x39, 0x34, 0x59, 0xaf,
+	0x17, 0x4b, 0xb6, 0x7f, 0xef, 0xee, 0x71, 0x10, 0x42, 0x4a, 0xb6, 0x2f, 0xce, 0x30, 0x5b, 0xe5,
+	0x2d, 0xb6, 0x88, 0xfe, 0x25, 0x98, 0x66, 0x90, 0xcb, 0x22, 0xa1, 0x96, 0xb6, 0x59, 0x82, 0xf1,
+	0xb8, 0x45, 0xf2, 0xf7, 0xee, 0x1e, 0x9f, 0x16, 0x4b, 0xc5, 0x0d, 0x21, 0x09, 0xf5, 0x6f, 0xc0,
+	0x53, 0x1d, 0x6b, 0x05, 0xd7, 0x42, 0x4e, 0x26, 0xec, 0x42, 0xf5, 0x99, 0x74, 0xc7, 0x90, 0x9c,
+	0x51, 0xfd, 0x03, 0x56, 0xbd, 0xd2, 0xb1, 0xfe, 0xc0, 0x83, 0xe7, 0xef, 0x34, 0x71, 0x65, 0x44,
+	0x24, 0x04, 0xae, 0x3d, 0x21, 0x71, 0x48, 0xe7, 0xee, 0x43, 0x87, 0x90, 0x77, 0x70, 0xae, 0x1d,
+	0xcb, 0x09, 0x3b, 0x02, 0xc9, 0x63, 0xc8, 0x09, 0x7f, 0x1f, 0xcb, 0x09, 0x3b, 0x23, 0xc1, 0xa5,
+	0xce, 0x48, 0xb0, 0x5f, 0x91, 0xc3, 0x3f, 0x8e, 0x40, 0xb0, 0x47, 0x5c, 0xbd, 0x5c, 0x56, 0xd9,
+	0x69, 0xdb, 0xa6, 0x7c, 0x0e, 0xfc, 0x5a, 0x5e, 0x99, 0xb1, 0x39, 0xa1, 0xc8, 0x34, 0x8c, 0xba,
+	0x74, 0x40, 0x64, 0x0b, 0xfc, 0x03, 0xed, 0xa5, 0xde, 0xb0, 0x41, 0x70, 0xc3, 0xb2, 0x6b, 0x0c,
+	0x55, 0xae, 0x1c, 0x0e, 0xa0, 0x05, 0x98, 0xaa, 0x3a, 0xcd, 0xa6, 0xe5, 0x57, 0x88, 0x6d, 0x56,
+	0xea, 0xfc, 0xb4, 0x0e, 0xb3, 0x6d, 0x78, 0x82, 0x4f, 0xac, 0xd8, 0xe6, 0x15, 0x36, 0x4c, 0x69,
+	0x39, 0x63, 0x94, 0x76, 0x84, 0xd3, 0xf2, 0x89, 0x80, 0x56, 0x3f, 0x0b, 0x7b, 0x43, 0x8b, 0x5f,
+	0xf0, 0x7d, 0xe2, 0xf1, 0x17, 0x6d, 0x86, 0xbc, 0xed, 0x1f, 0x9a, 0x78, 0x14, 0x25, 0x79, 0x85,
+	0xa2, 0xab, 0x30, 0x89, 0xc3, 0xe1, 0xe0, 0x10, 0x75, 0xdf, 0xb5, 0xc8, 0x22, 0xb1, 0x0c, 0x21,
+	0xb2, 0x0a, 0x2a, 0x41, 0x4e, 0x3e, 0xe7, 0xc5, 0xe6, 0x75, 0xb9, 0x11, 0x96, 0xb1, 0x8f, 0xaf,
+	0x0b, 0xca, 0xd8, 0xe1, 0x97, 0xec, 0xfa, 0xd7, 0x63, 0xcf, 0xd4, 0x81, 0x1f, 0xfd, 0x5f, 0x6a,
+	0x22, 0x10, 0x06, 0xeb, 0x87, 0xaf, 0x1b, 0xfe, 0xa0, 0xed, 0xf1, 0xba, 0x49, 0x3e, 0x84, 0x25,
+	0xdf, 0xe0, 0x9c, 0xf8, 0x6b, 0x91, 0x02, 0xc1, 0xc0, 0x2d, 0xf0, 0x13, 0x4d, 0x3c, 0x71, 0xc5,
+	0xea, 0x42, 0xff, 0x17, 0x61, 0xd4, 0x27, 0xbc, 0xf0, 0x31, 0x9c, 0xbd, 0x00, 0xc1, 0x79, 0x06,
+	0xa7, 0xf9, 0xbb, 0x43, 0xc1, 0xcb, 0x3a, 0xfa, 0x94, 0xa7, 0x6f, 0x3a, 0x6e, 0xe5, 0xd0, 0xe7,
+	0x73, 0x7c, 0xa0, 0x64, 0xa2, 0xdd, 0x30, 0x4e, 0x61, 0xd0, 0xa9, 0x21, 0x36, 0x35, 0x46, 0x3f,
+	0x4b, 0x26, 0xe5, 0x5a, 0x73, 0x9d, 0x66, 0xc5, 0xb7, 0x9a, 0x44, 0x1c, 0xcc, 0x1c, 0x1d, 0xb8,
+	0x61, 0x35, 0x09, 0xe3, 0x72, 0xf8, 0xd4, 0x88, 0xe0, 0x72, 0xd8, 0xc4, 0xcb, 0xec, 0xf1, 0xe6,
+	0xb7, 0xbd, 0xfc, 0xe8, 0x9c, 0x36, 0xbf, 0x73, 0xe9, 0xb0, 0xe2, 0x70, 0xac, 0x32, 0xc2, 0x4b,
+	0x56, 0x83, 0x5e, 0x83, 0x82, 0xad, 0x63, 0xa7, 0xc6, 0x1e, 0x7a, 0xa7, 0x7e, 0x21, 0x7d, 0xb5,
+	0xb3, 0x6c, 0xf1, 0x0a, 0xf0, 0x03, 0xdf, 0xeb, 0x7d, 0x99, 0xa8, 0x9c, 0x48, 0xb6, 0xc1, 0x6d,
+	0xd8, 0x8a, 0x88, 0xb7, 0xab, 0x04, 0xbb, 0xd5, 0x7a, 0xcc, 0x61, 0xa7, 0x61, 0x94, 0x2d, 0xc2,
+	0x13, 0x8b, 0x32, 0xff, 0xa0, 0xa3, 0x0d, 0xab, 0x69, 0xf1, 0xb4, 0x66, 0x47, 0x99, 0x7f, 0xe8,
+	0x6f, 0x89, 0xd0, 0x1c, 0x5b, 0x66, 0x00, 0x9e, 0xa9, 0x5f, 0x16, 0xb7, 0x17, 0x5f, 0xb8, 0x23,
+	0xa8, 0xf4, 0x83, 0xb0, 0x22, 0x72, 0xbe, 0x8e, 0x85, 0x06, 0x16, 0x3d, 0xf4, 0x1f, 0x6b, 0xf2,
+	0xd5, 0x88, 0x6d, 0xc7, 0xb6, 0xaa, 0xb8, 0x51, 0x5a, 0x96, 0x40, 0x2f, 0xc0, 0x24, 0x2f, 0xee,
+	0x56, 0xfc, 0xcd, 0x16, 0x61, 0x70, 0x77, 0x76, 0x7b, 0xd0, 0xad, 0x30, 0xc2, 0x1b, 0x9b, 0x2d,
+	0x52, 0x06, 0x12, 0xfc, 0x46, 0x85, 0x8e, 0x10, 0x3d, 0x11, 0xc6, 0x5c, 0x34, 0x0b, 0x93, 0xe4,
+	0x6d, 0x9f, 0xb8, 0x36, 0x6e, 0xd0, 0x43, 0x34, 0xcc, 0xa6, 0x41, 0x0e, 0x95, 0x4c, 0xfd, 0x25,
+	0xf9, 0xd8, 0x8c, 0x42, 0x13, 0xaa, 0xef, 0x87, 0xed, 0x55, 0x39, 0x1c, 0x9e, 0xce, 0xc9, 0x60,
+	0xac, 0x64, 0xea, 0xef, 0x08, 0xcd, 0x56, 0xe4, 0x8a, 0xcb, 0xde, 0x00, 0x35, 0xeb, 0x04, 0x30,
+	0x94, 0x04, 0xf0, 0x6d, 0x79, 0xf5, 0xc7, 0x10, 0x04, 0x39, 0x4c, 0xae, 0x89, 0x5b, 0x2d, 0xcb,
+	0xae, 0xc9, 0xcd, 0x9b, 0x4d, 0x97, 0x5f, 0x5a, 0xbe, 0xca, 0xe9, 0x62, 0x37, 0x97, 0xe4, 0xa5,
+	0x56, 0x6c, 0x12, 0xb7, 0x46, 0xcc, 0x8a, 0x65, 0xfb, 0x8e, 0x80, 0x01, 0x7c, 0xa8, 0x64, 0xfb,
+	0x0e, 0xbd, 0x9c, 0x79, 0xda, 0x19, 0x2c, 0xe4, 0x3d, 0xa6, 0x0d, 0x8e, 0x47, 0xa4, 0xe1, 0x47,
+	0x49, 0x9c, 0x77, 0x27, 0x34, 0x18, 0xb0, 0x19, 0x07, 0x16, 0x9a, 0x5e, 0x88, 0xa6, 0x42, 0x65,
+	0xe2, 0xb5, 0x1b, 0x7e, 0x99, 0x54, 0x1d, 0xd7, 0xec, 0x96, 0x47, 0x8d, 0x84, 0x79, 0xd4, 0x87,
+	0x43, 0xa2, 0x76, 0x9c, 0xc2, 0x2c, 0xf4, 0xed, 0xce, 0x8d, 0xf6, 0x01, 0xd4, 0x9d, 0x26, 0xa9,
+	0x78, 0x55, 0xc7, 0x25, 0x22, 0x8c, 0x4c, 0xd0, 0x91, 0x55, 0x3a, 0x40, 0xa7, 0xf1, 0x6d, 0xbc,
+	0x29, 0xa6, 0x87, 0xf9, 0x34, 0x1d, 0xe1, 0xd3, 0x79, 0x18, 0xf7, 0x7c, 0xec, 0xfa, 0xc4, 0x64,
+	0x17, 0x53, 0xae, 0x2c, 0x3f, 0xe9, 0x16, 0xcb, 0x4a, 0x36, 0xbb, 0x9b, 0x72, 0xe5, 0xe0, 0x9b,
+	0xa6, 0xaa, 0x55, 0x6c, 0x57, 0x49, 0xa3, 0x41, 0x4c, 0x76, 0xe7, 0xe4, 0xca, 0xe1, 0x00, 0x9d,
+	0x5d, 0xb3, 0x6c, 0xdc, 0xb0, 0xbe, 0x49, 0xcc, 0xfc, 0x38, 0x9f, 0x0d, 0x06, 0xd0, 0x2e, 0x18,
+	0x5e, 0x27, 0x9b, 0xf9, 0xdc, 0x9c, 0x36, 0xbf, 0xbd, 0x4c, 0x7f, 0xd2, 0x18, 0xb8, 0x81, 0x1b,
+	0x6d, 0x92, 0x9f, 0x60, 0x63, 0xfc, 0x83, 0x02, 0x6f, 0xb9, 0x8e, 0xb3, 0x56, 0x69, 0x61, 0xbf,
+	0x9e, 0x07, 0xe6, 0x64, 0x13, 0x6c, 0xe4, 0x3a, 0xf6, 0xeb, 0x4b, 0x9f, 0x1e, 0x84, 0x51, 0x66,
+	0x34, 0xf4, 0xae, 0x06, 0x63, 0xbc, 0x47, 0x82, 0xe6, 0xd3, 0x9d, 0x20, 0xd9, 0x92, 0x29, 0x1c,
+	0xc9, 0x40, 0xc9, 0x6d, 0xaf, 0x1f, 0xfd, 0xd6, 0xbf, 0xfe, 0xfb, 0xde, 0xd0, 0xb3, 0xe8, 0x80,
+	0xe1, 0x62, 0x6b, 0xad, 0xb5, 0x69, 0x28, 0x9a, 0x50, 0xe8, 0xbb, 0x1a, 0x8c, 0xd0, 0xdb, 0x01,
+	0x1d, 0x52, 0x08, 0x88, 0xf4, 0x6b, 0x0a, 0x87, 0x7b, 0xd2, 0x09, 0x18, 0x45, 0x06, 0x63, 0x1e,
+	0x1d, 0x52, 0xc2, 0xa0, 0x17, 0x91, 0x71, 0xc7, 0x32, 0xb7, 0xd0, 0x0f, 0x34, 0x18, 0xe3, 0x37,
+	0x80, 0xd2, 0x2c, 0xb1, 0x3e, 0x8e, 0xd2, 0x2c, 0xf1, 0x5e, 0x8e, 0x7e, 0x82, 0xe1, 0x59, 0x40,
+	0xf3, 0x4a, 0x3c, 0xfc, 0xc2, 0xe1, 0x88, 0xbe, 0xa7, 0xc1, 0x28, 0x73, 0x71, 0xa4, 0x52, 0x3a,
+	0xda, 0xda, 0x29, 0xcc, 0xf7, 0x26, 0x14, 0x70, 0x0c, 0x06, 0xe7, 0x08, 0x3a, 0xac, 0x84, 0xc3,
+	0x0e, 0x0d, 0x47, 0xf3, 0x17, 0x0d, 0xa6, 0x12, 0xcd, 0x1a, 0x74, 0x52, 0x21, 0xb0, 0x5b, 0xef,
+	0xa7, 0x70, 0xaa, 0x3f, 0x26, 0x81, 0xf8, 0x34, 0x43, 0x7c, 0x02, 0x15, 0x95, 0x88, 0xdb, 0x01,
+	0xbf, 0x4c, 0xa7, 0xe8, 0xc6, 0xf2, 0x46, 0x00, 0x52, 0x9b, 0x27, 0xd2, 0x54, 0x52, 0x6e, 0x6c,
+	0xbc, 0xb1, 0x94, 0x71, 0x63, 0x79, 0x93, 0x83, 0x9b, 0xf2, 0x0f, 0x1a, 0x6c, 0x8f, 0x76, 0x71,
+	0x50, 0xb1, 0xd7, 0xb6, 0xc5, 0xdb, 0x4d, 0x05, 0x23, 0x33, 0xbd, 0xc0, 0xf8, 0x12, 0xc3, 0xf8,
+	0x3c, 0x7a, 0x2e, 0xcb, 0x6e, 0xcb, 0xc8, 0xb9, 0x65, 0xc8, 0xd6, 0xd0, 0x1f, 0x19, 0xe0, 0xb0,
+	0x97, 0xd2, 0x03, 0x70, 0xa2, 0xed, 0xd3, 0x03, 0x70, 0xb2, 0x49, 0xa3, 0x9f, 0x67, 0x80, 0xcf,
+	0xa0, 0xd3, 0x99, 0x8c, 0x1a, 0xb4, 0x94, 0xb6, 0x0c, 0xd1, 0xa4, 0xf9, 0x9b, 0x06, 0x3b, 0x62,
+	0xed, 0x11, 0xa4, 0x82, 0x90, 0xd6, 0x81, 0x29, 0x9c, 0xc8, 0xce, 0x20, 0x40, 0x2f, 0x33, 0xd0,
+	0xe7, 0xd1, 0xb9, 0xfe, 0xac, 0xec, 0xf0, 0xc5, 0x2a, 0xa2, 0xf1, 0xf2, 0xbe, 0x06, 0x10, 0x16,
+	0xfe, 0xd1, 0x31, 0x05, 0x8c, 0x44, 0x57, 0xa5, 0x70, 0x3c, 0x23, 0xb5, 0x40, 0x7c, 0x8a, 0x21,
+	0x2e, 0xa2, 0x63, 0x4a, 0xc4, 0x61, 0xbf, 0x81, 0xfb, 0xef, 0xcf, 0x34, 0x98, 0x8c, 0xb4, 0x36,
+	0x50, 0x36, 0xa1, 0x81, 0x61, 0x8b, 0x59, 0xc9, 0xfb, 0x3a, 0x60, 0xd1, 0xa6, 0xc8, 0xbf, 0x35,
+	0x78, 0x32, 0xa5, 0x27, 0x80, 0x9e, 0x53, 0x48, 0xee, 0xde, 0xf7, 0x28, 0x9c, 0xee, 0x97, 0x4d,
+	0x00, 0xbf, 0xc6, 0x80, 0x5f, 0x41, 0x97, 0x94, 0xc0, 0x83, 0x12, 0xb6, 0x71, 0x27, 0x51, 0x09,
+	0xdf, 0x12, 0xff, 0xcf, 0x51, 0xb1, 0x28, 0xfc, 0xcf, 0x35, 0x78, 0x3a, 0xbd, 0xfa, 0x8f, 0xce,
+	0x64, 0x87, 0x18, 0x2f, 0x53, 0x16, 0xce, 0x3e, 0x04, 0xa7, 0xd0, 0xef, 0x0d, 0xa6, 0xdf, 0x6b,
+	0xa8, 0xf4, 0xe8, 0xfa, 0xc9, 0x5a, 0xe3, 0x3f, 0xe9, 0xb9, 0x8d, 0x56, 0xee, 0xd5, 0xe7, 0x36,
+	0xa5, 0xa3, 0xa0, 0x3e, 0xb7, 0x69, 0x4d, 0x81, 0xc1, 0xea, 0xc1, 0x51, 0xff, 0x54, 0x83, 0x9c,
+	0x2c, 0x42, 0xa3, 0x05, 0x05, 0xa2, 0x8e, 0x9a, 0x7f, 0xe1, 0x68, 0x26, 0x5a, 0x01, 0xfc, 0x79,
+	0x06, 0x7c, 0x11, 0x19, 0x4a, 0xe0, 0xb2, 0xee, 0x6d, 0xdc, 0x91, 0x68, 0xd1, 0x8f, 0x34, 0x98,
+	0x08, 0xea, 0xeb, 0x28, 0x8b, 0xcc, 0xc0, 0xbc, 0xc7, 0xb2, 0x11, 0xf7, 0x95, 0x85, 0x85, 0x95,
+	0xf9, 0xe0, 0x6a, 0x94, 0x8e, 0x5d, 0xec, 0x9d, 0xd1, 0xc4, 0xdc, 0xd9, 0xc8, 0x4c, 0xff, 0x68,
+	0x57, 0xa3, 0x74, 0xd8, 0xf7, 0x35, 0x98, 0x8c, 0xd4, 0xac, 0x95, 0xb1, 0x30, 0x59, 0xf7, 0x56,
+	0xc6, 0xc2, 0x94, 0x52, 0xb8, 0xbe, 0xc8, 0xd0, 0x1e, 0x45, 0x47, 0x32, 0xd8, 0xb3, 0xc2, 0xeb,
+	0xe4, 0x7f, 0xd7, 0x60, 0x57, 0x67, 0xb1, 0x18, 0x2d, 0xf5, 0x32, 0x53, 0xb2, 0xb4, 0x5d, 0x38,
+	0xd9, 0x17, 0x8f, 0x00, 0x7c, 0x81, 0x01, 0x7e, 0x11, 0x9d, 0xed, 0xcf, 0xbc, 0xd1, 0x02, 0xf6,
+	0xf7, 0x35, 0x18, 0x17, 0x35, 0x1d, 0xd4, 0x3b, 0xe1, 0x0e, 0x3c, 0x61, 0x21, 0x0b, 0xa9, 0x40,
+	0x79, 0x8c, 0xa1, 0x3c, 0x84, 0x0e, 0x66, 0x48, 0xce, 0x3d, 0xf4, 0x1d, 0x0d, 0x46, 0x59, 0x19,
+	0x0c, 0xf5, 0x7a, 0x8d, 0x78, 0x59, 0x12, 0xf3, 0x58, 0x45, 0x4d, 0x5f, 0x60, 0x50, 0x0e, 0x22,
+	0xbd, 0xe7, 0xbb, 0xc5, 0x63, 0x96, 0x91, 0x99, 0xf8, 0x91, 0x5e, 0xbb, 0x93, 0xcd, 0x32, 0x9d,
+	0x59, 0x77, 0x36, 0xcb, 0xc8, 0x5c, 0x9b, 0x9e, 0x86, 0x48, 0x99, 0x50, 0x79, 0x1a, 0x92, 0x55,
+	0x49, 0xe5, 0x69, 0x48, 0xa9, 0x3e, 0x66, 0x3c, 0x0d, 0xcc, 0x56, 0x86, 0xc7, 0xf8, 0xd1, 0x07,
+	0x1a, 0xec, 0x88, 0x95, 0x09, 0x95, 0x17, 0x4c, 0x5a, 0x65, 0x52, 0x79, 0xc1, 0xa4, 0x56, 0x20,
+	0xf5, 0x93, 0x0c, 0xe7, 0x71, 0x74, 0x34, 0x8b, 0x7b, 0x49, 0xa4, 0x3f, 0xa7, 0x59, 0x56, 0x58,
+	0xd3, 0x53, 0x67, 0x59, 0x89, 0xb2, 0xa4, 0x3a, 0xcb, 0x4a, 0x96, 0x0a, 0xf5, 0x25, 0x86, 0xf1,
+	0x18, 0x5a, 0x50, 0x62, 0xb4, 0x4c, 0xcf, 0x08, 0x8a, 0x77, 0x34, 0x5a, 0x4f, 0x46, 0xaa, 0x76,
+	0x4a, 0x88, 0xc9, 0xfa, 0xa2, 0x12, 0x62, 0x4a, 0x31, 0x50, 0x7f, 0x85, 0x41, 0x7c, 0x01, 0x9d,
+	0xe9, 0x09, 0xf1, 0x4e, 0xb4, 0xe8, 0xb8, 0x65, 0xc8, 0x6a, 0x29, 0x7a, 0x4f, 0x03, 0x08, 0xcb,
+	0x63, 0xca, 0xdc, 0x3a, 0x51, 0x07, 0x54, 0xe6, 0xd6, 0xc9, 0x9a, 0x9b, 0x3e, 0xcf, 0xd0, 0xea,
+	0x68, 0xae, 0x17, 0x5a, 0xf4, 0x91, 0x06, 0x53, 0x89, 0x5a, 0x16, 0x3a, 0x99, 0xe1, 0x2d, 0xdf,
+	0x59, 0x36, 0x53, 0x3e, 0xad, 0xbb, 0x96, 0xcb, 0xf4, 0x73, 0x0c, 0xea, 0x69, 0x74, 0xaa, 0xdf,
+	0x3b, 0x90, 0xae, 0x75, 0x71, 0xe5, 0xe3, 0xfb, 0x33, 0xda, 0x27, 0xf7, 0x67, 0xb4, 0xcf, 0xef,
+	0xcf, 0x68, 0x3f, 0x7c, 0x30, 0xb3, 0xed, 0x93, 0x07, 0x33, 0xdb, 0x3e, 0x7d, 0x30, 0xb3, 0xed,
+	0xab, 0x47, 0x6b, 0x96, 0x5f, 0x6f, 0xdf, 0x2c, 0x56, 0x9d, 0x66, 0x62, 0xe5, 0xb7, 0xc3, 0x9f,
+	0xfe, 0x66, 0x8b, 0x78, 0x37, 0xc7, 0xd8, 0xbf, 0x04, 0x9f, 0xfc, 0x7f, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x24, 0x83, 0x5c, 0xf2, 0x19, 0x2e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Team Queries a list of Team items.
+	Team(ctx context.Context, in *QueryTeamRequest, opts ...grpc.CallOption) (*QueryTeamResponse, error)
+	// League Queries a list of League items.
+	League(ctx context.Context, in *QueryLeagueRequest, opts ...grpc.CallOption) (*QueryLeagueResponse, error)
+	// Match Queries a list of Match items.
+	Match(ctx context.Context, in *QueryMatchRequest, opts ...grpc.CallOption) (*QueryMatchResponse, error)
+	UnfinishedMatches(ctx context.Context, in *QueryUnfinishedMatchesRequest, opts ...grpc.CallOption) (*QueryUnfinishedMatchesResponse, error)
+	// Market queries a prediction market by id.
+	Market(ctx context.Context, in *QueryMarketRequest, opts ...grpc.CallOption) (*QueryMarketResponse, error)
+	// MatchMarkets queries the prediction markets of a match.
+	MatchMarkets(ctx context.Context, in *QueryMatchMarketsRequest, opts ...grpc.CallOption) (*QueryMatchMarketsResponse, error)
+	// MarketStakes queries the stakes placed on a prediction market.
+	MarketStakes(ctx context.Context, in *QueryMarketStakesRequest, opts ...grpc.CallOption) (*QueryMarketStakesResponse, error)
+	// OutcomeTokens queries the outcome share tokens of a match and their collateral.
+	OutcomeTokens(ctx context.Context, in *QueryOutcomeTokensRequest, opts ...grpc.CallOption) (*QueryOutcomeTokensResponse, error)
+	// Correction queries a data-council correction by id.
+	Correction(ctx context.Context, in *QueryCorrectionRequest, opts ...grpc.CallOption) (*QueryCorrectionResponse, error)
+	// Corrections queries the data-council corrections.
+	Corrections(ctx context.Context, in *QueryCorrectionsRequest, opts ...grpc.CallOption) (*QueryCorrectionsResponse, error)
+	// ValidatorOracleInfo queries the missed and deviating report counters of a
+	// validator.
+	ValidatorOracleInfo(ctx context.Context, in *QueryValidatorOracleInfoRequest, opts ...grpc.CallOption) (*QueryValidatorOracleInfoResponse, error)
+	// ValidatorOracleReports queries the reports of a validator on matches that
+	// are not finalized yet.
+	ValidatorOracleReports(ctx context.Context, in *QueryValidatorOracleReportsRequest, opts ...grpc.CallOption) (*QueryValidatorOracleReportsResponse, error)
+	// OracleRewards queries the claimable oracle rewards of a validator and its
+	// weight in the current epoch.
+	OracleRewards(ctx context.Context, in *QueryOracleRewardsRequest, opts ...grpc.CallOption) (*QueryOracleRewardsResponse, error)
+	// Reporter queries a data reporter by address.
+	Reporter(ctx context.Context, in *QueryReporterRequest, opts ...grpc.CallOption) (*QueryReporterResponse, error)
+	// Reporters queries the data reporters.
+	Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error)
+	// MatchReports queries the reporter reports on a match that is not
+	// finalized yet.
+	MatchReports(ctx context.Context, in *QueryMatchReportsRequest, opts ...grpc.CallOption) (*QueryMatchReportsResponse, error)
+	// ReportRound queries the current commit-reveal round of the reporters.
+	ReportRound(ctx context.Context, in *QueryReportRoundRequest, opts ...grpc.CallOption) (*QueryReportRoundResponse, error)
+	// MatchAttestation queries the provider attestation of a match, with the
+	// provider key to verify it.
+	MatchAttestation(ctx context.Context, in *QueryMatchAttestationRequest, opts ...grpc.CallOption) (*QueryMatchAttestationResponse, error)
+	// Leagues queries the ingested leagues.
+	Leagues(ctx context.Context, in *QueryLeaguesRequest, opts ...grpc.CallOption) (*QueryLeaguesResponse, error)
+	// Teams queries the ingested teams.
+	Teams(ctx context.Context, in *QueryTeamsRequest, opts ...grpc.CallOption) (*QueryTeamsResponse, error)
+	// Matches queries the ingested matches, optionally filtered by league,
+	// team, kick-off time and status.
+	Matches(ctx context.Context, in *QueryMatchesRequest, opts ...grpc.CallOption) (*QueryMatchesResponse, error)
+	// SearchTeams queries the teams with a word of their name or long name
+	// starting with the query, ignoring case and diacritics.
+	SearchTeams(ctx context.Context, in *QuerySearchTeamsRequest, opts ...grpc.CallOption) (*QuerySearchTeamsResponse, error)
+	// SearchLeagues queries the leagues with a word of their name starting with
+	// the query, ignoring case and diacritics.
+	SearchLeagues(ctx context.Context, in *QuerySearchLeaguesRequest, opts ...grpc.CallOption) (*QuerySearchLeaguesResponse, error)
+	// CanonicalID queries the canonical id of an entity by its id at a data
+	// provider.
+	CanonicalID(ctx context.Context, in *QueryCanonicalIDRequest, opts ...grpc.CallOption) (*QueryCanonicalIDResponse, error)
+	// ExternalIDs queries the ids of an entity at the data providers, by its
+	// canonical id.
+	ExternalIDs(ctx context.Context, in *QueryExternalIDsRequest, opts ...grpc.CallOption) (*QueryExternalIDsResponse, error)
+	// IDMappings queries the id mappings of a type of entity, optionally of a
+	// single data provider.
+	IDMappings(ctx context.Context, in *QueryIDMappingsRequest, opts ...grpc.CallOption) (*QueryIDMappingsResponse, error)
+	// MatchResultRecord queries the result record of a match with its store
+	// key. A gRPC query can not prove the record: its ICS-23 proof against the
+	// app hash is the ABCI query of the key at proof_path with prove set, which
+	// is the public interface the relayers of the record use.
+	MatchResultRecord(ctx context.Context, in *QueryMatchResultRecordRequest, opts ...grpc.CallOption) (*QueryMatchResultRecordResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Team(ctx context.Context, in *QueryTeamRequest, opts ...grpc.CallOption) (*QueryTeamResponse, error) {
+	out := new(QueryTeamResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Team", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) League(ctx context.Context, in *QueryLeagueRequest, opts ...grpc.CallOption) (*QueryLeagueResponse, error) {
+	out := new(QueryLeagueResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/League", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Match(ctx context.Context, in *QueryMatchRequest, opts ...grpc.CallOption) (*QueryMatchResponse, error) {
+	out := new(QueryMatchResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Match", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnfinishedMatches(ctx context.Context, in *QueryUnfinishedMatchesRequest, opts ...grpc.CallOption) (*QueryUnfinishedMatchesResponse, error) {
+	out := new(QueryUnfinishedMatchesResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/UnfinishedMatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Market(ctx context.Context, in *QueryMarketRequest, opts ...grpc.CallOption) (*QueryMarketResponse, error) {
+	out := new(QueryMarketResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Market", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MatchMarkets(ctx context.Context, in *QueryMatchMarketsRequest, opts ...grpc.CallOption) (*QueryMatchMarketsResponse, error) {
+	out := new(QueryMatchMarketsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/MatchMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketStakes(ctx context.Context, in *QueryMarketStakesRequest, opts ...grpc.CallOption) (*QueryMarketStakesResponse, error) {
+	out := new(QueryMarketStakesResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/MarketStakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutcomeTokens(ctx context.Context, in *QueryOutcomeTokensRequest, opts ...grpc.CallOption) (*QueryOutcomeTokensResponse, error) {
+	out := new(QueryOutcomeTokensResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/OutcomeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Correction(ctx context.Context, in *QueryCorrectionRequest, opts ...grpc.CallOption) (*QueryCorrectionResponse, error) {
+	out := new(QueryCorrectionResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Correction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Corrections(ctx context.Context, in *QueryCorrectionsRequest, opts ...grpc.CallOption) (*QueryCorrectionsResponse, error) {
+	out := new(QueryCorrectionsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Corrections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorOracleInfo(ctx context.Context, in *QueryValidatorOracleInfoRequest, opts ...grpc.CallOption) (*QueryValidatorOracleInfoResponse, error) {
+	out := new(QueryValidatorOracleInfoResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/ValidatorOracleInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorOracleReports(ctx context.Context, in *QueryValidatorOracleReportsRequest, opts ...grpc.CallOption) (*QueryValidatorOracleReportsResponse, error) {
+	out := new(QueryValidatorOracleReportsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/ValidatorOracleReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleRewards(ctx context.Context, in *QueryOracleRewardsRequest, opts ...grpc.CallOption) (*QueryOracleRewardsResponse, error) {
+	out := new(QueryOracleRewardsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/OracleRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reporter(ctx context.Context, in *QueryReporterRequest, opts ...grpc.CallOption) (*QueryReporterResponse, error) {
+	out := new(QueryReporterResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Reporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Reporters(ctx context.Context, in *QueryReportersRequest, opts ...grpc.CallOption) (*QueryReportersResponse, error) {
+	out := new(QueryReportersResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Reporters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MatchReports(ctx context.Context, in *QueryMatchReportsRequest, opts ...grpc.CallOption) (*QueryMatchReportsResponse, error) {
+	out := new(QueryMatchReportsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/MatchReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReportRound(ctx context.Context, in *QueryReportRoundRequest, opts ...grpc.CallOption) (*QueryReportRoundResponse, error) {
+	out := new(QueryReportRoundResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/ReportRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MatchAttestation(ctx context.Context, in *QueryMatchAttestationRequest, opts ...grpc.CallOption) (*QueryMatchAttestationResponse, error) {
+	out := new(QueryMatchAttestationResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/MatchAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Leagues(ctx context.Context, in *QueryLeaguesRequest, opts ...grpc.CallOption) (*QueryLeaguesResponse, error) {
+	out := new(QueryLeaguesResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Leagues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Teams(ctx context.Context, in *QueryTeamsRequest, opts ...grpc.CallOption) (*QueryTeamsResponse, error) {
+	out := new(QueryTeamsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Teams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Matches(ctx context.Context, in *QueryMatchesRequest, opts ...grpc.CallOption) (*QueryMatchesResponse, error) {
+	out := new(QueryMatchesResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/Matches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SearchTeams(ctx context.Context, in *QuerySearchTeamsRequest, opts ...grpc.CallOption) (*QuerySearchTeamsResponse, error) {
+	out := new(QuerySearchTeamsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/SearchTeams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SearchLeagues(ctx context.Context, in *QuerySearchLeaguesRequest, opts ...grpc.CallOption) (*QuerySearchLeaguesResponse, error) {
+	out := new(QuerySearchLeaguesResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/SearchLeagues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CanonicalID(ctx context.Context, in *QueryCanonicalIDRequest, opts ...grpc.CallOption) (*QueryCanonicalIDResponse, error) {
+	out := new(QueryCanonicalIDResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/CanonicalID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExternalIDs(ctx context.Context, in *QueryExternalIDsRequest, opts ...grpc.CallOption) (*QueryExternalIDsResponse, error) {
+	out := new(QueryExternalIDsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/ExternalIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IDMappings(ctx context.Context, in *QueryIDMappingsRequest, opts ...grpc.CallOption) (*QueryIDMappingsResponse, error) {
+	out := new(QueryIDMappingsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/IDMappings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MatchResultRecord(ctx context.Context, in *QueryMatchResultRecordRequest, opts ...grpc.CallOption) (*QueryMatchResultRecordResponse, error) {
+	out := new(QueryMatchResultRecordResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Query/MatchResultRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Team Queries a list of Team items.
+	Team(context.Context, *QueryTeamRequest) (*QueryTeamResponse, error)
+	// League Queries a list of League items.
+	League(context.Context, *QueryLeagueRequest) (*QueryLeagueResponse, error)
+	// Match Queries a list of Match items.
+	Match(context.Context, *QueryMatchRequest) (*QueryMatchResponse, error)
+	UnfinishedMatches(context.Context, *QueryUnfinishedMatchesRequest) (*QueryUnfinishedMatchesResponse, error)
+	// Market queries a prediction market by id.
+	Market(context.Context, *QueryMarketRequest) (*QueryMarketResponse, error)
+	// MatchMarkets queries the prediction markets of a match.
+	MatchMarkets(context.Context, *QueryMatchMarketsRequest) (*QueryMatchMarketsResponse, error)
+	// MarketStakes queries the stakes placed on a prediction market.
+	MarketStakes(context.Context, *QueryMarketStakesRequest) (*QueryMarketStakesResponse, error)
+	// OutcomeTokens queries the outcome share tokens of a match and their collateral.
+	OutcomeTokens(context.Context, *QueryOutcomeTokensRequest) (*QueryOutcomeTokensResponse, error)
+	// Correction queries a data-council correction by id.
+	Correction(context.Context, *QueryCorrectionRequest) (*QueryCorrectionResponse, error)
+	// Corrections queries the data-council corrections.
+	Corrections(context.Context, *QueryCorrectionsRequest) (*QueryCorrectionsResponse, error)
+	// ValidatorOracleInfo queries the missed and deviating report counters of a
+	// validator.
+	ValidatorOracleInfo(context.Context, *QueryValidatorOracleInfoRequest) (*QueryValidatorOracleInfoResponse, error)
+	// ValidatorOracleReports queries the reports of a validator on matches that
+	// are not finalized yet.
+	ValidatorOracleReports(context.Context, *QueryValidatorOracleReportsRequest) (*QueryValidatorOracleReportsResponse, error)
+	// OracleRewards queries the claimable oracle rewards of a validator and its
+	// weight in the current epoch.
+	OracleRewards(context.Context, *QueryOracleRewardsRequest) (*QueryOracleRewardsResponse, error)
+	// Reporter queries a data reporter by address.
+	Reporter(context.Context, *QueryReporterRequest) (*QueryReporterResponse, error)
+	// Reporters queries the data reporters.
+	Reporters(context.Context, *QueryReportersRequest) (*QueryReportersResponse, error)
+	// MatchReports queries the reporter reports on a match that is not
+	// finalized yet.
+	MatchReports(context.Context, *QueryMatchReportsRequest) (*QueryMatchReportsResponse, error)
+	// ReportRound queries the current commit-reveal round of the reporters.
+	ReportRound(context.Context, *QueryReportRoundRequest) (*QueryReportRoundResponse, error)
+	// MatchAttestation queries the provider attestation of a match, with the
+	// provider key to verify it.
+	MatchAttestation(context.Context, *QueryMatchAttestationRequest) (*QueryMatchAttestationResponse, error)
+	// Leagues queries the ingested leagues.
+	Leagues(context.Context, *QueryLeaguesRequest) (*QueryLeaguesResponse, error)
+	// Teams queries the ingested teams.
+	Teams(context.Context, *QueryTeamsRequest) (*QueryTeamsResponse, error)
+	// Matches queries the ingested matches, optionally filtered by league,
+	// team, kick-off time and status.
+	Matches(context.Context, *QueryMatchesRequest) (*QueryMatchesResponse, error)
+	// SearchTeams queries the teams with a word of their name or long name
+	// starting with the query, ignoring case and diacritics.
+	SearchTeams(context.Context, *QuerySearchTeamsRequest) (*QuerySearchTeamsResponse, error)
+	// SearchLeagues queries the leagues with a word of their name starting with
+	// the query, ignoring case and diacritics.
+	SearchLeagues(context.Context, *QuerySearchLeaguesRequest) (*QuerySearchLeaguesResponse, error)
+	// CanonicalID queries the canonical id of an entity by its id at a data
+	// provider.
+	CanonicalID(context.Context, *QueryCanonicalIDRequest) (*QueryCanonicalIDResponse, error)
+	// ExternalIDs queries the ids of an entity at the data providers, by its
+	// canonical id.
+	ExternalIDs(context.Context, *QueryExternalIDsRequest) (*QueryExternalIDsResponse, error)
+	// IDMappings queries the id mappings of a type of entity, optionally of a
+	// single data provider.
+	IDMappings(context.Context, *QueryIDMappingsRequest) (*QueryIDMappingsResponse, error)
+	// MatchResultRecord queries the result record of a match with its store
+	// key. A gRPC query can not prove the record: its ICS-23 proof against the
+	// app hash is the ABCI query of the key at proof_path with prove set, which
+	// is the public interface the relayers of the record use.
+	MatchResultRecord(context.Context, *QueryMatchResultRecordRequest) (*QueryMatchResultRecordResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Team(ctx context.Context, req *QueryTeamRequest) (*QueryTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Team not implemented")
+}
+func (*UnimplementedQueryServer) League(ctx context.Context, req *QueryLeagueRequest) (*QueryLeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method League not implemented")
+}
+func (*UnimplementedQueryServer) Match(ctx context.Context, req *QueryMatchRequest) (*QueryMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Match not implemented")
+}
+func (*UnimplementedQueryServer) UnfinishedMatches(ctx context.Context, req *QueryUnfinishedMatchesRequest) (*QueryUnfinishedMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfinishedMatches not implemented")
+}
+func (*UnimplementedQueryServer) Market(ctx context.Context, req *QueryMarketRequest) (*QueryMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+}
+func (*UnimplementedQueryServer) MatchMarkets(ctx context.Context, req *QueryMatchMarketsRequest) (*QueryMatchMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchMarkets not implemented")
+}
+func (*UnimplementedQueryServer) MarketStakes(ctx context.Context, req *QueryMarketStakesRequest) (*QueryMarketStakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketStakes not implemented")
+}
+func (*UnimplementedQueryServer) OutcomeTokens(ctx context.Context, req *QueryOutcomeTokensRequest) (*QueryOutcomeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutcomeTokens not implemented")
+}
+func (*UnimplementedQueryServer) Correction(ctx context.Context, req *QueryCorrectionRequest) (*QueryCorrectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Correction not implemented")
+}
+func (*UnimplementedQueryServer) Corrections(ctx context.Context, req *QueryCorrectionsRequest) (*QueryCorrectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Corrections not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOracleInfo(ctx context.Context, req *QueryValidatorOracleInfoRequest) (*QueryValidatorOracleInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOracleInfo not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorOracleReports(ctx context.Context, req *QueryValidatorOracleReportsRequest) (*QueryValidatorOracleReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorOracleReports not implemented")
+}
+func (*UnimplementedQueryServer) OracleRewards(ctx context.Context, req *QueryOracleRewardsRequest) (*QueryOracleRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleRewards not implemented")
+}
+func (*UnimplementedQueryServer) Reporter(ctx context.Context, req *QueryReporterRequest) (*QueryReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reporter not implemented")
+}
+func (*UnimplementedQueryServer) Reporters(ctx context.Context, req *QueryReportersRequest) (*QueryReportersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reporters not implemented")
+}
+func (*UnimplementedQueryServer) MatchReports(ctx context.Context, req *QueryMatchReportsRequest) (*QueryMatchReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchReports not implemented")
+}
+func (*UnimplementedQueryServer) ReportRound(ctx context.Context, req *QueryReportRoundRequest) (*QueryReportRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportRound not implemented")
+}
+func (*UnimplementedQueryServer) MatchAttestation(ctx context.Context, req *QueryMatchAttestationRequest) (*QueryMatchAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchAttestation not implemented")
+}
+func (*UnimplementedQueryServer) Leagues(ctx context.Context, req *QueryLeaguesRequest) (*QueryLeaguesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leagues not implemented")
+}
+func (*UnimplementedQueryServer) Teams(ctx context.Context, req *QueryTeamsRequest) (*QueryTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Teams not implemented")
+}
+func (*UnimplementedQueryServer) Matches(ctx context.Context, req *QueryMatchesRequest) (*QueryMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Matches not implemented")
+}
+func (*UnimplementedQueryServer) SearchTeams(ctx context.Context, req *QuerySearchTeamsRequest) (*QuerySearchTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTeams not implemented")
+}
+func (*UnimplementedQueryServer) SearchLeagues(ctx context.Context, req *QuerySearchLeaguesRequest) (*QuerySearchLeaguesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLeagues not implemented")
+}
+func (*UnimplementedQueryServer) CanonicalID(ctx context.Context, req *QueryCanonicalIDRequest) (*QueryCanonicalIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CanonicalID not implemented")
+}
+func (*UnimplementedQueryServer) ExternalIDs(ctx context.Context, req *QueryExternalIDsRequest) (*QueryExternalIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalIDs not implemented")
+}
+func (*UnimplementedQueryServer) IDMappings(ctx context.Context, req *QueryIDMappingsRequest) (*QueryIDMappingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IDMappings not implemented")
+}
+func (*UnimplementedQueryServer) MatchResultRecord(ctx context.Context, req *QueryMatchResultRecordRequest) (*QueryMatchResultRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MatchResultRecord not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Team_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Team(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Team",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Team(ctx, req.(*QueryTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_League_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeagueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).League(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/League",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).League(ctx, req.(*QueryLeagueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Match_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Match(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Match",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Match(ctx, req.(*QueryMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnfinishedMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnfinishedMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnfinishedMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/UnfinishedMatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnfinishedMatches(ctx, req.(*QueryUnfinishedMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Market(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Market",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Market(ctx, req.(*QueryMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MatchMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMatchMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MatchMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/MatchMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MatchMarkets(ctx, req.(*QueryMatchMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketStakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketStakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketStakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/MarketStakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketStakes(ctx, req.(*QueryMarketStakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutcomeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutcomeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutcomeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/OutcomeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutcomeTokens(ctx, req.(*QueryOutcomeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Correction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorrectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Correction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Correction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Correction(ctx, req.(*QueryCorrectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Corrections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCorrectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Corrections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Corrections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Corrections(ctx, req.(*QueryCorrectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOracleInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOracleInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOracleInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/ValidatorOracleInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOracleInfo(ctx, req.(*QueryValidatorOracleInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorOracleReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOracleReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorOracleReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/ValidatorOracleReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorOracleReports(ctx, req.(*QueryValidatorOracleReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/OracleRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleRewards(ctx, req.(*QueryOracleRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReporterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Reporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reporter(ctx, req.(*QueryReporterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reporters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReportersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reporters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Reporters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reporters(ctx, req.(*QueryReportersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MatchReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMatchReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MatchReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/MatchReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MatchReports(ctx, req.(*QueryMatchReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReportRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReportRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReportRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/ReportRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReportRound(ctx, req.(*QueryReportRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MatchAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMatchAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MatchAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/MatchAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MatchAttestation(ctx, req.(*QueryMatchAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Leagues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaguesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Leagues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Leagues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Leagues(ctx, req.(*QueryLeaguesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Teams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Teams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Teams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Teams(ctx, req.(*QueryTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Matches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Matches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/Matches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Matches(ctx, req.(*QueryMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SearchTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/SearchTeams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchTeams(ctx, req.(*QuerySearchTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SearchLeagues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchLeaguesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchLeagues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/SearchLeagues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchLeagues(ctx, req.(*QuerySearchLeaguesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CanonicalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCanonicalIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CanonicalID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/CanonicalID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CanonicalID(ctx, req.(*QueryCanonicalIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExternalIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExternalIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExternalIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/ExternalIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExternalIDs(ctx, req.(*QueryExternalIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IDMappings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIDMappingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IDMappings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/IDMappings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IDMappings(ctx, req.(*QueryIDMappingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MatchResultRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMatchResultRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MatchResultRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Query/MatchResultRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MatchResultRecord(ctx, req.(*QueryMatchResultRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "futchain.futchain.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Team",
+			Handler:    _Query_Team_Handler,
+		},
+		{
+			MethodName: "League",
+			Handler:    _Query_League_Handler,
+		},
+		{
+			MethodName: "Match",
+			Handler:    _Query_Match_Handler,
+		},
+		{
+			MethodName: "UnfinishedMatches",
+			Handler:    _Query_UnfinishedMatches_Handler,
+		},
+		{
+			MethodName: "Market",
+			Handler:    _Query_Market_Handler,
+		},
+		{
+			MethodName: "MatchMarkets",
+			Handler:    _Query_MatchMarkets_Handler,
+		},
+		{
+			MethodName: "MarketStakes",
+			Handler:    _Query_MarketStakes_Handler,
+		},
+		{
+			MethodName: "OutcomeTokens",
+			Handler:    _Query_OutcomeTokens_Handler,
+		},
+		{
+			MethodName: "Correction",
+			Handler:    _Query_Correction_Handler,
+		},
+		{
+			MethodName: "Corrections",
+			Handler:    _Query_Corrections_Handler,
+		},
+		{
+			MethodName: "ValidatorOracleInfo",
+			Handler:    _Query_ValidatorOracleInfo_Handler,
+		},
+		{
+			MethodName: "ValidatorOracleReports",
+			Handler:    _Query_ValidatorOracleReports_Handler,
+		},
+		{
+			MethodName: "OracleRewards",
+			Handler:    _Query_OracleRewards_Handler,
+		},
+		{
+			MethodName: "Reporter",
+			Handler:    _Query_Reporter_Handler,
+		},
+		{
+			MethodName: "Reporters",
+			Handler:    _Query_Reporters_Handler,
+		},
+		{
+			MethodName: "MatchReports",
+			Handler:    _Query_MatchReports_Handler,
+		},
+		{
+			MethodName: "ReportRound",
+			Handler:    _Query_ReportRound_Handler,
+		},
+		{
+			MethodName: "MatchAttestation",
+			Handler:    _Query_MatchAttestation_Handler,
+		},
+		{
+			MethodName: "Leagues",
+			Handler:    _Query_Leagues_Handler,
+		},
+		{
+			MethodName: "Teams",
+			Handler:    _Query_Teams_Handler,
+		},
+		{
+			MethodName: "Matches",
+			Handler:    _Query_Matches_Handler,
+		},
+		{
+			MethodName: "SearchTeams",
+			Handler:    _Query_SearchTeams_Handler,
+		},
+		{
+			MethodName: "SearchLeagues",
+			Handler:    _Query_SearchLeagues_Handler,
+		},
+		{
+			MethodName: "CanonicalID",
+			Handler:    _Query_CanonicalID_Handler,
+		},
+		{
+			MethodName: "ExternalIDs",
+			Handler:    _Query_ExternalIDs_Handler,
+		},
+		{
+			MethodName: "IDMappings",
+			Handler:    _Query_IDMappings_Handler,
+		},
+		{
+			MethodName: "MatchResultRecord",
+			Handler:    _Query_MatchResultRecord_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "futchain/futchain/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTeamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTeamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTeamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTeamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTeamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTeamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Team.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeagueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeagueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeagueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeagueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeagueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeagueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.League.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnfinishedMatchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnfinishedMatchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnfinishedMatchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnfinishedMatchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnfinishedMatchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnfinishedMatchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA6 := make([]byte, len(m.Ids)*10)
+		var j5 int
+		for _, num1 := range m.Ids {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketStakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketStakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketStakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketStakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketStakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketStakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutcomeTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutcomeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutcomeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutcomeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutcomeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutcomeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorrectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorrectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorrectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorrectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorrectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorrectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Correction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorrectionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorrectionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorrectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCorrectionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCorrectionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCorrectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Corrections) > 0 {
+		for iNdEx := len(m.Corrections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Corrections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOracleInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOracleInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOracleInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOracleInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOracleInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOracleInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOracleReportsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOracleReportsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOracleReportsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOracleReportsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOracleReportsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOracleReportsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EpochWeight.Size()
+		i -= size
+		if _, err := m.EpochWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReporterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReporterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReporterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReporterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reporter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reporters) > 0 {
+		for iNdEx := len(m.Reporters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reporters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchReportsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchReportsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchReportsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchReportsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchReportsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchReportsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reports) > 0 {
+		for iNdEx := len(m.Reports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportRoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportRoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportRoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReportRoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportRoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportRoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevealEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevealEndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CommitEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CommitEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Revealing {
+		i--
+		if m.Revealing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Round != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaguesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaguesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaguesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaguesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaguesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaguesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Leagues) > 0 {
+		for iNdEx := len(m.Leagues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leagues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTeamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTeamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTeamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTeamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTeamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTeamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Teams) > 0 {
+		for iNdEx := len(m.Teams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Teams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ToTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TeamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TeamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LeagueId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LeagueId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Matches) > 0 {
+		for iNdEx := len(m.Matches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Matches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchTeamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchTeamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchTeamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchTeamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchTeamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchTeamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Teams) > 0 {
+		for iNdEx := len(m.Teams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Teams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchLeaguesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchLeaguesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchLeaguesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchLeaguesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchLeaguesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchLeaguesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Leagues) > 0 {
+		for iNdEx := len(m.Leagues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leagues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EntityType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EntityType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCanonicalIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCanonicalIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCanonicalIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanonicalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CanonicalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalIDsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalIDsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalIDsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanonicalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CanonicalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EntityType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EntityType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalIDsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalIDsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalIDsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MergedInto != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MergedInto))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Mappings) > 0 {
+		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIDMappingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIDMappingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIDMappingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EntityType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EntityType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIDMappingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIDMappingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIDMappingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mappings) > 0 {
+		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchResultRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchResultRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchResultRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMatchResultRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMatchResultRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMatchResultRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofPath) > 0 {
+		i -= len(m.ProofPath)
+		copy(dAtA[i:], m.ProofPath)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofPath)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTeamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryTeamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Team.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLeagueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryLeagueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.League.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryMatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Match.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnfinishedMatchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUnfinishedMatchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
 		for _, e := range m.Ids {
 			l += sovQuery(uint64(e))
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMatchMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovQuery(uint64(m.MatchId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMatchMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketStakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketStakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutcomeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovQuery(uint64(m.MatchId))
+	}
+	return n
+}
+
+func (m *QueryOutcomeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCorrectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryCorrectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Correction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCorrectionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCorrectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Corrections) > 0 {
+		for _, e := range m.Corrections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOracleInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOracleInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOracleReportsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOracleReportsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EpochWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReporterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reporter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReportersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reporters) > 0 {
+		for _, e := range m.Reporters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMatchReportsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovQuery(uint64(m.MatchId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMatchReportsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reports) > 0 {
+		for _, e := range m.Reports {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportRoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReportRoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovQuery(uint64(m.Round))
+	}
+	if m.Revealing {
+		n += 2
+	}
+	if m.CommitEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CommitEndHeight))
+	}
+	if m.RevealEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RevealEndHeight))
+	}
+	return n
+}
+
+func (m *QueryMatchAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovQuery(uint64(m.MatchId))
+	}
+	return n
+}
+
+func (m *QueryMatchAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Attestation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Provider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLeaguesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaguesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leagues) > 0 {
+		for _, e := range m.Leagues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTeamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTeamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Teams) > 0 {
+		for _, e := range m.Teams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMatchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeagueId != 0 {
+		n += 1 + sovQuery(uint64(m.LeagueId))
+	}
+	if m.TeamId != 0 {
+		n += 1 + sovQuery(uint64(m.TeamId))
+	}
+	if m.FromTime != 0 {
+		n += 1 + sovQuery(uint64(m.FromTime))
+	}
+	if m.ToTime != 0 {
+		n += 1 + sovQuery(uint64(m.ToTime))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMatchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Matches) > 0 {
+		for _, e := range m.Matches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchTeamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QuerySearchTeamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Teams) > 0 {
+		for _, e := range m.Teams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySearchLeaguesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QuerySearchLeaguesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leagues) > 0 {
+		for _, e := range m.Leagues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCanonicalIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntityType != 0 {
+		n += 1 + sovQuery(uint64(m.EntityType))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCanonicalIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CanonicalId != 0 {
+		n += 1 + sovQuery(uint64(m.CanonicalId))
+	}
+	return n
+}
+
+func (m *QueryExternalIDsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntityType != 0 {
+		n += 1 + sovQuery(uint64(m.EntityType))
+	}
+	if m.CanonicalId != 0 {
+		n += 1 + sovQuery(uint64(m.CanonicalId))
+	}
+	return n
+}
+
+func (m *QueryExternalIDsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for _, e := range m.Mappings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MergedInto != 0 {
+		n += 1 + sovQuery(uint64(m.MergedInto))
+	}
+	return n
+}
+
+func (m *QueryIDMappingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntityType != 0 {
+		n += 1 + sovQuery(uint64(m.EntityType))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIDMappingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for _, e := range m.Mappings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMatchResultRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovQuery(uint64(m.MatchId))
+	}
+	return n
+}
+
+func (m *QueryMatchResultRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		n += 1 + sovQuery(uint64(m.MatchId))
+	}
+	if m.HomeScore != 0 {
+		n += 1 + sovQuery(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovQuery(uint64(m.AwayScore))
+	}
+	if m.Started {
+		n += 2
+	}
+	if m.Finished {
+		n += 2
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	if m.Finalized {
+		n += 2
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProofPath)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTeamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTeamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTeamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTeamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTeamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTeamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Team", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Team.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeagueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeagueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeagueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeagueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeagueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeagueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field League", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.League.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Match.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnfinishedMatchesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnfinishedMatchesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnfinishedMatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnfinishedMatchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnfinishedMatchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnfinishedMatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchMarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchMarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchMarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchMarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketStakesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketStakesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketStakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketStakesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketStakesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketStakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stakes = append(m.Stakes, Stake{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutcomeTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutcomeTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutcomeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutcomeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutcomeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutcomeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, OutcomeToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorrectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorrectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorrectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorrectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorrectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorrectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Correction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Correction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorrectionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorrectionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorrectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCorrectionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCorrectionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCorrectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corrections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Corrections = append(m.Corrections, Correction{})
+			if err := m.Corrections[len(m.Corrections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOracleInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOracleInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOracleInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOracleInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOracleInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOracleInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOracleReportsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOracleReportsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOracleReportsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOracleReportsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOracleReportsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOracleReportsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, OracleReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReporterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReporterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReporterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReporterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReporterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reporter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporters = append(m.Reporters, Reporter{})
+			if err := m.Reporters[len(m.Reporters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchReportsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchReportsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchReportsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchReportsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchReportsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchReportsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reports = append(m.Reports, MatchReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportRoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportRoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportRoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportRoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportRoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportRoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealing = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEndHeight", wireType)
+			}
+			m.CommitEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealEndHeight", wireType)
+			}
+			m.RevealEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeaguesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeaguesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeaguesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeaguesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeaguesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeaguesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leagues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leagues = append(m.Leagues, League{})
+			if err := m.Leagues[len(m.Leagues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTeamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTeamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTeamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTeamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTeamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTeamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Teams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Teams = append(m.Teams, Team{})
+			if err := m.Teams[len(m.Teams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeagueId", wireType)
+			}
+			m.LeagueId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeagueId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
+			}
+			m.TeamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeamId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+			}
+			m.FromTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+			}
+			m.ToTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= MatchStatusFilter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Matches = append(m.Matches, Match{})
+			if err := m.Matches[len(m.Matches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySearchTeamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySearchTeamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySearchTeamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySearchTeamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySearchTeamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySearchTeamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Teams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Teams = append(m.Teams, Team{})
+			if err := m.Teams[len(m.Teams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySearchLeaguesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1485,12 +11674,63 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySearchLeaguesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySearchLeaguesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1512,7 +11752,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySearchLeaguesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1535,15 +11775,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySearchLeaguesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySearchLeaguesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leagues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1570,7 +11810,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Leagues = append(m.Leagues, League{})
+			if err := m.Leagues[len(m.Leagues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1595,7 +11836,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTeamRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1618,17 +11859,17 @@ func (m *QueryTeamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTeamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTeamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
 			}
-			m.Id = 0
+			m.EntityType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1638,11 +11879,75 @@ func (m *QueryTeamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.EntityType |= EntityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1664,7 +11969,7 @@ func (m *QueryTeamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTeamResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCanonicalIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1687,17 +11992,17 @@ func (m *QueryTeamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTeamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCanonicalIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTeamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCanonicalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalId", wireType)
 			}
-			m.Id = 0
+			m.CanonicalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1707,16 +12012,66 @@ func (m *QueryTeamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.CanonicalId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExternalIDsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExternalIDsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExternalIDsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+			}
+			m.EntityType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1726,24 +12081,30 @@ func (m *QueryTeamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EntityType |= EntityType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CanonicalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanonicalId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1765,7 +12126,7 @@ func (m *QueryTeamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeagueRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExternalIDsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1784,21 +12145,55 @@ func (m *QueryLeagueRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeagueRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeagueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExternalIDsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExternalIDsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mappings = append(m.Mappings, IDMapping{})
+			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergedInto", wireType)
 			}
-			m.Id = 0
+			m.MergedInto = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1808,7 +12203,7 @@ func (m *QueryLeagueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.MergedInto |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1834,7 +12229,7 @@ func (m *QueryLeagueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLeagueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIDMappingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1857,17 +12252,17 @@ func (m *QueryLeagueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLeagueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIDMappingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLeagueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIDMappingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
 			}
-			m.Id = 0
+			m.EntityType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1877,14 +12272,14 @@ func (m *QueryLeagueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				m.EntityType |= EntityType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1912,13 +12307,13 @@ func (m *QueryLeagueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1928,23 +12323,27 @@ func (m *QueryLeagueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupName = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1967,7 +12366,7 @@ func (m *QueryLeagueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMatchRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIDMappingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1990,17 +12389,17 @@ func (m *QueryMatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIDMappingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIDMappingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2010,11 +12409,62 @@ func (m *QueryMatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mappings = append(m.Mappings, IDMapping{})
+			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2036,7 +12486,7 @@ func (m *QueryMatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMatchResultRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2059,55 +12509,17 @@ func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMatchResultRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMatchResultRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeagueId", wireType)
-			}
-			m.LeagueId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LeagueId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
 			}
-			var stringLen uint64
+			m.MatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2117,99 +12529,66 @@ func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMatchResultRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Time = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomeId", wireType)
-			}
-			m.HomeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HomeId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMatchResultRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMatchResultRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
-			}
-			m.HomeScore = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HomeScore |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HomeName", wireType)
-			}
-			var stringLen uint64
+			m.MatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2219,29 +12598,16 @@ func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HomeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AwayId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
 			}
-			m.AwayId = 0
+			m.HomeScore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2251,12 +12617,12 @@ func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AwayId |= int64(b&0x7F) << shift
+				m.HomeScore |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
 			}
@@ -2270,16 +12636,16 @@ func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AwayScore |= int64(b&0x7F) << shift
+				m.AwayScore |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AwayName", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2289,27 +12655,15 @@ func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AwayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			m.Started = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2326,8 +12680,8 @@ func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Started = bool(v != 0)
-		case 12:
+			m.Finished = bool(v != 0)
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
 			}
@@ -2347,9 +12701,9 @@ func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Cancelled = bool(v != 0)
-		case 13:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2366,183 +12720,107 @@ func (m *QueryMatchResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Finished = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Finalized = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryUnfinishedMatchesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnfinishedMatchesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnfinishedMatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryUnfinishedMatchesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofPath", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnfinishedMatchesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnfinishedMatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
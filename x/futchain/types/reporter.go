@@ -0,0 +1,21 @@
+package types
+
+import (
+	"crypto/sha256"
+)
+
+// ReportCommitHash returns the hash a reporter commits to for a report: the sha256 hash of the salt followed
+// by the protobuf encoding of the report. The reporter address is part of the report, so a commit can not
+// be revealed by another reporter. The height of the report is set by the module and not hashed.
+func ReportCommitHash(report MatchReport, salt []byte) ([]byte, error) {
+	report.Height = 0
+	bz, err := report.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	hash.Write(salt)
+	hash.Write(bz)
+	return hash.Sum(nil), nil
+}
@@ -0,0 +1,1756 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: futchain/futchain/v1/reporter.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReporterStatus defines the lifecycle of a data reporter.
+type ReporterStatus int32
+
+const (
+	REPORTER_STATUS_UNSPECIFIED ReporterStatus = 0
+	// REPORTER_STATUS_ACTIVE reporters can submit match reports.
+	REPORTER_STATUS_ACTIVE ReporterStatus = 1
+	// REPORTER_STATUS_UNBONDING reporters get their bond back at the
+	// unbonding height. Their bond can still be slashed until then.
+	REPORTER_STATUS_UNBONDING ReporterStatus = 2
+)
+
+var ReporterStatus_name = map[int32]string{
+	0: "REPORTER_STATUS_UNSPECIFIED",
+	1: "REPORTER_STATUS_ACTIVE",
+	2: "REPORTER_STATUS_UNBONDING",
+}
+
+var ReporterStatus_value = map[string]int32{
+	"REPORTER_STATUS_UNSPECIFIED": 0,
+	"REPORTER_STATUS_ACTIVE":      1,
+	"REPORTER_STATUS_UNBONDING":   2,
+}
+
+func (x ReporterStatus) String() string {
+	return proto.EnumName(ReporterStatus_name, int32(x))
+}
+
+func (ReporterStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_73f54d9131958e01, []int{0}
+}
+
+// Reporter is an account that bonded tokens to submit match data.
+type Reporter struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Moniker string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// bond is the stake of the reporter, in the bond denom.
+	Bond   types.Coin     `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond"`
+	Status ReporterStatus `protobuf:"varint,4,opt,name=status,proto3,enum=futchain.futchain.v1.ReporterStatus" json:"status,omitempty"`
+	// unbonding_height is the height the bond is returned at, once unbonding.
+	UnbondingHeight int64 `protobuf:"varint,5,opt,name=unbonding_height,json=unbondingHeight,proto3" json:"unbonding_height,omitempty"`
+	// accurate_reports is the number of reports that agreed with a finalized
+	// result.
+	AccurateReports uint64 `protobuf:"varint,6,opt,name=accurate_reports,json=accurateReports,proto3" json:"accurate_reports,omitempty"`
+	// slashed_reports is the number of reports that deviated from a finalized
+	// result.
+	SlashedReports uint64 `protobuf:"varint,7,opt,name=slashed_reports,json=slashedReports,proto3" json:"slashed_reports,omitempty"`
+	// missed_reveals is the number of report commits that were not revealed in
+	// their round.
+	MissedReveals uint64 `protobuf:"varint,8,opt,name=missed_reveals,json=missedReveals,proto3" json:"missed_reveals,omitempty"`
+}
+
+func (m *Reporter) Reset()         { *m = Reporter{} }
+func (m *Reporter) String() string { return proto.CompactTextString(m) }
+func (*Reporter) ProtoMessage()    {}
+func (*Reporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f54d9131958e01, []int{0}
+}
+func (m *Reporter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reporter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reporter.Merge(m, src)
+}
+func (m *Reporter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reporter proto.InternalMessageInfo
+
+func (m *Reporter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Reporter) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *Reporter) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *Reporter) GetStatus() ReporterStatus {
+	if m != nil {
+		return m.Status
+	}
+	return REPORTER_STATUS_UNSPECIFIED
+}
+
+func (m *Reporter) GetUnbondingHeight() int64 {
+	if m != nil {
+		return m.UnbondingHeight
+	}
+	return 0
+}
+
+func (m *Reporter) GetAccurateReports() uint64 {
+	if m != nil {
+		return m.AccurateReports
+	}
+	return 0
+}
+
+func (m *Reporter) GetSlashedReports() uint64 {
+	if m != nil {
+		return m.SlashedReports
+	}
+	return 0
+}
+
+func (m *Reporter) GetMissedReveals() uint64 {
+	if m != nil {
+		return m.MissedReveals
+	}
+	return 0
+}
+
+// MatchReport is the state of a match as reported by a reporter. The ids are
+// submitted as the ids mapped under the "reporter" provider, and stored as
+// their canonical ids.
+type MatchReport struct {
+	Reporter   string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	MatchId    int64  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	LeagueId   int64  `protobuf:"varint,3,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	LeagueName string `protobuf:"bytes,4,opt,name=league_name,json=leagueName,proto3" json:"league_name,omitempty"`
+	HomeId     int64  `protobuf:"varint,5,opt,name=home_id,json=homeId,proto3" json:"home_id,omitempty"`
+	HomeName   string `protobuf:"bytes,6,opt,name=home_name,json=homeName,proto3" json:"home_name,omitempty"`
+	AwayId     int64  `protobuf:"varint,7,opt,name=away_id,json=awayId,proto3" json:"away_id,omitempty"`
+	AwayName   string `protobuf:"bytes,8,opt,name=away_name,json=awayName,proto3" json:"away_name,omitempty"`
+	HomeScore  int64  `protobuf:"varint,9,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore  int64  `protobuf:"varint,10,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Started    bool   `protobuf:"varint,11,opt,name=started,proto3" json:"started,omitempty"`
+	Finished   bool   `protobuf:"varint,12,opt,name=finished,proto3" json:"finished,omitempty"`
+	Cancelled  bool   `protobuf:"varint,13,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// time is the kick-off time, in the format of the data source.
+	Time string `protobuf:"bytes,14,opt,name=time,proto3" json:"time,omitempty"`
+	// height is the block height the report was last submitted at.
+	Height int64 `protobuf:"varint,15,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MatchReport) Reset()         { *m = MatchReport{} }
+func (m *MatchReport) String() string { return proto.CompactTextString(m) }
+func (*MatchReport) ProtoMessage()    {}
+func (*MatchReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f54d9131958e01, []int{1}
+}
+func (m *MatchReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatchReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MatchReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MatchReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchReport.Merge(m, src)
+}
+func (m *MatchReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatchReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchReport proto.InternalMessageInfo
+
+func (m *MatchReport) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *MatchReport) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *MatchReport) GetLeagueId() int64 {
+	if m != nil {
+		return m.LeagueId
+	}
+	return 0
+}
+
+func (m *MatchReport) GetLeagueName() string {
+	if m != nil {
+		return m.LeagueName
+	}
+	return ""
+}
+
+func (m *MatchReport) GetHomeId() int64 {
+	if m != nil {
+		return m.HomeId
+	}
+	return 0
+}
+
+func (m *MatchReport) GetHomeName() string {
+	if m != nil {
+		return m.HomeName
+	}
+	return ""
+}
+
+func (m *MatchReport) GetAwayId() int64 {
+	if m != nil {
+		return m.AwayId
+	}
+	return 0
+}
+
+func (m *MatchReport) GetAwayName() string {
+	if m != nil {
+		return m.AwayName
+	}
+	return ""
+}
+
+func (m *MatchReport) GetHomeScore() int64 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *MatchReport) GetAwayScore() int64 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *MatchReport) GetStarted() bool {
+	if m != nil {
+		return m.Started
+	}
+	return false
+}
+
+func (m *MatchReport) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *MatchReport) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *MatchReport) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *MatchReport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ReportCommit is the hash a reporter committed to for its report on a match,
+// revealed in the reveal phase of the same round.
+type ReportCommit struct {
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	MatchId  int64  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// hash is the sha256 hash of the salt followed by the encoded report, as
+	// computed by types.ReportCommitHash.
+	Hash   []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Round  uint64 `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+	Height int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ReportCommit) Reset()         { *m = ReportCommit{} }
+func (m *ReportCommit) String() string { return proto.CompactTextString(m) }
+func (*ReportCommit) ProtoMessage()    {}
+func (*ReportCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f54d9131958e01, []int{2}
+}
+func (m *ReportCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReportCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportCommit.Merge(m, src)
+}
+func (m *ReportCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportCommit proto.InternalMessageInfo
+
+func (m *ReportCommit) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *ReportCommit) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *ReportCommit) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *ReportCommit) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ReportCommit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("futchain.futchain.v1.ReporterStatus", ReporterStatus_name, ReporterStatus_value)
+	proto.RegisterType((*Reporter)(nil), "futchain.futchain.v1.Reporter")
+	proto.RegisterType((*MatchReport)(nil), "futchain.futchain.v1.MatchReport")
+	proto.RegisterType((*ReportCommit)(nil), "futchain.futchain.v1.ReportCommit")
+}
+
+func init() {
+	proto.RegisterFile("futchain/futchain/v1/reporter.proto", fileDescriptor_73f54d9131958e01)
+}
+
+var fileDescriptor_73f54d9131958e01 = []byte{
+	// 733 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6f, 0xe2, 0x46,
+	0x14, 0xc7, 0x81, 0x80, 0x19, 0x12, 0xa0, 0x23, 0x94, 0x1a, 0xd2, 0x38, 0x28, 0x6d, 0x55, 0x9a,
+	0xaa, 0xb6, 0x48, 0x7b, 0xe8, 0xa1, 0x97, 0x40, 0x68, 0xcb, 0xa1, 0x24, 0x1a, 0x48, 0x0f, 0xbd,
+	0xa0, 0xc1, 0x9e, 0xe0, 0x51, 0xb1, 0x07, 0x79, 0x0c, 0x6d, 0xbe, 0x41, 0x8f, 0xfd, 0x0a, 0x55,
+	0x2f, 0x3d, 0xf6, 0xd0, 0xcf, 0x50, 0xe5, 0x18, 0xf5, 0xb4, 0xa7, 0xd5, 0x2a, 0x39, 0xec, 0x37,
+	0xd8, 0xf3, 0x6a, 0xde, 0xd8, 0x24, 0xfb, 0x47, 0xda, 0xd3, 0x5e, 0xd0, 0xfb, 0xfd, 0x7b, 0x7e,
+	0x7a, 0xcf, 0x18, 0x7d, 0x7c, 0xb5, 0x4a, 0xbc, 0x80, 0xf2, 0xc8, 0xdd, 0x14, 0xeb, 0xae, 0x1b,
+	0xb3, 0xa5, 0x88, 0x13, 0x16, 0x3b, 0xcb, 0x58, 0x24, 0x02, 0x37, 0x32, 0xcd, 0xd9, 0x14, 0xeb,
+	0x6e, 0xeb, 0x03, 0x1a, 0xf2, 0x48, 0xb8, 0xf0, 0xab, 0x8d, 0x2d, 0xdb, 0x13, 0x32, 0x14, 0xd2,
+	0x9d, 0x51, 0xc9, 0xdc, 0x75, 0x77, 0xc6, 0x12, 0xda, 0x75, 0x3d, 0xc1, 0xa3, 0x54, 0x6f, 0x6a,
+	0x7d, 0x0a, 0xc8, 0xd5, 0x20, 0x95, 0x1a, 0x73, 0x31, 0x17, 0x9a, 0x57, 0x95, 0x66, 0x8f, 0x5e,
+	0x6c, 0x21, 0x93, 0xa4, 0xc3, 0xe0, 0x13, 0x54, 0xa2, 0xbe, 0x1f, 0x33, 0x29, 0x2d, 0xa3, 0x6d,
+	0x74, 0xca, 0x3d, 0xeb, 0xff, 0x7f, 0xbf, 0x6c, 0xa4, 0x5d, 0x4e, 0xb5, 0x32, 0x4e, 0x62, 0x1e,
+	0xcd, 0x49, 0x66, 0xc4, 0x16, 0x2a, 0x85, 0x22, 0xe2, 0xbf, 0xb0, 0xd8, 0xda, 0x52, 0x19, 0x92,
+	0x41, 0xfc, 0x0d, 0x2a, 0xcc, 0x44, 0xe4, 0x5b, 0xf9, 0xb6, 0xd1, 0xa9, 0x9c, 0x34, 0x9d, 0xb4,
+	0x8f, 0x1a, 0xdd, 0x49, 0x47, 0x77, 0xfa, 0x82, 0x47, 0xbd, 0xf2, 0xcd, 0xd3, 0xc3, 0xdc, 0xdf,
+	0xcf, 0xff, 0x39, 0x36, 0x08, 0x24, 0xf0, 0xb7, 0xa8, 0x28, 0x13, 0x9a, 0xac, 0xa4, 0x55, 0x68,
+	0x1b, 0x9d, 0xea, 0xc9, 0x27, 0xce, 0xdb, 0xf6, 0xe3, 0x64, 0x73, 0x8f, 0xc1, 0x4b, 0xd2, 0x0c,
+	0xfe, 0x1c, 0xd5, 0x57, 0x91, 0xea, 0xc3, 0xa3, 0xf9, 0x34, 0x60, 0x7c, 0x1e, 0x24, 0xd6, 0x76,
+	0xdb, 0xe8, 0xe4, 0x49, 0x6d, 0xc3, 0xff, 0x00, 0xb4, 0xb2, 0x52, 0xcf, 0x5b, 0xc5, 0x34, 0x61,
+	0x53, 0x7d, 0x12, 0x69, 0x15, 0xdb, 0x46, 0xa7, 0x40, 0x6a, 0x19, 0xaf, 0x1f, 0x22, 0xf1, 0x67,
+	0xa8, 0x26, 0x17, 0x54, 0x06, 0xcc, 0xdf, 0x38, 0x4b, 0xe0, 0xac, 0xa6, 0x74, 0x66, 0xfc, 0x14,
+	0x55, 0x43, 0x2e, 0x25, 0xf8, 0xd6, 0x8c, 0x2e, 0xa4, 0x65, 0x82, 0x6f, 0x57, 0xb3, 0x44, 0x93,
+	0x47, 0xff, 0xe5, 0x51, 0xe5, 0x47, 0x9a, 0x78, 0x81, 0xce, 0xe1, 0xaf, 0x91, 0x99, 0xbd, 0x14,
+	0xef, 0x5c, 0xfe, 0xc6, 0x89, 0x9b, 0xc8, 0x0c, 0x55, 0x93, 0x29, 0xf7, 0x61, 0xfd, 0x79, 0x52,
+	0x02, 0x3c, 0xf4, 0xf1, 0x3e, 0x2a, 0x2f, 0x18, 0x9d, 0xaf, 0x98, 0xd2, 0xf2, 0xa0, 0x99, 0x9a,
+	0x18, 0xfa, 0xf8, 0x10, 0x55, 0x52, 0x31, 0xa2, 0x21, 0x83, 0x35, 0x97, 0x09, 0xd2, 0xd4, 0x88,
+	0x86, 0x0c, 0x7f, 0x88, 0x4a, 0x81, 0x08, 0x21, 0xab, 0x77, 0x57, 0x54, 0x50, 0xb7, 0x05, 0x01,
+	0x72, 0x45, 0xc8, 0x99, 0x8a, 0xc8, 0x52, 0xf4, 0x57, 0x7a, 0xad, 0x52, 0x25, 0x9d, 0x52, 0x50,
+	0xa7, 0x40, 0x80, 0x94, 0xa9, 0x53, 0x8a, 0x80, 0xd4, 0x01, 0x42, 0xd0, 0x52, 0x7a, 0x22, 0x66,
+	0x56, 0x19, 0x82, 0xf0, 0x90, 0xb1, 0x22, 0x94, 0x0c, 0x59, 0x2d, 0x23, 0x2d, 0x2b, 0x46, 0xcb,
+	0x16, 0x2a, 0xc9, 0x84, 0xc6, 0x09, 0xf3, 0xad, 0x4a, 0xdb, 0xe8, 0x98, 0x24, 0x83, 0xb8, 0x85,
+	0xcc, 0x2b, 0x1e, 0x71, 0x75, 0x1c, 0x6b, 0x07, 0xa4, 0x0d, 0xc6, 0x1f, 0xa1, 0xb2, 0x47, 0x23,
+	0x8f, 0x2d, 0x16, 0xcc, 0xb7, 0x76, 0x41, 0x7c, 0x20, 0x30, 0x46, 0x85, 0x84, 0x87, 0xcc, 0xaa,
+	0xc2, 0xa4, 0x50, 0xe3, 0x3d, 0x54, 0x4c, 0x5f, 0xa6, 0x5a, 0xba, 0x10, 0x40, 0x47, 0x7f, 0x1a,
+	0x68, 0x47, 0xdf, 0xb0, 0x2f, 0xc2, 0x90, 0xbf, 0x87, 0x4b, 0x62, 0x54, 0x08, 0xa8, 0x0c, 0xe0,
+	0x88, 0x3b, 0x04, 0x6a, 0xdc, 0x40, 0xdb, 0xb1, 0x58, 0x45, 0x3e, 0x9c, 0xae, 0x40, 0x34, 0x78,
+	0x34, 0xe3, 0xf6, 0xe3, 0x19, 0x8f, 0x63, 0x54, 0x7d, 0xf5, 0xcf, 0x82, 0x0f, 0xd1, 0x3e, 0x19,
+	0x5c, 0x9c, 0x93, 0xc9, 0x80, 0x4c, 0xc7, 0x93, 0xd3, 0xc9, 0xe5, 0x78, 0x7a, 0x39, 0x1a, 0x5f,
+	0x0c, 0xfa, 0xc3, 0xef, 0x86, 0x83, 0xb3, 0x7a, 0x0e, 0xb7, 0xd0, 0xde, 0xeb, 0x86, 0xd3, 0xfe,
+	0x64, 0xf8, 0xd3, 0xa0, 0x6e, 0xe0, 0x03, 0xd4, 0x7c, 0x33, 0xdc, 0x3b, 0x1f, 0x9d, 0x0d, 0x47,
+	0xdf, 0xd7, 0xb7, 0x5a, 0x85, 0xdf, 0xff, 0xb2, 0x73, 0xbd, 0xc1, 0xcd, 0x9d, 0x6d, 0xdc, 0xde,
+	0xd9, 0xc6, 0xb3, 0x3b, 0xdb, 0xf8, 0xe3, 0xde, 0xce, 0xdd, 0xde, 0xdb, 0xb9, 0x27, 0xf7, 0x76,
+	0xee, 0xe7, 0x2f, 0xe6, 0x3c, 0x09, 0x56, 0x33, 0xc7, 0x13, 0xa1, 0x1b, 0x53, 0x7e, 0xb5, 0xbc,
+	0x7e, 0xf8, 0x36, 0xfe, 0xf6, 0x50, 0x26, 0xd7, 0x4b, 0x26, 0x67, 0x45, 0xf8, 0x4e, 0x7d, 0xf5,
+	0x32, 0x00, 0x00, 0xff, 0xff, 0x54, 0xd0, 0x7c, 0x33, 0x48, 0x05, 0x00, 0x00,
+}
+
+func (m *Reporter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reporter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedReveals != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.MissedReveals))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SlashedReports != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.SlashedReports))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AccurateReports != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.AccurateReports))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingHeight != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.UnbondingHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReporter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MatchReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatchReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MatchReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.AwayName) > 0 {
+		i -= len(m.AwayName)
+		copy(dAtA[i:], m.AwayName)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.AwayName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.AwayId != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.AwayId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.HomeName) > 0 {
+		i -= len(m.HomeName)
+		copy(dAtA[i:], m.HomeName)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.HomeName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HomeId != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.HomeId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LeagueName) > 0 {
+		i -= len(m.LeagueName)
+		copy(dAtA[i:], m.LeagueName)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.LeagueName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LeagueId != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.LeagueId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Round != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintReporter(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintReporter(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReporter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReporter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Reporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovReporter(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovReporter(uint64(m.Status))
+	}
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovReporter(uint64(m.UnbondingHeight))
+	}
+	if m.AccurateReports != 0 {
+		n += 1 + sovReporter(uint64(m.AccurateReports))
+	}
+	if m.SlashedReports != 0 {
+		n += 1 + sovReporter(uint64(m.SlashedReports))
+	}
+	if m.MissedReveals != 0 {
+		n += 1 + sovReporter(uint64(m.MissedReveals))
+	}
+	return n
+}
+
+func (m *MatchReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovReporter(uint64(m.MatchId))
+	}
+	if m.LeagueId != 0 {
+		n += 1 + sovReporter(uint64(m.LeagueId))
+	}
+	l = len(m.LeagueName)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	if m.HomeId != 0 {
+		n += 1 + sovReporter(uint64(m.HomeId))
+	}
+	l = len(m.HomeName)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	if m.AwayId != 0 {
+		n += 1 + sovReporter(uint64(m.AwayId))
+	}
+	l = len(m.AwayName)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	if m.HomeScore != 0 {
+		n += 1 + sovReporter(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovReporter(uint64(m.AwayScore))
+	}
+	if m.Started {
+		n += 2
+	}
+	if m.Finished {
+		n += 2
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovReporter(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ReportCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovReporter(uint64(m.MatchId))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovReporter(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovReporter(uint64(m.Round))
+	}
+	if m.Height != 0 {
+		n += 1 + sovReporter(uint64(m.Height))
+	}
+	return n
+}
+
+func sovReporter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReporter(x uint64) (n int) {
+	return sovReporter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Reporter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reporter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reporter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ReporterStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccurateReports", wireType)
+			}
+			m.AccurateReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccurateReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedReports", wireType)
+			}
+			m.SlashedReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedReports |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedReveals", wireType)
+			}
+			m.MissedReveals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedReveals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MatchReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MatchReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MatchReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeagueId", wireType)
+			}
+			m.LeagueId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeagueId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeagueName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeagueName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeId", wireType)
+			}
+			m.HomeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HomeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayId", wireType)
+			}
+			m.AwayId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AwayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
+			}
+			m.HomeScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
+			}
+			m.AwayScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finished = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReporter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReporter
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReporter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReporter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReporter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReporter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReporter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReporter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReporter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReporter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReporter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReporter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReporter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// DefaultSearchLimit is the number of teams or leagues a search returns by default.
+	DefaultSearchLimit = 20
+	// MaxSearchLimit is the max number of teams or leagues a search returns.
+	MaxSearchLimit = 100
+)
+
+// foldedLetters are the letters without a canonical decomposition, mapped to their base letters.
+var foldedLetters = strings.NewReplacer("ı", "i", "ł", "l", "ø", "o", "đ", "d", "ð", "d", "þ", "th", "æ", "ae", "œ", "oe")
+
+// NormalizeName normalizes a team or league name for the name search: case-folded, without diacritics, and
+// with the runs of other characters than letters and digits as single spaces, e.g. "Fenerbahçe S.K." is
+// "fenerbahce s k".
+func NormalizeName(name string) string {
+	// the transformer is stateful, it is not shared
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), cases.Fold(), norm.NFC)
+	folded, _, err := transform.String(t, name)
+	if err != nil {
+		folded = strings.ToLower(name)
+	}
+	folded = foldedLetters.Replace(folded)
+	return strings.Join(strings.FieldsFunc(folded, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// NameSearchTerms returns the terms indexing names in the name search: each normalized name from each of
+// its words, e.g. "real madrid" and "madrid" for Real Madrid. A search matches the terms starting with
+// the normalized query.
+func NameSearchTerms(names ...string) []string {
+	var terms []string
+	seen := map[string]bool{}
+	for _, name := range names {
+		words := strings.Fields(NormalizeName(name))
+		for i := range words {
+			term := strings.Join(words[i:], " ")
+			if !seen[term] {
+				seen[term] = true
+				terms = append(terms, term)
+			}
+		}
+	}
+	return terms
+}
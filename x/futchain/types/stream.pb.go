@@ -0,0 +1,1049 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: futchain/futchain/v1/stream.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WatchMatchesRequest defines the WatchMatchesRequest message. Empty filters
+// match all matches.
+type WatchMatchesRequest struct {
+	LeagueIds []int64 `protobuf:"varint,1,rep,packed,name=league_ids,json=leagueIds,proto3" json:"league_ids,omitempty"`
+	// team_ids selects the matches played by the teams, home or away.
+	TeamIds  []int64 `protobuf:"varint,2,rep,packed,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"`
+	MatchIds []int64 `protobuf:"varint,3,rep,packed,name=match_ids,json=matchIds,proto3" json:"match_ids,omitempty"`
+	// min_priority drops the match updates of a lower priority. New matches
+	// are always sent.
+	MinPriority MatchUpdatePriority `protobuf:"varint,4,opt,name=min_priority,json=minPriority,proto3,enum=futchain.futchain.v1.MatchUpdatePriority" json:"min_priority,omitempty"`
+}
+
+func (m *WatchMatchesRequest) Reset()         { *m = WatchMatchesRequest{} }
+func (m *WatchMatchesRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchMatchesRequest) ProtoMessage()    {}
+func (*WatchMatchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b698623851f04623, []int{0}
+}
+func (m *WatchMatchesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchMatchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchMatchesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchMatchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchMatchesRequest.Merge(m, src)
+}
+func (m *WatchMatchesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchMatchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchMatchesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchMatchesRequest proto.InternalMessageInfo
+
+func (m *WatchMatchesRequest) GetLeagueIds() []int64 {
+	if m != nil {
+		return m.LeagueIds
+	}
+	return nil
+}
+
+func (m *WatchMatchesRequest) GetTeamIds() []int64 {
+	if m != nil {
+		return m.TeamIds
+	}
+	return nil
+}
+
+func (m *WatchMatchesRequest) GetMatchIds() []int64 {
+	if m != nil {
+		return m.MatchIds
+	}
+	return nil
+}
+
+func (m *WatchMatchesRequest) GetMinPriority() MatchUpdatePriority {
+	if m != nil {
+		return m.MinPriority
+	}
+	return MATCH_UPDATE_PRIORITY_NO_CHANGES
+}
+
+// WatchMatchesResponse defines the WatchMatchesResponse message.
+type WatchMatchesResponse struct {
+	// height is the height of the block the match changed in.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// new is true for a newly ingested match.
+	New      bool                `protobuf:"varint,2,opt,name=new,proto3" json:"new,omitempty"`
+	Priority MatchUpdatePriority `protobuf:"varint,3,opt,name=priority,proto3,enum=futchain.futchain.v1.MatchUpdatePriority" json:"priority,omitempty"`
+	Match    MatchState          `protobuf:"bytes,4,opt,name=match,proto3" json:"match"`
+}
+
+func (m *WatchMatchesResponse) Reset()         { *m = WatchMatchesResponse{} }
+func (m *WatchMatchesResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchMatchesResponse) ProtoMessage()    {}
+func (*WatchMatchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b698623851f04623, []int{1}
+}
+func (m *WatchMatchesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchMatchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchMatchesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchMatchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchMatchesResponse.Merge(m, src)
+}
+func (m *WatchMatchesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchMatchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchMatchesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchMatchesResponse proto.InternalMessageInfo
+
+func (m *WatchMatchesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *WatchMatchesResponse) GetNew() bool {
+	if m != nil {
+		return m.New
+	}
+	return false
+}
+
+func (m *WatchMatchesResponse) GetPriority() MatchUpdatePriority {
+	if m != nil {
+		return m.Priority
+	}
+	return MATCH_UPDATE_PRIORITY_NO_CHANGES
+}
+
+func (m *WatchMatchesResponse) GetMatch() MatchState {
+	if m != nil {
+		return m.Match
+	}
+	return MatchState{}
+}
+
+func init() {
+	proto.RegisterType((*WatchMatchesRequest)(nil), "futchain.futchain.v1.WatchMatchesRequest")
+	proto.RegisterType((*WatchMatchesResponse)(nil), "futchain.futchain.v1.WatchMatchesResponse")
+}
+
+func init() { proto.RegisterFile("futchain/futchain/v1/stream.proto", fileDescriptor_b698623851f04623) }
+
+var fileDescriptor_b698623851f04623 = []byte{
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x4b, 0xc3, 0x40,
+	0x10, 0xcd, 0x36, 0xb5, 0xa6, 0xdb, 0x22, 0xb2, 0x16, 0x89, 0x15, 0x63, 0xec, 0x29, 0x2a, 0x24,
+	0xb6, 0x5e, 0x3d, 0x15, 0x7a, 0x10, 0x14, 0x24, 0x45, 0x04, 0x2f, 0x65, 0xdb, 0x6e, 0x93, 0x05,
+	0xf3, 0xd1, 0xec, 0xa6, 0xda, 0x7f, 0xe1, 0xef, 0xf1, 0x2e, 0xf4, 0xd8, 0xa3, 0x27, 0x91, 0xf6,
+	0x8f, 0x48, 0x36, 0x6d, 0xaa, 0x50, 0x45, 0x2f, 0xe1, 0xcd, 0xbc, 0xbc, 0xe1, 0xbd, 0x9d, 0x81,
+	0x47, 0x83, 0x98, 0xf7, 0x5c, 0x4c, 0x7d, 0x2b, 0x03, 0xa3, 0xba, 0xc5, 0x78, 0x44, 0xb0, 0x67,
+	0x86, 0x51, 0xc0, 0x03, 0x54, 0x59, 0x32, 0x66, 0x06, 0x46, 0xf5, 0xea, 0x7a, 0x21, 0x19, 0x11,
+	0x9f, 0xb3, 0x54, 0x58, 0xad, 0x38, 0x81, 0x13, 0x08, 0x68, 0x25, 0x28, 0xed, 0xd6, 0x5e, 0x00,
+	0xdc, 0xb9, 0xc3, 0xbc, 0xe7, 0x5e, 0x27, 0x1f, 0xc2, 0x6c, 0x32, 0x8c, 0x09, 0xe3, 0xe8, 0x00,
+	0xc2, 0x07, 0x82, 0x9d, 0x98, 0x74, 0x68, 0x9f, 0xa9, 0x40, 0x97, 0x0d, 0xd9, 0x2e, 0xa6, 0x9d,
+	0xcb, 0x3e, 0x43, 0x7b, 0x50, 0xe1, 0x04, 0x7b, 0x82, 0xcc, 0x09, 0x72, 0x33, 0xa9, 0x13, 0x6a,
+	0x1f, 0x16, 0xbd, 0x64, 0x96, 0xe0, 0x64, 0xc1, 0x29, 0xa2, 0x91, 0x90, 0x57, 0xb0, 0xec, 0x51,
+	0xbf, 0x13, 0x46, 0x34, 0x88, 0x28, 0x1f, 0xab, 0x79, 0x1d, 0x18, 0x5b, 0x8d, 0x63, 0x73, 0x5d,
+	0x28, 0x53, 0x58, 0xba, 0x0d, 0xfb, 0x98, 0x93, 0x9b, 0x85, 0xc0, 0x2e, 0x79, 0xd4, 0x5f, 0x16,
+	0xb5, 0x57, 0x00, 0x2b, 0xdf, 0xcd, 0xb3, 0x30, 0xf0, 0x19, 0x41, 0xbb, 0xb0, 0xe0, 0x12, 0xea,
+	0xb8, 0x5c, 0x05, 0x3a, 0x30, 0x64, 0x7b, 0x51, 0xa1, 0x6d, 0x28, 0xfb, 0xe4, 0x51, 0xcd, 0xe9,
+	0xc0, 0x50, 0xec, 0x04, 0xa2, 0x16, 0x54, 0x32, 0x33, 0xf2, 0x7f, 0xcd, 0x64, 0x52, 0x74, 0x01,
+	0x37, 0x44, 0x46, 0x11, 0xa8, 0xd4, 0xd0, 0x7f, 0x99, 0xd1, 0xe6, 0x98, 0x93, 0x66, 0x7e, 0xf2,
+	0x7e, 0x28, 0xd9, 0xa9, 0xa8, 0x31, 0x84, 0x85, 0xb6, 0xd8, 0x31, 0x72, 0x60, 0xf9, 0x6b, 0x20,
+	0xf4, 0x83, 0x99, 0x35, 0x1b, 0xab, 0x9e, 0xfc, 0xe5, 0xd7, 0xf4, 0x7d, 0xce, 0x40, 0xb3, 0x35,
+	0x99, 0x69, 0x60, 0x3a, 0xd3, 0xc0, 0xc7, 0x4c, 0x03, 0xcf, 0x73, 0x4d, 0x9a, 0xce, 0x35, 0xe9,
+	0x6d, 0xae, 0x49, 0xf7, 0xa7, 0x0e, 0xe5, 0x6e, 0xdc, 0x35, 0x7b, 0x81, 0x67, 0x45, 0x98, 0x0e,
+	0xc2, 0xf1, 0xea, 0xa6, 0x9e, 0x56, 0x90, 0x8f, 0x43, 0xc2, 0xba, 0x05, 0x71, 0x45, 0xe7, 0x9f,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0x14, 0x48, 0x64, 0x8a, 0xb9, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamClient interface {
+	// WatchMatches streams the new and updated matches as blocks commit.
+	WatchMatches(ctx context.Context, in *WatchMatchesRequest, opts ...grpc.CallOption) (Stream_WatchMatchesClient, error)
+}
+
+type streamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamClient(cc grpc1.ClientConn) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) WatchMatches(ctx context.Context, in *WatchMatchesRequest, opts ...grpc.CallOption) (Stream_WatchMatchesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Stream_serviceDesc.Streams[0], "/futchain.futchain.v1.Stream/WatchMatches", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamWatchMatchesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stream_WatchMatchesClient interface {
+	Recv() (*WatchMatchesResponse, error)
+	grpc.ClientStream
+}
+
+type streamWatchMatchesClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamWatchMatchesClient) Recv() (*WatchMatchesResponse, error) {
+	m := new(WatchMatchesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+type StreamServer interface {
+	// WatchMatches streams the new and updated matches as blocks commit.
+	WatchMatches(*WatchMatchesRequest, Stream_WatchMatchesServer) error
+}
+
+// UnimplementedStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (*UnimplementedStreamServer) WatchMatches(req *WatchMatchesRequest, srv Stream_WatchMatchesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMatches not implemented")
+}
+
+func RegisterStreamServer(s grpc1.Server, srv StreamServer) {
+	s.RegisterService(&_Stream_serviceDesc, srv)
+}
+
+func _Stream_WatchMatches_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMatchesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServer).WatchMatches(m, &streamWatchMatchesServer{stream})
+}
+
+type Stream_WatchMatchesServer interface {
+	Send(*WatchMatchesResponse) error
+	grpc.ServerStream
+}
+
+type streamWatchMatchesServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamWatchMatchesServer) Send(m *WatchMatchesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var Stream_serviceDesc = _Stream_serviceDesc
+var _Stream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "futchain.futchain.v1.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchMatches",
+			Handler:       _Stream_WatchMatches_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "futchain/futchain/v1/stream.proto",
+}
+
+func (m *WatchMatchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchMatchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchMatchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinPriority != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.MinPriority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MatchIds) > 0 {
+		dAtA2 := make([]byte, len(m.MatchIds)*10)
+		var j1 int
+		for _, num1 := range m.MatchIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintStream(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TeamIds) > 0 {
+		dAtA4 := make([]byte, len(m.TeamIds)*10)
+		var j3 int
+		for _, num1 := range m.TeamIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintStream(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LeagueIds) > 0 {
+		dAtA6 := make([]byte, len(m.LeagueIds)*10)
+		var j5 int
+		for _, num1 := range m.LeagueIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintStream(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchMatchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchMatchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchMatchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Priority != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.New {
+		i--
+		if m.New {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WatchMatchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LeagueIds) > 0 {
+		l = 0
+		for _, e := range m.LeagueIds {
+			l += sovStream(uint64(e))
+		}
+		n += 1 + sovStream(uint64(l)) + l
+	}
+	if len(m.TeamIds) > 0 {
+		l = 0
+		for _, e := range m.TeamIds {
+			l += sovStream(uint64(e))
+		}
+		n += 1 + sovStream(uint64(l)) + l
+	}
+	if len(m.MatchIds) > 0 {
+		l = 0
+		for _, e := range m.MatchIds {
+			l += sovStream(uint64(e))
+		}
+		n += 1 + sovStream(uint64(l)) + l
+	}
+	if m.MinPriority != 0 {
+		n += 1 + sovStream(uint64(m.MinPriority))
+	}
+	return n
+}
+
+func (m *WatchMatchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStream(uint64(m.Height))
+	}
+	if m.New {
+		n += 2
+	}
+	if m.Priority != 0 {
+		n += 1 + sovStream(uint64(m.Priority))
+	}
+	l = m.Match.Size()
+	n += 1 + l + sovStream(uint64(l))
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WatchMatchesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchMatchesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchMatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStream
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LeagueIds = append(m.LeagueIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStream
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStream
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStream
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LeagueIds) == 0 {
+					m.LeagueIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStream
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LeagueIds = append(m.LeagueIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeagueIds", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStream
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TeamIds = append(m.TeamIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStream
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStream
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStream
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TeamIds) == 0 {
+					m.TeamIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStream
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TeamIds = append(m.TeamIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamIds", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStream
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MatchIds = append(m.MatchIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStream
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStream
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStream
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MatchIds) == 0 {
+					m.MatchIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStream
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MatchIds = append(m.MatchIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchIds", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriority", wireType)
+			}
+			m.MinPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPriority |= MatchUpdatePriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchMatchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchMatchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchMatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.New = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= MatchUpdatePriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Match.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
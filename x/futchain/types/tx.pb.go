@@ -5,13 +5,16 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -126,229 +129,10004 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "futchain.futchain.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "futchain.futchain.v1.MsgUpdateParamsResponse")
+// MsgCreateMarket is the Msg/CreateMarket request type.
+type MsgCreateMarket struct {
+	Creator    string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	MatchId    int64      `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	MarketType MarketType `protobuf:"varint,3,opt,name=market_type,json=marketType,proto3,enum=futchain.futchain.v1.MarketType" json:"market_type,omitempty"`
+	// line is the goal line of over/under markets in half goals, e.g. 5 is the
+	// 2.5 goals line; it must be odd. Unused otherwise.
+	Line uint64 `protobuf:"varint,4,opt,name=line,proto3" json:"line,omitempty"`
 }
 
-func init() { proto.RegisterFile("futchain/futchain/v1/tx.proto", fileDescriptor_3640b1e2d8344897) }
+func (m *MsgCreateMarket) Reset()         { *m = MsgCreateMarket{} }
+func (m *MsgCreateMarket) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMarket) ProtoMessage()    {}
+func (*MsgCreateMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{2}
+}
+func (m *MsgCreateMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMarket.Merge(m, src)
+}
+func (m *MsgCreateMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMarket.DiscardUnknown(m)
+}
 
-var fileDescriptor_3640b1e2d8344897 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2b, 0x2d, 0x49,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x33, 0xca, 0x0c, 0xf5, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a, 0xf2,
-	0x4b, 0xf2, 0x85, 0x44, 0x60, 0xa2, 0x7a, 0x70, 0x46, 0x99, 0xa1, 0x94, 0x60, 0x62, 0x6e, 0x66,
-	0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x28, 0x94, 0x12, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf,
-	0x2d, 0x4e, 0x07, 0x19, 0x90, 0x5b, 0x9c, 0x0e, 0x95, 0x90, 0x84, 0x48, 0xc4, 0x83, 0x79, 0xfa,
-	0x10, 0x0e, 0x54, 0x4a, 0x11, 0xab, 0xdd, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x30, 0x25, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x10, 0xad, 0x20, 0x16, 0x44, 0x54, 0xe9, 0x10, 0x23, 0x17, 0xbf, 0x6f, 0x71,
-	0x7a, 0x68, 0x41, 0x4a, 0x62, 0x49, 0x6a, 0x00, 0x58, 0xbd, 0x90, 0x19, 0x17, 0x67, 0x62, 0x69,
-	0x49, 0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5,
-	0x2d, 0xba, 0x22, 0x50, 0x1b, 0x1d, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x83, 0x4b, 0x8a, 0x32,
-	0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xec, 0xb9, 0xd8, 0x20, 0x36, 0x4a, 0x30, 0x29, 0x30, 0x6a,
-	0x70, 0x1b, 0xc9, 0xe8, 0x61, 0xf3, 0xb2, 0x1e, 0xc4, 0x16, 0x27, 0xce, 0x13, 0xf7, 0xe4, 0x19,
-	0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x66, 0x65, 0xd6, 0xf4, 0x7c, 0x83, 0x16, 0xc2,
-	0xc0, 0xae, 0xe7, 0x1b, 0xb4, 0x94, 0xe1, 0xfe, 0xa9, 0x40, 0x78, 0x0d, 0xcd, 0xc1, 0x4a, 0x92,
-	0x5c, 0xe2, 0x68, 0x42, 0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0x45, 0x5c, 0xcc,
-	0xbe, 0xc5, 0xe9, 0x42, 0x29, 0x5c, 0x3c, 0x28, 0x5e, 0x54, 0xc5, 0xee, 0x34, 0x34, 0x53, 0xa4,
-	0x74, 0x89, 0x52, 0x06, 0xb3, 0x4c, 0x8a, 0xb5, 0x01, 0xe4, 0x1d, 0x27, 0xd7, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4e, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x2f, 0x4a, 0xcc, 0x4c, 0x2b, 0xa8, 0xd4, 0xc7, 0xe6, 0xbf, 0x92, 0xca, 0x82,
-	0xd4, 0xe2, 0x24, 0x36, 0x70, 0x0c, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x04, 0x70, 0x8b,
-	0x6f, 0x58, 0x02, 0x00, 0x00,
+var xxx_messageInfo_MsgCreateMarket proto.InternalMessageInfo
+
+func (m *MsgCreateMarket) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgCreateMarket) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgCreateMarket) GetMarketType() MarketType {
+	if m != nil {
+		return m.MarketType
+	}
+	return MARKET_TYPE_UNSPECIFIED
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgCreateMarket) GetLine() uint64 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+// MsgCreateMarketResponse defines the response structure for executing a
+// MsgCreateMarket message.
+type MsgCreateMarketResponse struct {
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCreateMarketResponse) Reset()         { *m = MsgCreateMarketResponse{} }
+func (m *MsgCreateMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateMarketResponse) ProtoMessage()    {}
+func (*MsgCreateMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{3}
+}
+func (m *MsgCreateMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateMarketResponse.Merge(m, src)
+}
+func (m *MsgCreateMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateMarketResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateMarketResponse proto.InternalMessageInfo
+
+func (m *MsgCreateMarketResponse) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+// MsgPlaceStake is the Msg/PlaceStake request type.
+type MsgPlaceStake struct {
+	Staker   string     `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	MarketId uint64     `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Outcome  uint64     `protobuf:"varint,3,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgPlaceStake) Reset()         { *m = MsgPlaceStake{} }
+func (m *MsgPlaceStake) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceStake) ProtoMessage()    {}
+func (*MsgPlaceStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{4}
+}
+func (m *MsgPlaceStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceStake.Merge(m, src)
+}
+func (m *MsgPlaceStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceStake.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+var xxx_messageInfo_MsgPlaceStake proto.InternalMessageInfo
+
+func (m *MsgPlaceStake) GetStaker() string {
+	if m != nil {
+		return m.Staker
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgPlaceStake) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgPlaceStake) GetOutcome() uint64 {
+	if m != nil {
+		return m.Outcome
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return 0
+}
+
+func (m *MsgPlaceStake) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/futchain.futchain.v1.Msg/UpdateParams",
+	return types.Coin{}
+}
+
+// MsgPlaceStakeResponse defines the response structure for executing a
+// MsgPlaceStake message.
+type MsgPlaceStakeResponse struct {
+}
+
+func (m *MsgPlaceStakeResponse) Reset()         { *m = MsgPlaceStakeResponse{} }
+func (m *MsgPlaceStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceStakeResponse) ProtoMessage()    {}
+func (*MsgPlaceStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{5}
+}
+func (m *MsgPlaceStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgPlaceStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceStakeResponse.Merge(m, src)
+}
+func (m *MsgPlaceStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceStakeResponse proto.InternalMessageInfo
+
+// MsgMintOutcomeShares is the Msg/MintOutcomeShares request type.
+type MsgMintOutcomeShares struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MatchId int64  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// collateral is locked, and the same amount of every outcome share is minted.
+	Collateral types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
+}
+
+func (m *MsgMintOutcomeShares) Reset()         { *m = MsgMintOutcomeShares{} }
+func (m *MsgMintOutcomeShares) String() string { return proto.CompactTextString(m) }
+func (*MsgMintOutcomeShares) ProtoMessage()    {}
+func (*MsgMintOutcomeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{6}
+}
+func (m *MsgMintOutcomeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintOutcomeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintOutcomeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgMintOutcomeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintOutcomeShares.Merge(m, src)
+}
+func (m *MsgMintOutcomeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintOutcomeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintOutcomeShares.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "futchain.futchain.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "futchain/futchain/v1/tx.proto",
+var xxx_messageInfo_MsgMintOutcomeShares proto.InternalMessageInfo
+
+func (m *MsgMintOutcomeShares) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMintOutcomeShares) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMintOutcomeShares) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// MsgMintOutcomeSharesResponse defines the response structure for executing a
+// MsgMintOutcomeShares message.
+type MsgMintOutcomeSharesResponse struct {
+}
+
+func (m *MsgMintOutcomeSharesResponse) Reset()         { *m = MsgMintOutcomeSharesResponse{} }
+func (m *MsgMintOutcomeSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMintOutcomeSharesResponse) ProtoMessage()    {}
+func (*MsgMintOutcomeSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{7}
+}
+func (m *MsgMintOutcomeSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMintOutcomeSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMintOutcomeSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMintOutcomeSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMintOutcomeSharesResponse.Merge(m, src)
+}
+func (m *MsgMintOutcomeSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMintOutcomeSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMintOutcomeSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMintOutcomeSharesResponse proto.InternalMessageInfo
+
+// MsgBurnOutcomeShares is the Msg/BurnOutcomeShares request type.
+type MsgBurnOutcomeShares struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MatchId int64  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// amount of complete sets to burn.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgBurnOutcomeShares) Reset()         { *m = MsgBurnOutcomeShares{} }
+func (m *MsgBurnOutcomeShares) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnOutcomeShares) ProtoMessage()    {}
+func (*MsgBurnOutcomeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{8}
+}
+func (m *MsgBurnOutcomeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnOutcomeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnOutcomeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnOutcomeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnOutcomeShares.Merge(m, src)
+}
+func (m *MsgBurnOutcomeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnOutcomeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnOutcomeShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnOutcomeShares proto.InternalMessageInfo
+
+func (m *MsgBurnOutcomeShares) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgBurnOutcomeShares) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+// MsgBurnOutcomeSharesResponse defines the response structure for executing a
+// MsgBurnOutcomeShares message.
+type MsgBurnOutcomeSharesResponse struct {
+}
+
+func (m *MsgBurnOutcomeSharesResponse) Reset()         { *m = MsgBurnOutcomeSharesResponse{} }
+func (m *MsgBurnOutcomeSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnOutcomeSharesResponse) ProtoMessage()    {}
+func (*MsgBurnOutcomeSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{9}
+}
+func (m *MsgBurnOutcomeSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnOutcomeSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnOutcomeSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnOutcomeSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnOutcomeSharesResponse.Merge(m, src)
+}
+func (m *MsgBurnOutcomeSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnOutcomeSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnOutcomeSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnOutcomeSharesResponse proto.InternalMessageInfo
+
+// MsgRedeemOutcomeShares is the Msg/RedeemOutcomeShares request type.
+type MsgRedeemOutcomeShares struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	MatchId int64  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+}
+
+func (m *MsgRedeemOutcomeShares) Reset()         { *m = MsgRedeemOutcomeShares{} }
+func (m *MsgRedeemOutcomeShares) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemOutcomeShares) ProtoMessage()    {}
+func (*MsgRedeemOutcomeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{10}
+}
+func (m *MsgRedeemOutcomeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemOutcomeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemOutcomeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemOutcomeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemOutcomeShares.Merge(m, src)
+}
+func (m *MsgRedeemOutcomeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemOutcomeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemOutcomeShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemOutcomeShares proto.InternalMessageInfo
+
+func (m *MsgRedeemOutcomeShares) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRedeemOutcomeShares) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+// MsgRedeemOutcomeSharesResponse defines the response structure for executing a
+// MsgRedeemOutcomeShares message.
+type MsgRedeemOutcomeSharesResponse struct {
+	Payout types.Coin `protobuf:"bytes,1,opt,name=payout,proto3" json:"payout"`
+}
+
+func (m *MsgRedeemOutcomeSharesResponse) Reset()         { *m = MsgRedeemOutcomeSharesResponse{} }
+func (m *MsgRedeemOutcomeSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemOutcomeSharesResponse) ProtoMessage()    {}
+func (*MsgRedeemOutcomeSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{11}
+}
+func (m *MsgRedeemOutcomeSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemOutcomeSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemOutcomeSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemOutcomeSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemOutcomeSharesResponse.Merge(m, src)
+}
+func (m *MsgRedeemOutcomeSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemOutcomeSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemOutcomeSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemOutcomeSharesResponse proto.InternalMessageInfo
+
+func (m *MsgRedeemOutcomeSharesResponse) GetPayout() types.Coin {
+	if m != nil {
+		return m.Payout
+	}
+	return types.Coin{}
+}
+
+// MsgOverrideMatch is the Msg/OverrideMatch request type.
+type MsgOverrideMatch struct {
+	// authority is the module authority or the data council.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MatchId   int64  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	HomeScore int64  `protobuf:"varint,3,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore int64  `protobuf:"varint,4,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Started   bool   `protobuf:"varint,5,opt,name=started,proto3" json:"started,omitempty"`
+	Finished  bool   `protobuf:"varint,6,opt,name=finished,proto3" json:"finished,omitempty"`
+	Cancelled bool   `protobuf:"varint,7,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// reason is recorded in the emitted event for auditing.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgOverrideMatch) Reset()         { *m = MsgOverrideMatch{} }
+func (m *MsgOverrideMatch) String() string { return proto.CompactTextString(m) }
+func (*MsgOverrideMatch) ProtoMessage()    {}
+func (*MsgOverrideMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{12}
+}
+func (m *MsgOverrideMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOverrideMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOverrideMatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOverrideMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOverrideMatch.Merge(m, src)
+}
+func (m *MsgOverrideMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOverrideMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOverrideMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOverrideMatch proto.InternalMessageInfo
+
+func (m *MsgOverrideMatch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgOverrideMatch) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *MsgOverrideMatch) GetHomeScore() int64 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *MsgOverrideMatch) GetAwayScore() int64 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *MsgOverrideMatch) GetStarted() bool {
+	if m != nil {
+		return m.Started
+	}
+	return false
+}
+
+func (m *MsgOverrideMatch) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+func (m *MsgOverrideMatch) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *MsgOverrideMatch) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgOverrideMatchResponse defines the response structure for executing a
+// MsgOverrideMatch message.
+type MsgOverrideMatchResponse struct {
+}
+
+func (m *MsgOverrideMatchResponse) Reset()         { *m = MsgOverrideMatchResponse{} }
+func (m *MsgOverrideMatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOverrideMatchResponse) ProtoMessage()    {}
+func (*MsgOverrideMatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{13}
+}
+func (m *MsgOverrideMatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOverrideMatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOverrideMatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOverrideMatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOverrideMatchResponse.Merge(m, src)
+}
+func (m *MsgOverrideMatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOverrideMatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOverrideMatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOverrideMatchResponse proto.InternalMessageInfo
+
+// MsgVoidMatch is the Msg/VoidMatch request type.
+type MsgVoidMatch struct {
+	// authority is the module authority or the data council.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MatchId   int64  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// reason is recorded in the emitted event for auditing.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgVoidMatch) Reset()         { *m = MsgVoidMatch{} }
+func (m *MsgVoidMatch) String() string { return proto.CompactTextString(m) }
+func (*MsgVoidMatch) ProtoMessage()    {}
+func (*MsgVoidMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{14}
+}
+func (m *MsgVoidMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoidMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoidMatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoidMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoidMatch.Merge(m, src)
+}
+func (m *MsgVoidMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoidMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoidMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoidMatch proto.InternalMessageInfo
+
+func (m *MsgVoidMatch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgVoidMatch) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *MsgVoidMatch) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgVoidMatchResponse defines the response structure for executing a
+// MsgVoidMatch message.
+type MsgVoidMatchResponse struct {
+}
+
+func (m *MsgVoidMatchResponse) Reset()         { *m = MsgVoidMatchResponse{} }
+func (m *MsgVoidMatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoidMatchResponse) ProtoMessage()    {}
+func (*MsgVoidMatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{15}
+}
+func (m *MsgVoidMatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoidMatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoidMatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoidMatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoidMatchResponse.Merge(m, src)
+}
+func (m *MsgVoidMatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoidMatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoidMatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoidMatchResponse proto.InternalMessageInfo
+
+// MsgUpsertTeam is the Msg/UpsertTeam request type.
+type MsgUpsertTeam struct {
+	// authority is the module authority or the data council.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	LongName  string `protobuf:"bytes,4,opt,name=long_name,json=longName,proto3" json:"long_name,omitempty"`
+	// reason is recorded in the emitted event for auditing.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgUpsertTeam) Reset()         { *m = MsgUpsertTeam{} }
+func (m *MsgUpsertTeam) String() string { return proto.CompactTextString(m) }
+func (*MsgUpsertTeam) ProtoMessage()    {}
+func (*MsgUpsertTeam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{16}
+}
+func (m *MsgUpsertTeam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpsertTeam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpsertTeam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpsertTeam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpsertTeam.Merge(m, src)
+}
+func (m *MsgUpsertTeam) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpsertTeam) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpsertTeam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpsertTeam proto.InternalMessageInfo
+
+func (m *MsgUpsertTeam) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpsertTeam) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgUpsertTeam) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgUpsertTeam) GetLongName() string {
+	if m != nil {
+		return m.LongName
+	}
+	return ""
+}
+
+func (m *MsgUpsertTeam) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgUpsertTeamResponse defines the response structure for executing a
+// MsgUpsertTeam message.
+type MsgUpsertTeamResponse struct {
+}
+
+func (m *MsgUpsertTeamResponse) Reset()         { *m = MsgUpsertTeamResponse{} }
+func (m *MsgUpsertTeamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpsertTeamResponse) ProtoMessage()    {}
+func (*MsgUpsertTeamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{17}
+}
+func (m *MsgUpsertTeamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpsertTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpsertTeamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpsertTeamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpsertTeamResponse.Merge(m, src)
+}
+func (m *MsgUpsertTeamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpsertTeamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpsertTeamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpsertTeamResponse proto.InternalMessageInfo
+
+// MsgUpsertLeague is the Msg/UpsertLeague request type.
+type MsgUpsertLeague struct {
+	// authority is the module authority or the data council.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	PrimaryId int64  `protobuf:"varint,3,opt,name=primary_id,json=primaryId,proto3" json:"primary_id,omitempty"`
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	GroupName string `protobuf:"bytes,5,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	IsGroup   bool   `protobuf:"varint,6,opt,name=is_group,json=isGroup,proto3" json:"is_group,omitempty"`
+	Ccode     string `protobuf:"bytes,7,opt,name=ccode,proto3" json:"ccode,omitempty"`
+	// reason is recorded in the emitted event for auditing.
+	Reason string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgUpsertLeague) Reset()         { *m = MsgUpsertLeague{} }
+func (m *MsgUpsertLeague) String() string { return proto.CompactTextString(m) }
+func (*MsgUpsertLeague) ProtoMessage()    {}
+func (*MsgUpsertLeague) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{18}
+}
+func (m *MsgUpsertLeague) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpsertLeague) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpsertLeague.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpsertLeague) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpsertLeague.Merge(m, src)
+}
+func (m *MsgUpsertLeague) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpsertLeague) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpsertLeague.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpsertLeague proto.InternalMessageInfo
+
+func (m *MsgUpsertLeague) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpsertLeague) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MsgUpsertLeague) GetPrimaryId() int64 {
+	if m != nil {
+		return m.PrimaryId
+	}
+	return 0
+}
+
+func (m *MsgUpsertLeague) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgUpsertLeague) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+func (m *MsgUpsertLeague) GetIsGroup() bool {
+	if m != nil {
+		return m.IsGroup
+	}
+	return false
+}
+
+func (m *MsgUpsertLeague) GetCcode() string {
+	if m != nil {
+		return m.Ccode
+	}
+	return ""
+}
+
+func (m *MsgUpsertLeague) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgUpsertLeagueResponse defines the response structure for executing a
+// MsgUpsertLeague message.
+type MsgUpsertLeagueResponse struct {
+}
+
+func (m *MsgUpsertLeagueResponse) Reset()         { *m = MsgUpsertLeagueResponse{} }
+func (m *MsgUpsertLeagueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpsertLeagueResponse) ProtoMessage()    {}
+func (*MsgUpsertLeagueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{19}
+}
+func (m *MsgUpsertLeagueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpsertLeagueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpsertLeagueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpsertLeagueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpsertLeagueResponse.Merge(m, src)
+}
+func (m *MsgUpsertLeagueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpsertLeagueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpsertLeagueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpsertLeagueResponse proto.InternalMessageInfo
+
+// MsgMergeEntities is the Msg/MergeEntities request type.
+type MsgMergeEntities struct {
+	// authority is the module authority.
+	Authority  string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	EntityType EntityType `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=futchain.futchain.v1.EntityType" json:"entity_type,omitempty"`
+	// duplicate_id is the canonical id of the duplicate entity, which resolves
+	// to canonical_id after the merge.
+	DuplicateId int64 `protobuf:"varint,3,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+	CanonicalId int64 `protobuf:"varint,4,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+	// reason is recorded in the emitted event for auditing.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgMergeEntities) Reset()         { *m = MsgMergeEntities{} }
+func (m *MsgMergeEntities) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeEntities) ProtoMessage()    {}
+func (*MsgMergeEntities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{20}
+}
+func (m *MsgMergeEntities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeEntities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeEntities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergeEntities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeEntities.Merge(m, src)
+}
+func (m *MsgMergeEntities) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeEntities) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeEntities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergeEntities proto.InternalMessageInfo
+
+func (m *MsgMergeEntities) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgMergeEntities) GetEntityType() EntityType {
+	if m != nil {
+		return m.EntityType
+	}
+	return ENTITY_TYPE_UNSPECIFIED
+}
+
+func (m *MsgMergeEntities) GetDuplicateId() int64 {
+	if m != nil {
+		return m.DuplicateId
+	}
+	return 0
+}
+
+func (m *MsgMergeEntities) GetCanonicalId() int64 {
+	if m != nil {
+		return m.CanonicalId
+	}
+	return 0
+}
+
+func (m *MsgMergeEntities) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgMergeEntitiesResponse defines the response structure for executing a
+// MsgMergeEntities message.
+type MsgMergeEntitiesResponse struct {
+}
+
+func (m *MsgMergeEntitiesResponse) Reset()         { *m = MsgMergeEntitiesResponse{} }
+func (m *MsgMergeEntitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMergeEntitiesResponse) ProtoMessage()    {}
+func (*MsgMergeEntitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{21}
+}
+func (m *MsgMergeEntitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMergeEntitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMergeEntitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMergeEntitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMergeEntitiesResponse.Merge(m, src)
+}
+func (m *MsgMergeEntitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMergeEntitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMergeEntitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMergeEntitiesResponse proto.InternalMessageInfo
+
+// MsgMapExternalID is the Msg/MapExternalID request type.
+type MsgMapExternalID struct {
+	// authority is the module authority.
+	Authority  string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	EntityType EntityType `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=futchain.futchain.v1.EntityType" json:"entity_type,omitempty"`
+	Provider   string     `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalId string     `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// canonical_id is the id of an existing entity. A mapped external id is
+	// remapped to it.
+	CanonicalId int64 `protobuf:"varint,5,opt,name=canonical_id,json=canonicalId,proto3" json:"canonical_id,omitempty"`
+	// reason is recorded in the emitted event for auditing.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgMapExternalID) Reset()         { *m = MsgMapExternalID{} }
+func (m *MsgMapExternalID) String() string { return proto.CompactTextString(m) }
+func (*MsgMapExternalID) ProtoMessage()    {}
+func (*MsgMapExternalID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{22}
+}
+func (m *MsgMapExternalID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMapExternalID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMapExternalID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMapExternalID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMapExternalID.Merge(m, src)
+}
+func (m *MsgMapExternalID) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMapExternalID) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMapExternalID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMapExternalID proto.InternalMessageInfo
+
+func (m *MsgMapExternalID) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgMapExternalID) GetEntityType() EntityType {
+	if m != nil {
+		return m.EntityType
+	}
+	return ENTITY_TYPE_UNSPECIFIED
+}
+
+func (m *MsgMapExternalID) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *MsgMapExternalID) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *MsgMapExternalID) GetCanonicalId() int64 {
+	if m != nil {
+		return m.CanonicalId
+	}
+	return 0
+}
+
+func (m *MsgMapExternalID) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgMapExternalIDResponse defines the response structure for executing a
+// MsgMapExternalID message.
+type MsgMapExternalIDResponse struct {
+}
+
+func (m *MsgMapExternalIDResponse) Reset()         { *m = MsgMapExternalIDResponse{} }
+func (m *MsgMapExternalIDResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMapExternalIDResponse) ProtoMessage()    {}
+func (*MsgMapExternalIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{23}
+}
+func (m *MsgMapExternalIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMapExternalIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMapExternalIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMapExternalIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMapExternalIDResponse.Merge(m, src)
+}
+func (m *MsgMapExternalIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMapExternalIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMapExternalIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMapExternalIDResponse proto.InternalMessageInfo
+
+// MsgProposeCorrection is the Msg/ProposeCorrection request type.
+type MsgProposeCorrection struct {
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// msg is a MsgOverrideMatch, MsgVoidMatch, MsgUpsertTeam or MsgUpsertLeague
+	// whose authority is the module authority.
+	Msg *any.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// reason is recorded for auditing.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgProposeCorrection) Reset()         { *m = MsgProposeCorrection{} }
+func (m *MsgProposeCorrection) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeCorrection) ProtoMessage()    {}
+func (*MsgProposeCorrection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{24}
+}
+func (m *MsgProposeCorrection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeCorrection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeCorrection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeCorrection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeCorrection.Merge(m, src)
+}
+func (m *MsgProposeCorrection) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeCorrection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeCorrection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeCorrection proto.InternalMessageInfo
+
+func (m *MsgProposeCorrection) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MsgProposeCorrection) GetMsg() *any.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *MsgProposeCorrection) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgProposeCorrectionResponse defines the response structure for executing a
+// MsgProposeCorrection message.
+type MsgProposeCorrectionResponse struct {
+	CorrectionId uint64 `protobuf:"varint,1,opt,name=correction_id,json=correctionId,proto3" json:"correction_id,omitempty"`
+}
+
+func (m *MsgProposeCorrectionResponse) Reset()         { *m = MsgProposeCorrectionResponse{} }
+func (m *MsgProposeCorrectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeCorrectionResponse) ProtoMessage()    {}
+func (*MsgProposeCorrectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{25}
+}
+func (m *MsgProposeCorrectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeCorrectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeCorrectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeCorrectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeCorrectionResponse.Merge(m, src)
+}
+func (m *MsgProposeCorrectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeCorrectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeCorrectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeCorrectionResponse proto.InternalMessageInfo
+
+func (m *MsgProposeCorrectionResponse) GetCorrectionId() uint64 {
+	if m != nil {
+		return m.CorrectionId
+	}
+	return 0
+}
+
+// MsgVoteCorrection is the Msg/VoteCorrection request type.
+type MsgVoteCorrection struct {
+	Voter        string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	CorrectionId uint64 `protobuf:"varint,2,opt,name=correction_id,json=correctionId,proto3" json:"correction_id,omitempty"`
+}
+
+func (m *MsgVoteCorrection) Reset()         { *m = MsgVoteCorrection{} }
+func (m *MsgVoteCorrection) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteCorrection) ProtoMessage()    {}
+func (*MsgVoteCorrection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{26}
+}
+func (m *MsgVoteCorrection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteCorrection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteCorrection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteCorrection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteCorrection.Merge(m, src)
+}
+func (m *MsgVoteCorrection) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteCorrection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteCorrection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteCorrection proto.InternalMessageInfo
+
+func (m *MsgVoteCorrection) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgVoteCorrection) GetCorrectionId() uint64 {
+	if m != nil {
+		return m.CorrectionId
+	}
+	return 0
+}
+
+// MsgVoteCorrectionResponse defines the response structure for executing a
+// MsgVoteCorrection message.
+type MsgVoteCorrectionResponse struct {
+	Status CorrectionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=futchain.futchain.v1.CorrectionStatus" json:"status,omitempty"`
+}
+
+func (m *MsgVoteCorrectionResponse) Reset()         { *m = MsgVoteCorrectionResponse{} }
+func (m *MsgVoteCorrectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteCorrectionResponse) ProtoMessage()    {}
+func (*MsgVoteCorrectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{27}
+}
+func (m *MsgVoteCorrectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteCorrectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteCorrectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteCorrectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteCorrectionResponse.Merge(m, src)
+}
+func (m *MsgVoteCorrectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteCorrectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteCorrectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteCorrectionResponse proto.InternalMessageInfo
+
+func (m *MsgVoteCorrectionResponse) GetStatus() CorrectionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CORRECTION_STATUS_UNSPECIFIED
+}
+
+// MsgSubmitOracleReport is the Msg/SubmitOracleReport request type.
+type MsgSubmitOracleReport struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	MatchId          int64  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	HomeScore        int64  `protobuf:"varint,3,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore        int64  `protobuf:"varint,4,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	Cancelled        bool   `protobuf:"varint,5,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (m *MsgSubmitOracleReport) Reset()         { *m = MsgSubmitOracleReport{} }
+func (m *MsgSubmitOracleReport) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitOracleReport) ProtoMessage()    {}
+func (*MsgSubmitOracleReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{28}
+}
+func (m *MsgSubmitOracleReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitOracleReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitOracleReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitOracleReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitOracleReport.Merge(m, src)
+}
+func (m *MsgSubmitOracleReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitOracleReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitOracleReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitOracleReport proto.InternalMessageInfo
+
+func (m *MsgSubmitOracleReport) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSubmitOracleReport) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *MsgSubmitOracleReport) GetHomeScore() int64 {
+	if m != nil {
+		return m.HomeScore
+	}
+	return 0
+}
+
+func (m *MsgSubmitOracleReport) GetAwayScore() int64 {
+	if m != nil {
+		return m.AwayScore
+	}
+	return 0
+}
+
+func (m *MsgSubmitOracleReport) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+// MsgSubmitOracleReportResponse defines the response structure for executing a
+// MsgSubmitOracleReport message.
+type MsgSubmitOracleReportResponse struct {
+}
+
+func (m *MsgSubmitOracleReportResponse) Reset()         { *m = MsgSubmitOracleReportResponse{} }
+func (m *MsgSubmitOracleReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitOracleReportResponse) ProtoMessage()    {}
+func (*MsgSubmitOracleReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{29}
+}
+func (m *MsgSubmitOracleReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitOracleReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitOracleReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitOracleReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitOracleReportResponse.Merge(m, src)
+}
+func (m *MsgSubmitOracleReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitOracleReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitOracleReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitOracleReportResponse proto.InternalMessageInfo
+
+// MsgClaimOracleRewards is the Msg/ClaimOracleRewards request type.
+type MsgClaimOracleRewards struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgClaimOracleRewards) Reset()         { *m = MsgClaimOracleRewards{} }
+func (m *MsgClaimOracleRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimOracleRewards) ProtoMessage()    {}
+func (*MsgClaimOracleRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{30}
+}
+func (m *MsgClaimOracleRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimOracleRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimOracleRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimOracleRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimOracleRewards.Merge(m, src)
+}
+func (m *MsgClaimOracleRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimOracleRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimOracleRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimOracleRewards proto.InternalMessageInfo
+
+func (m *MsgClaimOracleRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// MsgClaimOracleRewardsResponse defines the response structure for executing a
+// MsgClaimOracleRewards message.
+type MsgClaimOracleRewardsResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgClaimOracleRewardsResponse) Reset()         { *m = MsgClaimOracleRewardsResponse{} }
+func (m *MsgClaimOracleRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimOracleRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimOracleRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{31}
+}
+func (m *MsgClaimOracleRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimOracleRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimOracleRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimOracleRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimOracleRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimOracleRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimOracleRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimOracleRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimOracleRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimOracleRewardsResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgRegisterReporter is the Msg/RegisterReporter request type.
+type MsgRegisterReporter struct {
+	Reporter string     `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Moniker  string     `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Bond     types.Coin `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond"`
+}
+
+func (m *MsgRegisterReporter) Reset()         { *m = MsgRegisterReporter{} }
+func (m *MsgRegisterReporter) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterReporter) ProtoMessage()    {}
+func (*MsgRegisterReporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{32}
+}
+func (m *MsgRegisterReporter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterReporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterReporter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterReporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterReporter.Merge(m, src)
+}
+func (m *MsgRegisterReporter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterReporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterReporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterReporter proto.InternalMessageInfo
+
+func (m *MsgRegisterReporter) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *MsgRegisterReporter) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *MsgRegisterReporter) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+// MsgRegisterReporterResponse defines the response structure for executing a
+// MsgRegisterReporter message.
+type MsgRegisterReporterResponse struct {
+}
+
+func (m *MsgRegisterReporterResponse) Reset()         { *m = MsgRegisterReporterResponse{} }
+func (m *MsgRegisterReporterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterReporterResponse) ProtoMessage()    {}
+func (*MsgRegisterReporterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{33}
+}
+func (m *MsgRegisterReporterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterReporterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterReporterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterReporterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterReporterResponse.Merge(m, src)
+}
+func (m *MsgRegisterReporterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterReporterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterReporterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterReporterResponse proto.InternalMessageInfo
+
+// MsgUnbondReporter is the Msg/UnbondReporter request type.
+type MsgUnbondReporter struct {
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *MsgUnbondReporter) Reset()         { *m = MsgUnbondReporter{} }
+func (m *MsgUnbondReporter) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondReporter) ProtoMessage()    {}
+func (*MsgUnbondReporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{34}
+}
+func (m *MsgUnbondReporter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondReporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondReporter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondReporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondReporter.Merge(m, src)
+}
+func (m *MsgUnbondReporter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondReporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondReporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondReporter proto.InternalMessageInfo
+
+func (m *MsgUnbondReporter) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+// MsgUnbondReporterResponse defines the response structure for executing a
+// MsgUnbondReporter message.
+type MsgUnbondReporterResponse struct {
+	UnbondingHeight int64 `protobuf:"varint,1,opt,name=unbonding_height,json=unbondingHeight,proto3" json:"unbonding_height,omitempty"`
+}
+
+func (m *MsgUnbondReporterResponse) Reset()         { *m = MsgUnbondReporterResponse{} }
+func (m *MsgUnbondReporterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondReporterResponse) ProtoMessage()    {}
+func (*MsgUnbondReporterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{35}
+}
+func (m *MsgUnbondReporterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondReporterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondReporterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondReporterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondReporterResponse.Merge(m, src)
+}
+func (m *MsgUnbondReporterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondReporterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondReporterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondReporterResponse proto.InternalMessageInfo
+
+func (m *MsgUnbondReporterResponse) GetUnbondingHeight() int64 {
+	if m != nil {
+		return m.UnbondingHeight
+	}
+	return 0
+}
+
+// MsgSubmitMatchReport is the Msg/SubmitMatchReport request type.
+type MsgSubmitMatchReport struct {
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// report is the state of the match. Its reporter and height are set by the
+	// module.
+	Report MatchReport `protobuf:"bytes,2,opt,name=report,proto3" json:"report"`
+}
+
+func (m *MsgSubmitMatchReport) Reset()         { *m = MsgSubmitMatchReport{} }
+func (m *MsgSubmitMatchReport) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitMatchReport) ProtoMessage()    {}
+func (*MsgSubmitMatchReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{36}
+}
+func (m *MsgSubmitMatchReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitMatchReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitMatchReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitMatchReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitMatchReport.Merge(m, src)
+}
+func (m *MsgSubmitMatchReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitMatchReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitMatchReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitMatchReport proto.InternalMessageInfo
+
+func (m *MsgSubmitMatchReport) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *MsgSubmitMatchReport) GetReport() MatchReport {
+	if m != nil {
+		return m.Report
+	}
+	return MatchReport{}
+}
+
+// MsgSubmitMatchReportResponse defines the response structure for executing a
+// MsgSubmitMatchReport message.
+type MsgSubmitMatchReportResponse struct {
+}
+
+func (m *MsgSubmitMatchReportResponse) Reset()         { *m = MsgSubmitMatchReportResponse{} }
+func (m *MsgSubmitMatchReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitMatchReportResponse) ProtoMessage()    {}
+func (*MsgSubmitMatchReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{37}
+}
+func (m *MsgSubmitMatchReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitMatchReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitMatchReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitMatchReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitMatchReportResponse.Merge(m, src)
+}
+func (m *MsgSubmitMatchReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitMatchReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitMatchReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitMatchReportResponse proto.InternalMessageInfo
+
+// MsgCommitReport is the Msg/CommitReport request type.
+type MsgCommitReport struct {
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	MatchId  int64  `protobuf:"varint,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// hash is the sha256 hash of the salt followed by the encoded report.
+	Hash []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *MsgCommitReport) Reset()         { *m = MsgCommitReport{} }
+func (m *MsgCommitReport) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitReport) ProtoMessage()    {}
+func (*MsgCommitReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{38}
+}
+func (m *MsgCommitReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitReport.Merge(m, src)
+}
+func (m *MsgCommitReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitReport proto.InternalMessageInfo
+
+func (m *MsgCommitReport) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *MsgCommitReport) GetMatchId() int64 {
+	if m != nil {
+		return m.MatchId
+	}
+	return 0
+}
+
+func (m *MsgCommitReport) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// MsgCommitReportResponse defines the response structure for executing a
+// MsgCommitReport message.
+type MsgCommitReportResponse struct {
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (m *MsgCommitReportResponse) Reset()         { *m = MsgCommitReportResponse{} }
+func (m *MsgCommitReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitReportResponse) ProtoMessage()    {}
+func (*MsgCommitReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{39}
+}
+func (m *MsgCommitReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitReportResponse.Merge(m, src)
+}
+func (m *MsgCommitReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitReportResponse proto.InternalMessageInfo
+
+func (m *MsgCommitReportResponse) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+// MsgRevealReport is the Msg/RevealReport request type.
+type MsgRevealReport struct {
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// report is the committed report. Its reporter is set by the module and its
+	// height is not part of the hash.
+	Report MatchReport `protobuf:"bytes,2,opt,name=report,proto3" json:"report"`
+	Salt   []byte      `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *MsgRevealReport) Reset()         { *m = MsgRevealReport{} }
+func (m *MsgRevealReport) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealReport) ProtoMessage()    {}
+func (*MsgRevealReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{40}
+}
+func (m *MsgRevealReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealReport.Merge(m, src)
+}
+func (m *MsgRevealReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealReport proto.InternalMessageInfo
+
+func (m *MsgRevealReport) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *MsgRevealReport) GetReport() MatchReport {
+	if m != nil {
+		return m.Report
+	}
+	return MatchReport{}
+}
+
+func (m *MsgRevealReport) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+// MsgRevealReportResponse defines the response structure for executing a
+// MsgRevealReport message.
+type MsgRevealReportResponse struct {
+}
+
+func (m *MsgRevealReportResponse) Reset()         { *m = MsgRevealReportResponse{} }
+func (m *MsgRevealReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealReportResponse) ProtoMessage()    {}
+func (*MsgRevealReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{41}
+}
+func (m *MsgRevealReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealReportResponse.Merge(m, src)
+}
+func (m *MsgRevealReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealReportResponse proto.InternalMessageInfo
+
+// MsgFundReporterRewards is the Msg/FundReporterRewards request type.
+type MsgFundReporterRewards struct {
+	Depositor string     `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgFundReporterRewards) Reset()         { *m = MsgFundReporterRewards{} }
+func (m *MsgFundReporterRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgFundReporterRewards) ProtoMessage()    {}
+func (*MsgFundReporterRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{42}
+}
+func (m *MsgFundReporterRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundReporterRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundReporterRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundReporterRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundReporterRewards.Merge(m, src)
+}
+func (m *MsgFundReporterRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundReporterRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundReporterRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundReporterRewards proto.InternalMessageInfo
+
+func (m *MsgFundReporterRewards) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *MsgFundReporterRewards) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgFundReporterRewardsResponse defines the response structure for executing a
+// MsgFundReporterRewards message.
+type MsgFundReporterRewardsResponse struct {
+}
+
+func (m *MsgFundReporterRewardsResponse) Reset()         { *m = MsgFundReporterRewardsResponse{} }
+func (m *MsgFundReporterRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundReporterRewardsResponse) ProtoMessage()    {}
+func (*MsgFundReporterRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3640b1e2d8344897, []int{43}
+}
+func (m *MsgFundReporterRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundReporterRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundReporterRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundReporterRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundReporterRewardsResponse.Merge(m, src)
+}
+func (m *MsgFundReporterRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundReporterRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundReporterRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundReporterRewardsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "futchain.futchain.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "futchain.futchain.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateMarket)(nil), "futchain.futchain.v1.MsgCreateMarket")
+	proto.RegisterType((*MsgCreateMarketResponse)(nil), "futchain.futchain.v1.MsgCreateMarketResponse")
+	proto.RegisterType((*MsgPlaceStake)(nil), "futchain.futchain.v1.MsgPlaceStake")
+	proto.RegisterType((*MsgPlaceStakeResponse)(nil), "futchain.futchain.v1.MsgPlaceStakeResponse")
+	proto.RegisterType((*MsgMintOutcomeShares)(nil), "futchain.futchain.v1.MsgMintOutcomeShares")
+	proto.RegisterType((*MsgMintOutcomeSharesResponse)(nil), "futchain.futchain.v1.MsgMintOutcomeSharesResponse")
+	proto.RegisterType((*MsgBurnOutcomeShares)(nil), "futchain.futchain.v1.MsgBurnOutcomeShares")
+	proto.RegisterType((*MsgBurnOutcomeSharesResponse)(nil), "futchain.futchain.v1.MsgBurnOutcomeSharesResponse")
+	proto.RegisterType((*MsgRedeemOutcomeShares)(nil), "futchain.futchain.v1.MsgRedeemOutcomeShares")
+	proto.RegisterType((*MsgRedeemOutcomeSharesResponse)(nil), "futchain.futchain.v1.MsgRedeemOutcomeSharesResponse")
+	proto.RegisterType((*MsgOverrideMatch)(nil), "futchain.futchain.v1.MsgOverrideMatch")
+	proto.RegisterType((*MsgOverrideMatchResponse)(nil), "futchain.futchain.v1.MsgOverrideMatchResponse")
+	proto.RegisterType((*MsgVoidMatch)(nil), "futchain.futchain.v1.MsgVoidMatch")
+	proto.RegisterType((*MsgVoidMatchResponse)(nil), "futchain.futchain.v1.MsgVoidMatchResponse")
+	proto.RegisterType((*MsgUpsertTeam)(nil), "futchain.futchain.v1.MsgUpsertTeam")
+	proto.RegisterType((*MsgUpsertTeamResponse)(nil), "futchain.futchain.v1.MsgUpsertTeamResponse")
+	proto.RegisterType((*MsgUpsertLeague)(nil), "futchain.futchain.v1.MsgUpsertLeague")
+	proto.RegisterType((*MsgUpsertLeagueResponse)(nil), "futchain.futchain.v1.MsgUpsertLeagueResponse")
+	proto.RegisterType((*MsgMergeEntities)(nil), "futchain.futchain.v1.MsgMergeEntities")
+	proto.RegisterType((*MsgMergeEntitiesResponse)(nil), "futchain.futchain.v1.MsgMergeEntitiesResponse")
+	proto.RegisterType((*MsgMapExternalID)(nil), "futchain.futchain.v1.MsgMapExternalID")
+	proto.RegisterType((*MsgMapExternalIDResponse)(nil), "futchain.futchain.v1.MsgMapExternalIDResponse")
+	proto.RegisterType((*MsgProposeCorrection)(nil), "futchain.futchain.v1.MsgProposeCorrection")
+	proto.RegisterType((*MsgProposeCorrectionResponse)(nil), "futchain.futchain.v1.MsgProposeCorrectionResponse")
+	proto.RegisterType((*MsgVoteCorrection)(nil), "futchain.futchain.v1.MsgVoteCorrection")
+	proto.RegisterType((*MsgVoteCorrectionResponse)(nil), "futchain.futchain.v1.MsgVoteCorrectionResponse")
+	proto.RegisterType((*MsgSubmitOracleReport)(nil), "futchain.futchain.v1.MsgSubmitOracleReport")
+	proto.RegisterType((*MsgSubmitOracleReportResponse)(nil), "futchain.futchain.v1.MsgSubmitOracleReportResponse")
+	proto.RegisterType((*MsgClaimOracleRewards)(nil), "futchain.futchain.v1.MsgClaimOracleRewards")
+	proto.RegisterType((*MsgClaimOracleRewardsResponse)(nil), "futchain.futchain.v1.MsgClaimOracleRewardsResponse")
+	proto.RegisterType((*MsgRegisterReporter)(nil), "futchain.futchain.v1.MsgRegisterReporter")
+	proto.RegisterType((*MsgRegisterReporterResponse)(nil), "futchain.futchain.v1.MsgRegisterReporterResponse")
+	proto.RegisterType((*MsgUnbondReporter)(nil), "futchain.futchain.v1.MsgUnbondReporter")
+	proto.RegisterType((*MsgUnbondReporterResponse)(nil), "futchain.futchain.v1.MsgUnbondReporterResponse")
+	proto.RegisterType((*MsgSubmitMatchReport)(nil), "futchain.futchain.v1.MsgSubmitMatchReport")
+	proto.RegisterType((*MsgSubmitMatchReportResponse)(nil), "futchain.futchain.v1.MsgSubmitMatchReportResponse")
+	proto.RegisterType((*MsgCommitReport)(nil), "futchain.futchain.v1.MsgCommitReport")
+	proto.RegisterType((*MsgCommitReportResponse)(nil), "futchain.futchain.v1.MsgCommitReportResponse")
+	proto.RegisterType((*MsgRevealReport)(nil), "futchain.futchain.v1.MsgRevealReport")
+	proto.RegisterType((*MsgRevealReportResponse)(nil), "futchain.futchain.v1.MsgRevealReportResponse")
+	proto.RegisterType((*MsgFundReporterRewards)(nil), "futchain.futchain.v1.MsgFundReporterRewards")
+	proto.RegisterType((*MsgFundReporterRewardsResponse)(nil), "futchain.futchain.v1.MsgFundReporterRewardsResponse")
+}
+
+func init() { proto.RegisterFile("futchain/futchain/v1/tx.proto", fileDescriptor_3640b1e2d8344897) }
+
+var fileDescriptor_3640b1e2d8344897 = []byte{
+	// 2176 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xf9, 0xf6, 0x52, 0xd4, 0x07, 0x47, 0xb2, 0x63, 0x6d, 0x14, 0x9b, 0xa2, 0x2d, 0x59, 0x5a, 0xff,
+	0x94, 0xc8, 0xca, 0x4f, 0x64, 0x24, 0xbb, 0x6e, 0x4a, 0xa4, 0x2d, 0x2c, 0xd9, 0x69, 0x04, 0x94,
+	0xb1, 0xb1, 0x8a, 0x73, 0x68, 0xd0, 0x08, 0xa3, 0xdd, 0xf1, 0x72, 0x6a, 0xee, 0x0e, 0xb1, 0x33,
+	0x64, 0xcc, 0x5b, 0x91, 0x63, 0x4f, 0xfd, 0x0b, 0x0a, 0x14, 0xe8, 0xa1, 0x87, 0xb6, 0xf0, 0xc1,
+	0xe7, 0x1e, 0x7a, 0x32, 0x0a, 0xb4, 0x08, 0x7c, 0x28, 0x82, 0x1c, 0x82, 0xc2, 0x46, 0x6b, 0xf4,
+	0xd0, 0xff, 0xa1, 0x98, 0x8f, 0x9d, 0xdd, 0xd5, 0x7e, 0x88, 0x52, 0x62, 0xe4, 0x42, 0xec, 0xbc,
+	0xf3, 0x0c, 0xdf, 0x79, 0x9e, 0xf9, 0x7a, 0xdf, 0x17, 0x2c, 0x3d, 0x18, 0x30, 0xa7, 0x0b, 0x71,
+	0xd0, 0xd2, 0x1f, 0xc3, 0xad, 0x16, 0x7b, 0xd4, 0xec, 0x87, 0x84, 0x11, 0x73, 0x21, 0xb2, 0x36,
+	0xf5, 0xc7, 0x70, 0xab, 0x31, 0x0f, 0x7d, 0x1c, 0x90, 0x96, 0xf8, 0x95, 0xc0, 0xc6, 0xb2, 0x43,
+	0xa8, 0x4f, 0x68, 0xeb, 0x10, 0x52, 0xd4, 0x1a, 0x6e, 0x1d, 0x22, 0x06, 0xb7, 0x5a, 0x0e, 0xc1,
+	0x81, 0xea, 0xbf, 0xa8, 0xfa, 0x7d, 0xea, 0x71, 0x07, 0x3e, 0xf5, 0x54, 0xc7, 0xa2, 0xec, 0x38,
+	0x10, 0xad, 0x96, 0x6c, 0xa8, 0xae, 0xb5, 0xdc, 0xb9, 0x39, 0x24, 0x0c, 0x91, 0xc3, 0x30, 0x89,
+	0xfe, 0x7a, 0x35, 0x17, 0x86, 0x02, 0x86, 0xd9, 0xa8, 0x14, 0xe2, 0xc3, 0xf0, 0x21, 0x62, 0xa5,
+	0x90, 0x3e, 0x0c, 0xa1, 0x1f, 0xcd, 0xe7, 0x6a, 0x2e, 0x24, 0x44, 0x7d, 0x12, 0x32, 0x14, 0x2a,
+	0xd0, 0x82, 0x47, 0x3c, 0x22, 0xc9, 0xf0, 0xaf, 0x88, 0xa5, 0x47, 0x88, 0xd7, 0x43, 0x2d, 0xd1,
+	0x3a, 0x1c, 0x3c, 0x68, 0xc1, 0x40, 0xcd, 0xcd, 0xfa, 0x8b, 0x01, 0x5e, 0xeb, 0x50, 0xef, 0x7e,
+	0xdf, 0x85, 0x0c, 0xdd, 0x13, 0xfe, 0xcc, 0x9b, 0xa0, 0x06, 0x07, 0xac, 0x4b, 0x42, 0xcc, 0x46,
+	0x75, 0x63, 0xc5, 0x58, 0xaf, 0xed, 0xd4, 0x9f, 0x3d, 0xd9, 0x5c, 0x50, 0xf2, 0xdc, 0x72, 0xdd,
+	0x10, 0x51, 0xba, 0xcf, 0x42, 0x1c, 0x78, 0x76, 0x0c, 0x35, 0x7f, 0x0c, 0xa6, 0xe4, 0x8c, 0xeb,
+	0x95, 0x15, 0x63, 0x7d, 0x76, 0xfb, 0x72, 0x33, 0x6f, 0xfd, 0x9a, 0xd2, 0xcb, 0x4e, 0xed, 0xe9,
+	0xd7, 0x57, 0xce, 0xfc, 0xfe, 0xe5, 0xe3, 0x0d, 0xc3, 0x56, 0xc3, 0xda, 0x37, 0x3f, 0x7f, 0xf9,
+	0x78, 0x23, 0xfe, 0xc3, 0x5f, 0xbd, 0x7c, 0xbc, 0x11, 0xb3, 0x7e, 0x14, 0xf3, 0x3e, 0x32, 0x61,
+	0x6b, 0x11, 0x5c, 0x3c, 0x62, 0xb2, 0x11, 0xed, 0x93, 0x80, 0x22, 0xeb, 0xbf, 0x92, 0xdf, 0x6e,
+	0x88, 0x20, 0x43, 0x1d, 0x21, 0xb9, 0xb9, 0x0d, 0xa6, 0x1d, 0xde, 0x26, 0xe1, 0xb1, 0xec, 0x22,
+	0xa0, 0xb9, 0x08, 0x66, 0x7c, 0xc8, 0x9c, 0xee, 0x01, 0x76, 0x05, 0xbb, 0x09, 0x7b, 0x5a, 0xb4,
+	0xf7, 0x5c, 0xf3, 0x16, 0x98, 0x95, 0x6b, 0x79, 0xc0, 0x46, 0x7d, 0x54, 0x9f, 0x58, 0x31, 0xd6,
+	0xcf, 0x6d, 0xaf, 0xe4, 0x73, 0x97, 0x33, 0xf8, 0x68, 0xd4, 0x47, 0x36, 0xf0, 0xf5, 0xb7, 0x69,
+	0x82, 0x6a, 0x0f, 0x07, 0xa8, 0x5e, 0x5d, 0x31, 0xd6, 0xab, 0xb6, 0xf8, 0x6e, 0xdf, 0xe0, 0x62,
+	0x44, 0xfe, 0xcb, 0xa4, 0x48, 0x72, 0xb3, 0x6e, 0x0a, 0x29, 0x92, 0xa6, 0x48, 0x0a, 0xf3, 0x12,
+	0xa8, 0xa9, 0x79, 0x62, 0x57, 0x10, 0xaf, 0xda, 0x33, 0xd2, 0xb0, 0xe7, 0x5a, 0xff, 0x31, 0xc0,
+	0xd9, 0x0e, 0xf5, 0xee, 0xf5, 0xa0, 0x83, 0xf6, 0x19, 0x7c, 0x88, 0xcc, 0x77, 0xc0, 0x14, 0xe5,
+	0x1f, 0xc7, 0x8b, 0xa4, 0x70, 0x69, 0x07, 0x95, 0xb4, 0x03, 0xb3, 0x0e, 0xa6, 0xc9, 0x80, 0x39,
+	0xc4, 0x97, 0x0a, 0x55, 0xed, 0xa8, 0x69, 0xbe, 0x07, 0xa6, 0xa0, 0x4f, 0x06, 0x01, 0x13, 0xf4,
+	0x67, 0xb7, 0x17, 0x9b, 0xca, 0x0b, 0x3f, 0xcd, 0x4d, 0x75, 0x9a, 0x9b, 0xbb, 0x04, 0x07, 0xa9,
+	0x3d, 0x23, 0xc7, 0xb4, 0xb7, 0xb8, 0x4c, 0x6a, 0x06, 0x5c, 0xa5, 0xd5, 0x02, 0x95, 0x62, 0x66,
+	0xd6, 0x45, 0xf0, 0x46, 0xca, 0xa0, 0x37, 0xcb, 0x0b, 0x03, 0x2c, 0x74, 0xa8, 0xd7, 0xc1, 0x01,
+	0xbb, 0x2b, 0x27, 0xb7, 0xdf, 0x85, 0x21, 0xa2, 0x42, 0x0b, 0x14, 0xb8, 0x63, 0x69, 0x21, 0x70,
+	0x65, 0xfb, 0xe5, 0x36, 0x00, 0x0e, 0xe9, 0xf5, 0x20, 0x43, 0x21, 0xec, 0x09, 0x31, 0xc6, 0xe5,
+	0x9c, 0x18, 0xd7, 0x7e, 0x57, 0xf2, 0x16, 0xde, 0x38, 0xef, 0xf5, 0x02, 0xde, 0x19, 0x32, 0xd6,
+	0x32, 0xb8, 0x9c, 0x67, 0xd7, 0x2a, 0xbc, 0x94, 0x2a, 0xec, 0x0c, 0xc2, 0xe0, 0x15, 0xaa, 0xf0,
+	0x81, 0x5e, 0xf5, 0x09, 0xf1, 0x67, 0xef, 0x70, 0x9a, 0x5f, 0x7d, 0x7d, 0xe5, 0x0d, 0xf9, 0x87,
+	0xd4, 0x7d, 0xd8, 0xc4, 0xa4, 0xe5, 0x43, 0xd6, 0x6d, 0xee, 0x05, 0xec, 0xd9, 0x93, 0x4d, 0xa0,
+	0x3c, 0xed, 0x05, 0x2c, 0xbd, 0x03, 0xc6, 0x55, 0x22, 0x43, 0x48, 0x29, 0x91, 0xb1, 0x6b, 0x25,
+	0x7e, 0x6b, 0x80, 0x0b, 0x1d, 0xea, 0xd9, 0xc8, 0x45, 0xc8, 0x7f, 0x75, 0x5a, 0xb4, 0xdb, 0x47,
+	0x18, 0x6c, 0x14, 0x30, 0xc8, 0x99, 0x88, 0xf5, 0x29, 0x58, 0xce, 0xef, 0xd1, 0xe7, 0xfe, 0x3d,
+	0x7e, 0x2d, 0x8f, 0xc8, 0x80, 0x89, 0xa9, 0x8e, 0x7d, 0xbe, 0xe4, 0x18, 0xeb, 0x69, 0x05, 0x9c,
+	0xef, 0x50, 0xef, 0xee, 0x10, 0x85, 0x21, 0x76, 0x51, 0x87, 0x4f, 0xf9, 0xd4, 0x2f, 0x44, 0xc9,
+	0x7e, 0x58, 0x02, 0xa0, 0x4b, 0x7c, 0x74, 0x40, 0x1d, 0x12, 0xca, 0x2b, 0x62, 0xc2, 0xae, 0x71,
+	0xcb, 0x3e, 0x37, 0xf0, 0x6e, 0xf8, 0x19, 0x1c, 0xa9, 0xee, 0xaa, 0xec, 0xe6, 0x16, 0xd9, 0x5d,
+	0x07, 0xd3, 0x94, 0xc1, 0x90, 0x21, 0xb7, 0x3e, 0xb9, 0x62, 0xac, 0xcf, 0xd8, 0x51, 0xd3, 0x6c,
+	0x80, 0x99, 0x07, 0x38, 0xc0, 0xb4, 0x8b, 0xdc, 0xfa, 0x94, 0xe8, 0xd2, 0x6d, 0xf3, 0x32, 0xa8,
+	0x39, 0x30, 0x70, 0x50, 0xaf, 0x87, 0xdc, 0xfa, 0xb4, 0xe8, 0x8c, 0x0d, 0xe6, 0x05, 0x30, 0x15,
+	0x22, 0x48, 0x49, 0x50, 0x9f, 0xe1, 0x0c, 0x6d, 0xd5, 0x6a, 0x7f, 0x3f, 0xfb, 0x4a, 0xfd, 0x5f,
+	0xc1, 0x82, 0xa5, 0x54, 0xb3, 0x1a, 0xa0, 0x7e, 0xd4, 0xa6, 0xb7, 0xda, 0x9f, 0x0c, 0x30, 0xd7,
+	0xa1, 0xde, 0xc7, 0x04, 0xbb, 0xaf, 0x4c, 0xe2, 0x98, 0xd0, 0x44, 0x8a, 0xd0, 0xf5, 0x2c, 0xa1,
+	0x95, 0x02, 0x42, 0x7a, 0x7e, 0xd6, 0x05, 0x71, 0x49, 0xe8, 0xb6, 0x26, 0xf2, 0x0f, 0xf9, 0x90,
+	0xdc, 0xef, 0x53, 0x14, 0xb2, 0x8f, 0x10, 0xf4, 0x4f, 0xcd, 0xe4, 0x1c, 0xa8, 0x68, 0x0e, 0x15,
+	0xec, 0xf2, 0x47, 0x32, 0x80, 0xea, 0xf9, 0xa8, 0xd9, 0xe2, 0x9b, 0x3f, 0x39, 0x3d, 0x12, 0x78,
+	0x07, 0xa2, 0xa3, 0x2a, 0x3a, 0x66, 0xb8, 0xe1, 0x43, 0xde, 0x19, 0xf3, 0x9d, 0x4c, 0xf1, 0xbd,
+	0x91, 0xe5, 0xbb, 0x5a, 0x18, 0x66, 0x44, 0x34, 0xd4, 0xab, 0x11, 0x1b, 0x34, 0xe3, 0x3f, 0x54,
+	0x54, 0x08, 0xc5, 0x7b, 0x7e, 0x8a, 0xa0, 0x37, 0x40, 0xdf, 0x1a, 0xe7, 0x25, 0x00, 0xfa, 0x21,
+	0xf6, 0x61, 0x38, 0xe2, 0xeb, 0xa9, 0x4e, 0x85, 0xb2, 0xec, 0xc5, 0x92, 0x54, 0x13, 0x92, 0x2c,
+	0x01, 0xe0, 0x85, 0x64, 0xd0, 0x97, 0x9a, 0x48, 0xe6, 0x35, 0x61, 0x11, 0xa2, 0x2c, 0x82, 0x19,
+	0x4c, 0x0f, 0x44, 0x5b, 0x9d, 0x87, 0x69, 0x4c, 0x7f, 0xc2, 0x9b, 0xe6, 0x02, 0x98, 0x74, 0x1c,
+	0xe2, 0x22, 0x71, 0x14, 0x6a, 0xb6, 0x6c, 0x14, 0x1e, 0x83, 0x13, 0x05, 0x6b, 0xb1, 0x34, 0x3a,
+	0x58, 0x8b, 0x4d, 0x5a, 0xc9, 0xdf, 0xc9, 0xbb, 0xa6, 0x83, 0x42, 0x0f, 0xdd, 0xe1, 0x11, 0x34,
+	0x46, 0xa7, 0x8f, 0x46, 0x6f, 0x81, 0x59, 0x19, 0x85, 0xcb, 0xb0, 0xac, 0x52, 0x16, 0x96, 0x09,
+	0x67, 0x23, 0x19, 0x96, 0x21, 0xfd, 0x6d, 0xae, 0x82, 0x39, 0x77, 0xd0, 0xef, 0x61, 0x07, 0x32,
+	0x14, 0xeb, 0x3f, 0xab, 0x6d, 0x7b, 0x2e, 0x87, 0x38, 0x30, 0x20, 0x01, 0x76, 0x60, 0x8f, 0x43,
+	0xe4, 0xcd, 0x34, 0xab, 0x6d, 0xa9, 0x63, 0x37, 0x79, 0xda, 0x7b, 0x24, 0xa5, 0x88, 0xba, 0x47,
+	0x52, 0x36, 0x2d, 0xe1, 0x9f, 0x95, 0x84, 0xb0, 0x7f, 0xe7, 0x11, 0x43, 0x61, 0x00, 0x7b, 0x7b,
+	0xb7, 0xbf, 0x4b, 0x09, 0x1b, 0x60, 0xa6, 0x1f, 0x92, 0x21, 0xe6, 0x2f, 0xa5, 0x3c, 0xb8, 0xba,
+	0x6d, 0x5e, 0x01, 0xb3, 0x48, 0x4d, 0x32, 0x92, 0xae, 0x66, 0x83, 0xc8, 0x94, 0x23, 0xee, 0x64,
+	0x99, 0xb8, 0x53, 0xa7, 0x16, 0x37, 0xa9, 0x55, 0x24, 0x6e, 0xd2, 0xa6, 0xc5, 0xfd, 0x97, 0x8c,
+	0x8c, 0xee, 0x85, 0xa4, 0x4f, 0x28, 0xda, 0xd5, 0xa9, 0xa0, 0x79, 0x43, 0xb0, 0xe4, 0xc6, 0xe3,
+	0xe3, 0x01, 0x8d, 0x34, 0x77, 0xc1, 0x84, 0x4f, 0x3d, 0x95, 0x2c, 0x2d, 0x34, 0x65, 0x92, 0xd6,
+	0x8c, 0x92, 0xb4, 0xe6, 0xad, 0x60, 0xb4, 0x73, 0xe9, 0xaf, 0x4f, 0x36, 0x2f, 0xe6, 0x3d, 0xd7,
+	0xfc, 0xcd, 0xe7, 0xa3, 0x0b, 0x2f, 0x75, 0x11, 0x53, 0x68, 0x5f, 0x65, 0x71, 0x51, 0x86, 0x8e,
+	0xb5, 0x2b, 0xe2, 0xa2, 0x8c, 0x5d, 0x47, 0x14, 0x57, 0xc1, 0xd9, 0x38, 0x0f, 0x8e, 0xb3, 0x89,
+	0xb9, 0xd8, 0xb8, 0xe7, 0x5a, 0xbf, 0x31, 0xc0, 0xbc, 0x78, 0x21, 0x58, 0x52, 0xa9, 0x26, 0x98,
+	0x1c, 0x12, 0x36, 0x86, 0x4c, 0x12, 0x96, 0x75, 0x55, 0xc9, 0xba, 0x92, 0x17, 0xba, 0x1c, 0xc0,
+	0x89, 0xae, 0x15, 0x3e, 0x5e, 0xc9, 0xa9, 0x58, 0x9f, 0x80, 0xc5, 0x8c, 0x51, 0x53, 0xfc, 0x91,
+	0xc8, 0x7e, 0xd8, 0x80, 0x8a, 0x89, 0x9e, 0xdb, 0x7e, 0x33, 0x7f, 0xd7, 0xc7, 0x23, 0xf7, 0x05,
+	0xda, 0x56, 0xa3, 0xf8, 0x55, 0xc6, 0x9f, 0x8b, 0xfd, 0xc1, 0xa1, 0x8f, 0xd9, 0xdd, 0x10, 0x3a,
+	0x3d, 0x64, 0x8b, 0x54, 0xdd, 0xfc, 0x10, 0xcc, 0x0f, 0x61, 0x0f, 0xbb, 0x3c, 0xad, 0x3b, 0x80,
+	0x92, 0xb3, 0x52, 0x63, 0xf5, 0xd9, 0x93, 0xcd, 0x25, 0xa5, 0xc6, 0xc7, 0x11, 0x26, 0x2d, 0xcb,
+	0xf9, 0xe1, 0x11, 0xfb, 0xab, 0x8b, 0xa9, 0x52, 0xd1, 0xd1, 0xe4, 0x91, 0xe8, 0xa8, 0x7d, 0x9b,
+	0x6b, 0x9e, 0x65, 0xc2, 0xf5, 0xbf, 0x56, 0xa0, 0x7f, 0x56, 0x0c, 0xeb, 0x0a, 0x58, 0xca, 0xed,
+	0xd0, 0x47, 0xee, 0x8f, 0x86, 0xd0, 0x71, 0xb7, 0x07, 0xb1, 0x1f, 0x01, 0x3e, 0x83, 0xa1, 0x4b,
+	0xbf, 0x6d, 0x1d, 0x4f, 0x43, 0x28, 0x3b, 0x2b, 0xeb, 0xe7, 0x82, 0x50, 0xb6, 0x23, 0x19, 0x8d,
+	0xab, 0xbc, 0xc7, 0x38, 0x79, 0xb6, 0x6b, 0x7d, 0x65, 0x80, 0xd7, 0x45, 0xb8, 0xef, 0x61, 0xca,
+	0x50, 0x68, 0xab, 0xea, 0x0f, 0xbf, 0x80, 0xa2, 0x4a, 0xd0, 0xf1, 0x17, 0x50, 0x84, 0xe4, 0x51,
+	0xb3, 0x4f, 0x02, 0xcc, 0x73, 0xfc, 0x8a, 0xb8, 0x3c, 0xa2, 0xa6, 0xf9, 0x2e, 0xa8, 0x1e, 0x92,
+	0xc0, 0x3d, 0x51, 0x76, 0x2a, 0x46, 0xb4, 0x7f, 0x20, 0xee, 0x9d, 0xc8, 0x05, 0x57, 0xef, 0xad,
+	0xc2, 0x6c, 0x26, 0x4d, 0xc2, 0x5a, 0x02, 0x97, 0x72, 0xcc, 0x7a, 0x2b, 0x7c, 0x2e, 0x2f, 0x94,
+	0xfb, 0x01, 0xf7, 0xf3, 0xcd, 0x98, 0xcb, 0xe7, 0x21, 0x35, 0xcb, 0xa2, 0x4b, 0x23, 0xed, 0xce,
+	0x7a, 0x5f, 0x5c, 0x1a, 0x69, 0xa3, 0x5e, 0xdb, 0x6b, 0xe0, 0xfc, 0x40, 0xf4, 0xe0, 0xc0, 0x3b,
+	0xe8, 0x22, 0xec, 0x75, 0xe5, 0x2a, 0x4f, 0xd8, 0xaf, 0x69, 0xfb, 0x07, 0xc2, 0x6c, 0xfd, 0x5d,
+	0x3e, 0x25, 0x72, 0xe7, 0xab, 0x08, 0x5a, 0x5c, 0x0f, 0xa7, 0x5b, 0xc9, 0xdb, 0xfc, 0x15, 0xe0,
+	0xdf, 0xea, 0x35, 0x59, 0x2d, 0x2a, 0x3f, 0x69, 0x47, 0xa9, 0xdd, 0x25, 0xc7, 0xaa, 0x37, 0x23,
+	0xa9, 0xca, 0x7a, 0xe9, 0x51, 0x4e, 0xfc, 0x9d, 0xca, 0xa5, 0x33, 0xf6, 0xe4, 0x41, 0x16, 0x85,
+	0x38, 0xe2, 0xfb, 0x98, 0x7d, 0x23, 0xae, 0x25, 0x17, 0x9e, 0x09, 0xaa, 0x5d, 0x48, 0xbb, 0x62,
+	0xdb, 0xce, 0xd9, 0xe2, 0xbb, 0xfd, 0xbd, 0x0c, 0xa9, 0xc2, 0x42, 0x5a, 0x62, 0x6e, 0x56, 0x4b,
+	0x16, 0xd2, 0x12, 0x26, 0xbd, 0xcc, 0x0b, 0x60, 0x32, 0x24, 0x83, 0x20, 0x7a, 0xf6, 0x64, 0xc3,
+	0xfa, 0x52, 0x12, 0xb4, 0xd1, 0x10, 0xc1, 0xde, 0x77, 0xbf, 0x98, 0x5c, 0x0b, 0x0a, 0x7b, 0x2c,
+	0xd2, 0x82, 0x7f, 0x9f, 0x40, 0x8b, 0x24, 0x0d, 0x15, 0xb2, 0x27, 0x4d, 0x7a, 0x59, 0xff, 0x26,
+	0x4b, 0x24, 0xef, 0x0f, 0x92, 0xc7, 0x41, 0x5e, 0xd0, 0x37, 0x41, 0xcd, 0x45, 0x7d, 0x42, 0xf1,
+	0x38, 0x85, 0xd6, 0x18, 0x9a, 0xb8, 0x21, 0x2b, 0xa7, 0xa8, 0x07, 0xfe, 0x50, 0x04, 0x7e, 0xfa,
+	0xdf, 0xca, 0xca, 0x29, 0x39, 0x93, 0xb6, 0x56, 0x44, 0x39, 0x25, 0xa7, 0x27, 0x62, 0xbc, 0xfd,
+	0x6f, 0x13, 0x4c, 0x74, 0xa8, 0x67, 0xba, 0x60, 0x2e, 0x55, 0x35, 0x5f, 0x2b, 0x58, 0xa5, 0x74,
+	0x61, 0xba, 0xb1, 0x39, 0x16, 0x4c, 0xef, 0x35, 0x17, 0xcc, 0xa5, 0x6a, 0xd7, 0xc5, 0x5e, 0x92,
+	0xb0, 0x12, 0x2f, 0xb9, 0xa5, 0xe1, 0x4f, 0x01, 0x48, 0x54, 0x7e, 0xaf, 0x16, 0x0e, 0x8e, 0x41,
+	0x8d, 0xb7, 0xc7, 0x00, 0xe9, 0xff, 0xa7, 0x60, 0x3e, 0x5b, 0x54, 0xdd, 0x28, 0xfc, 0x87, 0x0c,
+	0xb6, 0xb1, 0x3d, 0x3e, 0x36, 0xe9, 0x34, 0x5b, 0xc3, 0x2c, 0x76, 0x9a, 0xc1, 0x96, 0x38, 0x2d,
+	0x2c, 0x19, 0x9a, 0x23, 0xf0, 0x7a, 0x5e, 0xb9, 0xf0, 0xff, 0x0b, 0xff, 0x2a, 0x07, 0xdd, 0xb8,
+	0x71, 0x12, 0xb4, 0x76, 0xed, 0x81, 0xb3, 0xe9, 0x2a, 0xdd, 0x9b, 0x85, 0x7f, 0x93, 0xc2, 0x35,
+	0x9a, 0xe3, 0xe1, 0xb4, 0xa3, 0x4f, 0x40, 0x2d, 0xae, 0x53, 0x59, 0x85, 0x83, 0x35, 0xa6, 0xb1,
+	0x71, 0x3c, 0x26, 0xb9, 0x15, 0x13, 0xb5, 0xa3, 0xab, 0x25, 0xa7, 0x25, 0x02, 0x95, 0x6c, 0xc5,
+	0x6c, 0xb5, 0x46, 0x1e, 0xdb, 0x44, 0xa5, 0x66, 0xed, 0x98, 0xc1, 0x12, 0x56, 0x7a, 0x6c, 0xb3,
+	0x95, 0x0c, 0xbe, 0x16, 0xe9, 0x2a, 0x46, 0xf1, 0x5a, 0xa4, 0x70, 0x25, 0x6b, 0x91, 0x9b, 0xef,
+	0x0b, 0x47, 0xa9, 0x5c, 0xbf, 0xc4, 0x51, 0x12, 0x57, 0xe6, 0x28, 0x2f, 0xf7, 0xe5, 0xa7, 0x29,
+	0x9b, 0xf7, 0x16, 0x2f, 0x6c, 0x06, 0x5b, 0x72, 0x9a, 0x8a, 0x13, 0xcd, 0x5f, 0x80, 0x73, 0x47,
+	0xf2, 0xc7, 0xb7, 0x4a, 0xb6, 0x52, 0x12, 0xd8, 0x68, 0x8d, 0x09, 0xd4, 0xbe, 0x86, 0xc0, 0xcc,
+	0xc9, 0xd6, 0x8a, 0xf7, 0x56, 0x16, 0xdc, 0xb8, 0x7e, 0x02, 0x70, 0xd2, 0x6f, 0x4e, 0x76, 0x53,
+	0xec, 0x37, 0x0b, 0x2e, 0xf1, 0x5b, 0x92, 0x88, 0xf4, 0xc1, 0xf9, 0x4c, 0x1a, 0x71, 0xad, 0xe4,
+	0xe2, 0x49, 0x43, 0x1b, 0x5b, 0x63, 0x43, 0x93, 0xab, 0x79, 0x24, 0x78, 0x2f, 0x5e, 0xcd, 0x34,
+	0xb0, 0x64, 0x35, 0x0b, 0x42, 0x71, 0x0a, 0xe6, 0xb3, 0xb1, 0xf5, 0xc6, 0x31, 0xeb, 0x93, 0xc0,
+	0x96, 0x6c, 0xd7, 0xc2, 0x18, 0x57, 0x3c, 0xd6, 0xc9, 0xf8, 0xb6, 0xe4, 0xb1, 0x4e, 0xc0, 0xca,
+	0x1e, 0xeb, 0xbc, 0xf0, 0xd3, 0x05, 0x73, 0xa9, 0x20, 0x73, 0xad, 0x64, 0x25, 0x62, 0x58, 0x89,
+	0x97, 0xbc, 0xc0, 0x8e, 0x3f, 0x64, 0x79, 0x41, 0x5d, 0xf1, 0x43, 0x96, 0x83, 0x2e, 0x79, 0xc8,
+	0x4a, 0x22, 0xac, 0xc6, 0xe4, 0x2f, 0x79, 0x44, 0xb7, 0x73, 0xe7, 0xe9, 0xf3, 0x65, 0xe3, 0x8b,
+	0xe7, 0xcb, 0xc6, 0x3f, 0x9f, 0x2f, 0x1b, 0xbf, 0x7e, 0xb1, 0x7c, 0xe6, 0x8b, 0x17, 0xcb, 0x67,
+	0xbe, 0x7c, 0xb1, 0x7c, 0xe6, 0x67, 0x6f, 0x7b, 0x98, 0x75, 0x07, 0x87, 0x4d, 0x87, 0xf8, 0xad,
+	0x10, 0xe2, 0x07, 0xfd, 0x51, 0x2b, 0x2f, 0xc4, 0x63, 0xa3, 0x3e, 0xa2, 0x87, 0x53, 0xa2, 0xa0,
+	0x76, 0xfd, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x85, 0x63, 0x29, 0x6c, 0x22, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateMarket opens a prediction market on a match that has not started yet.
+	CreateMarket(ctx context.Context, in *MsgCreateMarket, opts ...grpc.CallOption) (*MsgCreateMarketResponse, error)
+	// PlaceStake stakes coins of the bond denom on an outcome of an open market.
+	PlaceStake(ctx context.Context, in *MsgPlaceStake, opts ...grpc.CallOption) (*MsgPlaceStakeResponse, error)
+	// MintOutcomeShares locks collateral of the bond denom and mints the same
+	// amount of every outcome share of a match.
+	MintOutcomeShares(ctx context.Context, in *MsgMintOutcomeShares, opts ...grpc.CallOption) (*MsgMintOutcomeSharesResponse, error)
+	// BurnOutcomeShares burns complete sets of outcome shares and unlocks the
+	// same amount of collateral.
+	BurnOutcomeShares(ctx context.Context, in *MsgBurnOutcomeShares, opts ...grpc.CallOption) (*MsgBurnOutcomeSharesResponse, error)
+	// RedeemOutcomeShares burns the winning outcome shares of a finalized match
+	// held by the sender and pays out the same amount of collateral. If the
+	// match was cancelled, every outcome share pays out its pro rata part of
+	// the collateral.
+	RedeemOutcomeShares(ctx context.Context, in *MsgRedeemOutcomeShares, opts ...grpc.CallOption) (*MsgRedeemOutcomeSharesResponse, error)
+	// OverrideMatch corrects the score and status of a match. A finished or
+	// cancelled result is finalized immediately. Only the authority or the data
+	// council can override matches.
+	OverrideMatch(ctx context.Context, in *MsgOverrideMatch, opts ...grpc.CallOption) (*MsgOverrideMatchResponse, error)
+	// VoidMatch cancels a match and finalizes it, refunding its open markets.
+	// Only the authority or the data council can void matches.
+	VoidMatch(ctx context.Context, in *MsgVoidMatch, opts ...grpc.CallOption) (*MsgVoidMatchResponse, error)
+	// UpsertTeam creates or replaces a team. Only the authority or the data
+	// council can upsert teams.
+	UpsertTeam(ctx context.Context, in *MsgUpsertTeam, opts ...grpc.CallOption) (*MsgUpsertTeamResponse, error)
+	// UpsertLeague creates or replaces a league. Only the authority or the data
+	// council can upsert leagues.
+	UpsertLeague(ctx context.Context, in *MsgUpsertLeague, opts ...grpc.CallOption) (*MsgUpsertLeagueResponse, error)
+	// MergeEntities merges a duplicate team, league or match into its canonical
+	// entity: the ids of the duplicate at the data providers are mapped to the
+	// canonical id. Only the authority can merge entities.
+	MergeEntities(ctx context.Context, in *MsgMergeEntities, opts ...grpc.CallOption) (*MsgMergeEntitiesResponse, error)
+	// MapExternalID maps the id of a team, league or match at a data provider
+	// to its canonical id. Only the authority can map ids.
+	MapExternalID(ctx context.Context, in *MsgMapExternalID, opts ...grpc.CallOption) (*MsgMapExternalIDResponse, error)
+	// ProposeCorrection proposes a data correction as a data-council member.
+	// The proposal counts as the proposer's approval.
+	ProposeCorrection(ctx context.Context, in *MsgProposeCorrection, opts ...grpc.CallOption) (*MsgProposeCorrectionResponse, error)
+	// VoteCorrection approves a pending data correction as a data-council
+	// member. The correction is applied once it reaches the threshold.
+	VoteCorrection(ctx context.Context, in *MsgVoteCorrection, opts ...grpc.CallOption) (*MsgVoteCorrectionResponse, error)
+	// SubmitOracleReport submits or replaces the report of a bonded validator on
+	// the result of a match that is not finalized yet.
+	SubmitOracleReport(ctx context.Context, in *MsgSubmitOracleReport, opts ...grpc.CallOption) (*MsgSubmitOracleReportResponse, error)
+	// ClaimOracleRewards pays the oracle rewards of a validator out to its
+	// operator account.
+	ClaimOracleRewards(ctx context.Context, in *MsgClaimOracleRewards, opts ...grpc.CallOption) (*MsgClaimOracleRewardsResponse, error)
+	// RegisterReporter bonds tokens of the bond denom to register the sender as
+	// a data reporter, or adds to the bond of an active reporter.
+	RegisterReporter(ctx context.Context, in *MsgRegisterReporter, opts ...grpc.CallOption) (*MsgRegisterReporterResponse, error)
+	// UnbondReporter starts unbonding a reporter. The bond is returned after
+	// reporter_unbonding_blocks.
+	UnbondReporter(ctx context.Context, in *MsgUnbondReporter, opts ...grpc.CallOption) (*MsgUnbondReporterResponse, error)
+	// SubmitMatchReport submits or replaces the report of an active reporter on
+	// the state of a match that is not finalized yet. It is only accepted while
+	// the commit-reveal scheme is disabled.
+	SubmitMatchReport(ctx context.Context, in *MsgSubmitMatchReport, opts ...grpc.CallOption) (*MsgSubmitMatchReportResponse, error)
+	// CommitReport commits an active reporter to the hash of its report on a
+	// match, in the commit phase of the current round.
+	CommitReport(ctx context.Context, in *MsgCommitReport, opts ...grpc.CallOption) (*MsgCommitReportResponse, error)
+	// RevealReport reveals a committed report in the reveal phase of its round.
+	RevealReport(ctx context.Context, in *MsgRevealReport, opts ...grpc.CallOption) (*MsgRevealReportResponse, error)
+	// FundReporterRewards adds tokens of the bond denom to the pool the reporter
+	// rewards are paid from.
+	FundReporterRewards(ctx context.Context, in *MsgFundReporterRewards, opts ...grpc.CallOption) (*MsgFundReporterRewardsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateMarket(ctx context.Context, in *MsgCreateMarket, opts ...grpc.CallOption) (*MsgCreateMarketResponse, error) {
+	out := new(MsgCreateMarketResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/CreateMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceStake(ctx context.Context, in *MsgPlaceStake, opts ...grpc.CallOption) (*MsgPlaceStakeResponse, error) {
+	out := new(MsgPlaceStakeResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/PlaceStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MintOutcomeShares(ctx context.Context, in *MsgMintOutcomeShares, opts ...grpc.CallOption) (*MsgMintOutcomeSharesResponse, error) {
+	out := new(MsgMintOutcomeSharesResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/MintOutcomeShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BurnOutcomeShares(ctx context.Context, in *MsgBurnOutcomeShares, opts ...grpc.CallOption) (*MsgBurnOutcomeSharesResponse, error) {
+	out := new(MsgBurnOutcomeSharesResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/BurnOutcomeShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemOutcomeShares(ctx context.Context, in *MsgRedeemOutcomeShares, opts ...grpc.CallOption) (*MsgRedeemOutcomeSharesResponse, error) {
+	out := new(MsgRedeemOutcomeSharesResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/RedeemOutcomeShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OverrideMatch(ctx context.Context, in *MsgOverrideMatch, opts ...grpc.CallOption) (*MsgOverrideMatchResponse, error) {
+	out := new(MsgOverrideMatchResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/OverrideMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoidMatch(ctx context.Context, in *MsgVoidMatch, opts ...grpc.CallOption) (*MsgVoidMatchResponse, error) {
+	out := new(MsgVoidMatchResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/VoidMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpsertTeam(ctx context.Context, in *MsgUpsertTeam, opts ...grpc.CallOption) (*MsgUpsertTeamResponse, error) {
+	out := new(MsgUpsertTeamResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/UpsertTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpsertLeague(ctx context.Context, in *MsgUpsertLeague, opts ...grpc.CallOption) (*MsgUpsertLeagueResponse, error) {
+	out := new(MsgUpsertLeagueResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/UpsertLeague", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MergeEntities(ctx context.Context, in *MsgMergeEntities, opts ...grpc.CallOption) (*MsgMergeEntitiesResponse, error) {
+	out := new(MsgMergeEntitiesResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/MergeEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MapExternalID(ctx context.Context, in *MsgMapExternalID, opts ...grpc.CallOption) (*MsgMapExternalIDResponse, error) {
+	out := new(MsgMapExternalIDResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/MapExternalID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeCorrection(ctx context.Context, in *MsgProposeCorrection, opts ...grpc.CallOption) (*MsgProposeCorrectionResponse, error) {
+	out := new(MsgProposeCorrectionResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/ProposeCorrection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteCorrection(ctx context.Context, in *MsgVoteCorrection, opts ...grpc.CallOption) (*MsgVoteCorrectionResponse, error) {
+	out := new(MsgVoteCorrectionResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/VoteCorrection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitOracleReport(ctx context.Context, in *MsgSubmitOracleReport, opts ...grpc.CallOption) (*MsgSubmitOracleReportResponse, error) {
+	out := new(MsgSubmitOracleReportResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/SubmitOracleReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimOracleRewards(ctx context.Context, in *MsgClaimOracleRewards, opts ...grpc.CallOption) (*MsgClaimOracleRewardsResponse, error) {
+	out := new(MsgClaimOracleRewardsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/ClaimOracleRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterReporter(ctx context.Context, in *MsgRegisterReporter, opts ...grpc.CallOption) (*MsgRegisterReporterResponse, error) {
+	out := new(MsgRegisterReporterResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/RegisterReporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbondReporter(ctx context.Context, in *MsgUnbondReporter, opts ...grpc.CallOption) (*MsgUnbondReporterResponse, error) {
+	out := new(MsgUnbondReporterResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/UnbondReporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitMatchReport(ctx context.Context, in *MsgSubmitMatchReport, opts ...grpc.CallOption) (*MsgSubmitMatchReportResponse, error) {
+	out := new(MsgSubmitMatchReportResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/SubmitMatchReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitReport(ctx context.Context, in *MsgCommitReport, opts ...grpc.CallOption) (*MsgCommitReportResponse, error) {
+	out := new(MsgCommitReportResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/CommitReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealReport(ctx context.Context, in *MsgRevealReport, opts ...grpc.CallOption) (*MsgRevealReportResponse, error) {
+	out := new(MsgRevealReportResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/RevealReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundReporterRewards(ctx context.Context, in *MsgFundReporterRewards, opts ...grpc.CallOption) (*MsgFundReporterRewardsResponse, error) {
+	out := new(MsgFundReporterRewardsResponse)
+	err := c.cc.Invoke(ctx, "/futchain.futchain.v1.Msg/FundReporterRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateMarket opens a prediction market on a match that has not started yet.
+	CreateMarket(context.Context, *MsgCreateMarket) (*MsgCreateMarketResponse, error)
+	// PlaceStake stakes coins of the bond denom on an outcome of an open market.
+	PlaceStake(context.Context, *MsgPlaceStake) (*MsgPlaceStakeResponse, error)
+	// MintOutcomeShares locks collateral of the bond denom and mints the same
+	// amount of every outcome share of a match.
+	MintOutcomeShares(context.Context, *MsgMintOutcomeShares) (*MsgMintOutcomeSharesResponse, error)
+	// BurnOutcomeShares burns complete sets of outcome shares and unlocks the
+	// same amount of collateral.
+	BurnOutcomeShares(context.Context, *MsgBurnOutcomeShares) (*MsgBurnOutcomeSharesResponse, error)
+	// RedeemOutcomeShares burns the winning outcome shares of a finalized match
+	// held by the sender and pays out the same amount of collateral. If the
+	// match was cancelled, every outcome share pays out its pro rata part of
+	// the collateral.
+	RedeemOutcomeShares(context.Context, *MsgRedeemOutcomeShares) (*MsgRedeemOutcomeSharesResponse, error)
+	// OverrideMatch corrects the score and status of a match. A finished or
+	// cancelled result is finalized immediately. Only the authority or the data
+	// council can override matches.
+	OverrideMatch(context.Context, *MsgOverrideMatch) (*MsgOverrideMatchResponse, error)
+	// VoidMatch cancels a match and finalizes it, refunding its open markets.
+	// Only the authority or the data council can void matches.
+	VoidMatch(context.Context, *MsgVoidMatch) (*MsgVoidMatchResponse, error)
+	// UpsertTeam creates or replaces a team. Only the authority or the data
+	// council can upsert teams.
+	UpsertTeam(context.Context, *MsgUpsertTeam) (*MsgUpsertTeamResponse, error)
+	// UpsertLeague creates or replaces a league. Only the authority or the data
+	// council can upsert leagues.
+	UpsertLeague(context.Context, *MsgUpsertLeague) (*MsgUpsertLeagueResponse, error)
+	// MergeEntities merges a duplicate team, league or match into its canonical
+	// entity: the ids of the duplicate at the data providers are mapped to the
+	// canonical id. Only the authority can merge entities.
+	MergeEntities(context.Context, *MsgMergeEntities) (*MsgMergeEntitiesResponse, error)
+	// MapExternalID maps the id of a team, league or match at a data provider
+	// to its canonical id. Only the authority can map ids.
+	MapExternalID(context.Context, *MsgMapExternalID) (*MsgMapExternalIDResponse, error)
+	// ProposeCorrection proposes a data correction as a data-council member.
+	// The proposal counts as the proposer's approval.
+	ProposeCorrection(context.Context, *MsgProposeCorrection) (*MsgProposeCorrectionResponse, error)
+	// VoteCorrection approves a pending data correction as a data-council
+	// member. The correction is applied once it reaches the threshold.
+	VoteCorrection(context.Context, *MsgVoteCorrection) (*MsgVoteCorrectionResponse, error)
+	// SubmitOracleReport submits or replaces the report of a bonded validator on
+	// the result of a match that is not finalized yet.
+	SubmitOracleReport(context.Context, *MsgSubmitOracleReport) (*MsgSubmitOracleReportResponse, error)
+	// ClaimOracleRewards pays the oracle rewards of a validator out to its
+	// operator account.
+	ClaimOracleRewards(context.Context, *MsgClaimOracleRewards) (*MsgClaimOracleRewardsResponse, error)
+	// RegisterReporter bonds tokens of the bond denom to register the sender as
+	// a data reporter, or adds to the bond of an active reporter.
+	RegisterReporter(context.Context, *MsgRegisterReporter) (*MsgRegisterReporterResponse, error)
+	// UnbondReporter starts unbonding a reporter. The bond is returned after
+	// reporter_unbonding_blocks.
+	UnbondReporter(context.Context, *MsgUnbondReporter) (*MsgUnbondReporterResponse, error)
+	// SubmitMatchReport submits or replaces the report of an active reporter on
+	// the state of a match that is not finalized yet. It is only accepted while
+	// the commit-reveal scheme is disabled.
+	SubmitMatchReport(context.Context, *MsgSubmitMatchReport) (*MsgSubmitMatchReportResponse, error)
+	// CommitReport commits an active reporter to the hash of its report on a
+	// match, in the commit phase of the current round.
+	CommitReport(context.Context, *MsgCommitReport) (*MsgCommitReportResponse, error)
+	// RevealReport reveals a committed report in the reveal phase of its round.
+	RevealReport(context.Context, *MsgRevealReport) (*MsgRevealReportResponse, error)
+	// FundReporterRewards adds tokens of the bond denom to the pool the reporter
+	// rewards are paid from.
+	FundReporterRewards(context.Context, *MsgFundReporterRewards) (*MsgFundReporterRewardsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateMarket(ctx context.Context, req *MsgCreateMarket) (*MsgCreateMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMarket not implemented")
+}
+func (*UnimplementedMsgServer) PlaceStake(ctx context.Context, req *MsgPlaceStake) (*MsgPlaceStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceStake not implemented")
+}
+func (*UnimplementedMsgServer) MintOutcomeShares(ctx context.Context, req *MsgMintOutcomeShares) (*MsgMintOutcomeSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintOutcomeShares not implemented")
+}
+func (*UnimplementedMsgServer) BurnOutcomeShares(ctx context.Context, req *MsgBurnOutcomeShares) (*MsgBurnOutcomeSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnOutcomeShares not implemented")
+}
+func (*UnimplementedMsgServer) RedeemOutcomeShares(ctx context.Context, req *MsgRedeemOutcomeShares) (*MsgRedeemOutcomeSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemOutcomeShares not implemented")
+}
+func (*UnimplementedMsgServer) OverrideMatch(ctx context.Context, req *MsgOverrideMatch) (*MsgOverrideMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverrideMatch not implemented")
+}
+func (*UnimplementedMsgServer) VoidMatch(ctx context.Context, req *MsgVoidMatch) (*MsgVoidMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidMatch not implemented")
+}
+func (*UnimplementedMsgServer) UpsertTeam(ctx context.Context, req *MsgUpsertTeam) (*MsgUpsertTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTeam not implemented")
+}
+func (*UnimplementedMsgServer) UpsertLeague(ctx context.Context, req *MsgUpsertLeague) (*MsgUpsertLeagueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertLeague not implemented")
+}
+func (*UnimplementedMsgServer) MergeEntities(ctx context.Context, req *MsgMergeEntities) (*MsgMergeEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeEntities not implemented")
+}
+func (*UnimplementedMsgServer) MapExternalID(ctx context.Context, req *MsgMapExternalID) (*MsgMapExternalIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MapExternalID not implemented")
+}
+func (*UnimplementedMsgServer) ProposeCorrection(ctx context.Context, req *MsgProposeCorrection) (*MsgProposeCorrectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeCorrection not implemented")
+}
+func (*UnimplementedMsgServer) VoteCorrection(ctx context.Context, req *MsgVoteCorrection) (*MsgVoteCorrectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteCorrection not implemented")
+}
+func (*UnimplementedMsgServer) SubmitOracleReport(ctx context.Context, req *MsgSubmitOracleReport) (*MsgSubmitOracleReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOracleReport not implemented")
+}
+func (*UnimplementedMsgServer) ClaimOracleRewards(ctx context.Context, req *MsgClaimOracleRewards) (*MsgClaimOracleRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimOracleRewards not implemented")
+}
+func (*UnimplementedMsgServer) RegisterReporter(ctx context.Context, req *MsgRegisterReporter) (*MsgRegisterReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterReporter not implemented")
+}
+func (*UnimplementedMsgServer) UnbondReporter(ctx context.Context, req *MsgUnbondReporter) (*MsgUnbondReporterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondReporter not implemented")
+}
+func (*UnimplementedMsgServer) SubmitMatchReport(ctx context.Context, req *MsgSubmitMatchReport) (*MsgSubmitMatchReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMatchReport not implemented")
+}
+func (*UnimplementedMsgServer) CommitReport(ctx context.Context, req *MsgCommitReport) (*MsgCommitReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReport not implemented")
+}
+func (*UnimplementedMsgServer) RevealReport(ctx context.Context, req *MsgRevealReport) (*MsgRevealReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealReport not implemented")
+}
+func (*UnimplementedMsgServer) FundReporterRewards(ctx context.Context, req *MsgFundReporterRewards) (*MsgFundReporterRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundReporterRewards not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/CreateMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateMarket(ctx, req.(*MsgCreateMarket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/PlaceStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceStake(ctx, req.(*MsgPlaceStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintOutcomeShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintOutcomeShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintOutcomeShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/MintOutcomeShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintOutcomeShares(ctx, req.(*MsgMintOutcomeShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BurnOutcomeShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurnOutcomeShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BurnOutcomeShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/BurnOutcomeShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BurnOutcomeShares(ctx, req.(*MsgBurnOutcomeShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemOutcomeShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemOutcomeShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemOutcomeShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/RedeemOutcomeShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemOutcomeShares(ctx, req.(*MsgRedeemOutcomeShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OverrideMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOverrideMatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OverrideMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/OverrideMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OverrideMatch(ctx, req.(*MsgOverrideMatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoidMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoidMatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoidMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/VoidMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoidMatch(ctx, req.(*MsgVoidMatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpsertTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertTeam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/UpsertTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertTeam(ctx, req.(*MsgUpsertTeam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpsertLeague_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpsertLeague)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpsertLeague(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/UpsertLeague",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpsertLeague(ctx, req.(*MsgUpsertLeague))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MergeEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMergeEntities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MergeEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/MergeEntities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MergeEntities(ctx, req.(*MsgMergeEntities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MapExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMapExternalID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MapExternalID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/MapExternalID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MapExternalID(ctx, req.(*MsgMapExternalID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeCorrection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/ProposeCorrection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeCorrection(ctx, req.(*MsgProposeCorrection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteCorrection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/VoteCorrection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteCorrection(ctx, req.(*MsgVoteCorrection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitOracleReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitOracleReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitOracleReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/SubmitOracleReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitOracleReport(ctx, req.(*MsgSubmitOracleReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimOracleRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimOracleRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimOracleRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/ClaimOracleRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimOracleRewards(ctx, req.(*MsgClaimOracleRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterReporter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/RegisterReporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterReporter(ctx, req.(*MsgRegisterReporter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondReporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondReporter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondReporter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/UnbondReporter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondReporter(ctx, req.(*MsgUnbondReporter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitMatchReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitMatchReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitMatchReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/SubmitMatchReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitMatchReport(ctx, req.(*MsgSubmitMatchReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/CommitReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitReport(ctx, req.(*MsgCommitReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/RevealReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealReport(ctx, req.(*MsgRevealReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundReporterRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundReporterRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundReporterRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/futchain.futchain.v1.Msg/FundReporterRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundReporterRewards(ctx, req.(*MsgFundReporterRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "futchain.futchain.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateMarket",
+			Handler:    _Msg_CreateMarket_Handler,
+		},
+		{
+			MethodName: "PlaceStake",
+			Handler:    _Msg_PlaceStake_Handler,
+		},
+		{
+			MethodName: "MintOutcomeShares",
+			Handler:    _Msg_MintOutcomeShares_Handler,
+		},
+		{
+			MethodName: "BurnOutcomeShares",
+			Handler:    _Msg_BurnOutcomeShares_Handler,
+		},
+		{
+			MethodName: "RedeemOutcomeShares",
+			Handler:    _Msg_RedeemOutcomeShares_Handler,
+		},
+		{
+			MethodName: "OverrideMatch",
+			Handler:    _Msg_OverrideMatch_Handler,
+		},
+		{
+			MethodName: "VoidMatch",
+			Handler:    _Msg_VoidMatch_Handler,
+		},
+		{
+			MethodName: "UpsertTeam",
+			Handler:    _Msg_UpsertTeam_Handler,
+		},
+		{
+			MethodName: "UpsertLeague",
+			Handler:    _Msg_UpsertLeague_Handler,
+		},
+		{
+			MethodName: "MergeEntities",
+			Handler:    _Msg_MergeEntities_Handler,
+		},
+		{
+			MethodName: "MapExternalID",
+			Handler:    _Msg_MapExternalID_Handler,
+		},
+		{
+			MethodName: "ProposeCorrection",
+			Handler:    _Msg_ProposeCorrection_Handler,
+		},
+		{
+			MethodName: "VoteCorrection",
+			Handler:    _Msg_VoteCorrection_Handler,
+		},
+		{
+			MethodName: "SubmitOracleReport",
+			Handler:    _Msg_SubmitOracleReport_Handler,
+		},
+		{
+			MethodName: "ClaimOracleRewards",
+			Handler:    _Msg_ClaimOracleRewards_Handler,
+		},
+		{
+			MethodName: "RegisterReporter",
+			Handler:    _Msg_RegisterReporter_Handler,
+		},
+		{
+			MethodName: "UnbondReporter",
+			Handler:    _Msg_UnbondReporter_Handler,
+		},
+		{
+			MethodName: "SubmitMatchReport",
+			Handler:    _Msg_SubmitMatchReport_Handler,
+		},
+		{
+			MethodName: "CommitReport",
+			Handler:    _Msg_CommitReport_Handler,
+		},
+		{
+			MethodName: "RevealReport",
+			Handler:    _Msg_RevealReport_Handler,
+		},
+		{
+			MethodName: "FundReporterRewards",
+			Handler:    _Msg_FundReporterRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "futchain/futchain/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Line != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Line))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MarketType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Outcome != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Staker) > 0 {
+		i -= len(m.Staker)
+		copy(dAtA[i:], m.Staker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Staker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintOutcomeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintOutcomeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintOutcomeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMintOutcomeSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMintOutcomeSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMintOutcomeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnOutcomeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnOutcomeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnOutcomeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnOutcomeSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnOutcomeSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnOutcomeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemOutcomeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemOutcomeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemOutcomeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemOutcomeSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemOutcomeSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemOutcomeSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOverrideMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOverrideMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOverrideMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Finished {
+		i--
+		if m.Finished {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOverrideMatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOverrideMatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOverrideMatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoidMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoidMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoidMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoidMatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoidMatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoidMatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertTeam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertTeam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LongName) > 0 {
+		i -= len(m.LongName)
+		copy(dAtA[i:], m.LongName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LongName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertTeamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertTeamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertTeamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertLeague) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertLeague) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertLeague) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Ccode) > 0 {
+		i -= len(m.Ccode)
+		copy(dAtA[i:], m.Ccode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ccode)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.IsGroup {
+		i--
+		if m.IsGroup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.GroupName) > 0 {
+		i -= len(m.GroupName)
+		copy(dAtA[i:], m.GroupName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PrimaryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PrimaryId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpsertLeagueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpsertLeagueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpsertLeagueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeEntities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeEntities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeEntities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CanonicalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CanonicalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DuplicateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DuplicateId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EntityType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EntityType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMergeEntitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMergeEntitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMergeEntitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMapExternalID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMapExternalID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMapExternalID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CanonicalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CanonicalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EntityType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EntityType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMapExternalIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMapExternalIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMapExternalIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeCorrection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeCorrection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeCorrection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeCorrectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeCorrectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeCorrectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CorrectionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CorrectionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteCorrection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteCorrection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteCorrection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CorrectionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CorrectionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteCorrectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteCorrectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteCorrectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitOracleReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitOracleReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitOracleReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AwayScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AwayScore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HomeScore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HomeScore))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitOracleReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitOracleReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitOracleReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimOracleRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimOracleRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimOracleRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimOracleRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimOracleRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimOracleRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterReporter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterReporter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterReporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterReporterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondReporter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondReporter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondReporter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondReporterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondReporterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondReporterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitMatchReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMatchReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMatchReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitMatchReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMatchReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMatchReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MatchId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Round != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundReporterRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundReporterRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundReporterRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundReporterRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundReporterRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundReporterRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovTx(uint64(m.MatchId))
+	}
+	if m.MarketType != 0 {
+		n += 1 + sovTx(uint64(m.MarketType))
+	}
+	if m.Line != 0 {
+		n += 1 + sovTx(uint64(m.Line))
+	}
+	return n
+}
+
+func (m *MsgCreateMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *MsgPlaceStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Staker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovTx(uint64(m.Outcome))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMintOutcomeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovTx(uint64(m.MatchId))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMintOutcomeSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBurnOutcomeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovTx(uint64(m.MatchId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnOutcomeSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemOutcomeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovTx(uint64(m.MatchId))
+	}
+	return n
+}
+
+func (m *MsgRedeemOutcomeSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payout.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgOverrideMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovTx(uint64(m.MatchId))
+	}
+	if m.HomeScore != 0 {
+		n += 1 + sovTx(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovTx(uint64(m.AwayScore))
+	}
+	if m.Started {
+		n += 2
+	}
+	if m.Finished {
+		n += 2
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOverrideMatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoidMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovTx(uint64(m.MatchId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoidMatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpsertTeam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LongName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpsertTeamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpsertLeague) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.PrimaryId != 0 {
+		n += 1 + sovTx(uint64(m.PrimaryId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsGroup {
+		n += 2
+	}
+	l = len(m.Ccode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpsertLeagueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMergeEntities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EntityType != 0 {
+		n += 1 + sovTx(uint64(m.EntityType))
+	}
+	if m.DuplicateId != 0 {
+		n += 1 + sovTx(uint64(m.DuplicateId))
+	}
+	if m.CanonicalId != 0 {
+		n += 1 + sovTx(uint64(m.CanonicalId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMergeEntitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMapExternalID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EntityType != 0 {
+		n += 1 + sovTx(uint64(m.EntityType))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CanonicalId != 0 {
+		n += 1 + sovTx(uint64(m.CanonicalId))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMapExternalIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeCorrection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeCorrectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CorrectionId != 0 {
+		n += 1 + sovTx(uint64(m.CorrectionId))
+	}
+	return n
+}
+
+func (m *MsgVoteCorrection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CorrectionId != 0 {
+		n += 1 + sovTx(uint64(m.CorrectionId))
+	}
+	return n
+}
+
+func (m *MsgVoteCorrectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgSubmitOracleReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovTx(uint64(m.MatchId))
+	}
+	if m.HomeScore != 0 {
+		n += 1 + sovTx(uint64(m.HomeScore))
+	}
+	if m.AwayScore != 0 {
+		n += 1 + sovTx(uint64(m.AwayScore))
+	}
+	if m.Cancelled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSubmitOracleReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimOracleRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimOracleRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterReporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbondReporter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbondReporterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingHeight))
+	}
+	return n
+}
+
+func (m *MsgSubmitMatchReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Report.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitMatchReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MatchId != 0 {
+		n += 1 + sovTx(uint64(m.MatchId))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovTx(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *MsgRevealReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Report.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevealReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundReporterRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundReporterRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketType", wireType)
+			}
+			m.MarketType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketType |= MarketType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
+			}
+			m.Line = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Line |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintOutcomeShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintOutcomeShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintOutcomeShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintOutcomeSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintOutcomeSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintOutcomeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnOutcomeShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnOutcomeShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnOutcomeShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnOutcomeSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnOutcomeSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnOutcomeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemOutcomeShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemOutcomeShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemOutcomeShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemOutcomeSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemOutcomeSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemOutcomeSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOverrideMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOverrideMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOverrideMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
+			}
+			m.HomeScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
+			}
+			m.AwayScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finished = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOverrideMatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOverrideMatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOverrideMatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoidMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoidMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoidMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoidMatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoidMatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoidMatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpsertTeam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertTeam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertTeam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LongName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpsertTeamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertTeamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertTeamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpsertLeague) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertLeague: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertLeague: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryId", wireType)
+			}
+			m.PrimaryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrimaryId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsGroup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsGroup = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ccode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ccode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpsertLeagueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpsertLeagueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpsertLeagueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMergeEntities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMergeEntities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMergeEntities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+			}
+			m.EntityType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntityType |= EntityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateId", wireType)
+			}
+			m.DuplicateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DuplicateId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalId", wireType)
+			}
+			m.CanonicalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanonicalId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMergeEntitiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMergeEntitiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMergeEntitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMapExternalID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMapExternalID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMapExternalID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityType", wireType)
+			}
+			m.EntityType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntityType |= EntityType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalId", wireType)
+			}
+			m.CanonicalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanonicalId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMapExternalIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMapExternalIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMapExternalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeCorrection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeCorrection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeCorrection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &any.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeCorrectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeCorrectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeCorrectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectionId", wireType)
+			}
+			m.CorrectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorrectionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteCorrection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteCorrection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteCorrection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrectionId", wireType)
+			}
+			m.CorrectionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorrectionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteCorrectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteCorrectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteCorrectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CorrectionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitOracleReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitOracleReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitOracleReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HomeScore", wireType)
+			}
+			m.HomeScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HomeScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwayScore", wireType)
+			}
+			m.AwayScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AwayScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitOracleReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitOracleReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitOracleReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimOracleRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimOracleRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimOracleRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimOracleRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimOracleRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimOracleRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterReporter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterReporter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterReporter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterReporterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterReporterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondReporter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondReporter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondReporter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondReporterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondReporterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondReporterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitMatchReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMatchReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMatchReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitMatchReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMatchReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMatchReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchId", wireType)
+			}
+			m.MatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return nil
 }
+func (m *MsgRevealReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRevealReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgFundReporterRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -371,15 +10149,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundReporterRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundReporterRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -407,11 +10185,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -438,7 +10216,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -463,7 +10241,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundReporterRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -486,10 +10264,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundReporterRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundReporterRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: